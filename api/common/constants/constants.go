@@ -22,6 +22,8 @@ const (
 const (
 	// KindOperatorConfig is the KIND for scaling advisor operator configuration.
 	KindOperatorConfig = "OperatorConfig"
+	// KindScalingPlannerConfig is the KIND for scaling-planner service configuration.
+	KindScalingPlannerConfig = "ScalingPlannerConfig"
 	// KindScalingAdvice is the KIND for ClusterScalingAdvice generated by the scaling-advisor operator.
 	KindScalingAdvice = "ScalingAdvice"
 	// KindScalingConstraint is the KIND for constraint object that is reconciled by the scaling-advisor operator in order to generate the scaling advice.
@@ -46,6 +48,8 @@ const (
 	LabelNodePoolName = "sa.gardener.cloud/node-pool-name"
 	// LabelNodeTemplateName is the label key to identify the node template name for which the simulation is being run.
 	LabelNodeTemplateName = "sa.gardener.cloud/node-template-name"
+	// LabelCapacityType is the well-known label key for the capacity type (on-demand or spot) of a node.
+	LabelCapacityType = "karpenter.sh/capacity-type"
 	// LabelRequestID is the label key to identify the request Name of scaling advice request.
 	LabelRequestID = "sa.gardener.cloud/request-id"
 	// LabelCorrelationID is the label key to identify the correlation Name of the scaling advice request.
@@ -61,6 +65,10 @@ const (
 
 	// LabelSnapshotNumUnscheduledPods is the label key for the number of unscheduled pods in the snapshot.
 	LabelSnapshotNumUnscheduledPods = "sa.gardener.cloud/snapshot-num-unscheduled-pods"
+
+	// LabelPartialPlan is the label key marking a scaling plan that only covers part of the unscheduled pods since the
+	// advice generation timeout of the request elapsed before all simulations completed.
+	LabelPartialPlan = "sa.gardener.cloud/partial-plan"
 )
 
 const (
@@ -77,6 +85,8 @@ const (
 
 	// DefaultGracefulShutdownTimeout is the default timeout for graceful shutdown of a server
 	DefaultGracefulShutdownTimeout = 10 * time.Second
+	// DefaultTracingSamplingRatio is the default ratio of traces without a sampled parent span that are sampled.
+	DefaultTracingSamplingRatio = 1.0
 )
 
 var (
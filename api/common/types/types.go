@@ -8,6 +8,7 @@ import (
 	"cmp"
 	"context"
 	"fmt"
+	"math"
 
 	commonerrors "github.com/gardener/scaling-advisor/api/common/errors"
 
@@ -58,6 +59,44 @@ type QPSBurst struct {
 	Burst int `json:"burst"`
 }
 
+// TracingConfig is the configuration of the OpenTelemetry tracing of a service. Tracing is disabled if neither an
+// OTLPEndpoint nor a FilePath is set.
+type TracingConfig struct {
+	// OTLPEndpoint is the address(host:port) of the OTLP gRPC collector to which spans are exported.
+	OTLPEndpoint string `json:"otlpEndpoint,omitempty"`
+	// OTLPInsecure disables transport security for the connection to the OTLPEndpoint.
+	OTLPInsecure bool `json:"otlpInsecure,omitempty"`
+	// FilePath is the path of the file to which spans are exported as JSON without requiring a collector.
+	FilePath string `json:"filePath,omitempty"`
+	// SamplingRatio is the ratio in [0, 1] of traces without a sampled parent span that are sampled, where no trace is
+	// sampled if 0 and all traces are sampled if 1. Defaults to constants.DefaultTracingSamplingRatio if nil.
+	SamplingRatio *float64 `json:"samplingRatio,omitempty"`
+}
+
+// DeepCopyInto copies the receiver into out. in must be non-nil.
+func (in *TracingConfig) DeepCopyInto(out *TracingConfig) {
+	*out = *in
+	if in.SamplingRatio != nil {
+		out.SamplingRatio = new(float64)
+		*out.SamplingRatio = *in.SamplingRatio
+	}
+}
+
+// DeepCopy copies the receiver, creating a new TracingConfig.
+func (in *TracingConfig) DeepCopy() *TracingConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// IsEnabled returns whether tracing is enabled by this TracingConfig.
+func (c TracingConfig) IsEnabled() bool {
+	return c.OTLPEndpoint != "" || c.FilePath != ""
+}
+
 // NamespacedName is a fully qualified object name.
 // NOTE: This is only needed since k8s APIMachinery types.NamespacedName does not have JSON tags and k8s maintainers
 // recommended that every project should use their own copy of NamespacedName.
@@ -149,8 +188,79 @@ const (
 	NodeScoringStrategyLeastWaste NodeScoringStrategy = "least-waste"
 	// NodeScoringStrategyLeastCost represents a scoring strategy that minimizes cost.
 	NodeScoringStrategyLeastCost NodeScoringStrategy = "least-cost"
+	// NodeScoringStrategyComposite represents a scoring strategy that minimizes a weighted combination of normalized
+	// cost, waste, unscheduled pods and zone spread as given by CompositeScoringWeights.
+	NodeScoringStrategyComposite NodeScoringStrategy = "composite"
+)
+
+// CompositeScoringWeights holds the weights of the criteria combined by the composite node scoring strategy. Each
+// criterion is normalized to [0, 1] across the candidate nodes before it is weighted.
+type CompositeScoringWeights struct {
+	// Cost is the weight of the effective hourly price of a candidate node per normalized resource unit scheduled.
+	Cost float64 `json:"cost,omitempty"`
+	// Waste is the weight of the normalized resource units left unused by the candidate node.
+	Waste float64 `json:"waste,omitempty"`
+	// UnscheduledPods is the weight of the number of pods left unscheduled by the candidate node. It serves as a proxy
+	// for the number of further nodes required, which is not estimated since it depends on the nodes scaled later.
+	UnscheduledPods float64 `json:"unscheduledPods,omitempty"`
+	// ZoneSpread is the weight of the number of existing plus planned nodes of the node pool of the candidate node in its
+	// availability zone.
+	ZoneSpread float64 `json:"zoneSpread,omitempty"`
+}
+
+// DefaultCompositeScoringWeights are the CompositeScoringWeights used if none are given.
+var DefaultCompositeScoringWeights = CompositeScoringWeights{Cost: 1, Waste: 1, UnscheduledPods: 1, ZoneSpread: 1}
+
+// Validate returns an error if any of the weights is negative or not finite, or if all of them are zero.
+func (w CompositeScoringWeights) Validate() error {
+	for _, weight := range []struct {
+		name  string
+		value float64
+	}{{"cost", w.Cost}, {"waste", w.Waste}, {"unscheduledPods", w.UnscheduledPods}, {"zoneSpread", w.ZoneSpread}} {
+		if !(weight.value >= 0) || math.IsInf(weight.value, 0) {
+			return fmt.Errorf("composite scoring weight %q must be a non-negative number, got %v", weight.name, weight.value)
+		}
+	}
+	if w == (CompositeScoringWeights{}) {
+		return fmt.Errorf("at least one composite scoring weight must be positive")
+	}
+	return nil
+}
+
+// CapacityType represents the purchase option for the capacity of an instance.
+// +enum
+type CapacityType string
+
+const (
+	// CapacityTypeOnDemand indicates on-demand capacity, which is the default if no capacity type is specified.
+	CapacityTypeOnDemand CapacityType = "on-demand"
+	// CapacityTypeSpot indicates spot (preemptible) capacity, which is cheaper than on-demand capacity but can be
+	// interrupted by the cloud provider.
+	CapacityTypeSpot CapacityType = "spot"
 )
 
+// IsSpot returns true if the capacity type is spot.
+func (c CapacityType) IsSpot() bool {
+	return c == CapacityTypeSpot
+}
+
+// Canonical returns the canonical form of the capacity type in which on-demand capacity is denoted by the empty
+// capacity type, so that capacity types compare equal irrespective of whether on-demand was specified explicitly.
+func (c CapacityType) Canonical() CapacityType {
+	if c == CapacityTypeOnDemand {
+		return ""
+	}
+	return c
+}
+
+// OrDefault returns the capacity type, or CapacityTypeOnDemand if it is empty.
+func (c CapacityType) OrDefault() CapacityType {
+	if c == "" {
+		return CapacityTypeOnDemand
+	}
+	return c
+}
+
 // CloudProvider represents the cloud provider type for the cluster.
 // +enum
 type CloudProvider string
@@ -9,8 +9,12 @@ import (
 	"time"
 
 	"github.com/gardener/scaling-advisor/api/common/constants"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 const (
@@ -70,3 +74,61 @@ func SetDefaults_ScalingConstraintsControllerConfiguration(scalingConstraintsCon
 		scalingConstraintsConfig.ConcurrentSyncs = defaultConcurrentSyncs
 	}
 }
+
+// SetDefaults_ScalingPlannerConfig sets defaults for the ScalingPlannerConfig.
+func SetDefaults_ScalingPlannerConfig(plannerConfig *ScalingPlannerConfig) {
+	if strings.TrimSpace(plannerConfig.Server.BindAddress) == "" {
+		plannerConfig.Server.BindAddress = constants.DefaultAdvisorServiceBindAddress
+	}
+	if plannerConfig.Server.GracefulShutdownTimeout.Duration == 0 {
+		plannerConfig.Server.GracefulShutdownTimeout = metav1.Duration{Duration: constants.DefaultGracefulShutdownTimeout}
+	}
+	if plannerConfig.CloudProvider == "" {
+		plannerConfig.CloudProvider = commontypes.CloudProviderAWS
+	}
+	if plannerConfig.Tracing.SamplingRatio == nil {
+		plannerConfig.Tracing.SamplingRatio = ptr.To(constants.DefaultTracingSamplingRatio)
+	}
+}
+
+// SetDefaults_MinKAPIConfig sets defaults for the MinKAPIConfig of the ScalingPlannerConfig.
+func SetDefaults_MinKAPIConfig(minKAPIConfig *MinKAPIConfig) {
+	if strings.TrimSpace(minKAPIConfig.BindAddress) == "" {
+		minKAPIConfig.BindAddress = constants.DefaultMinKAPIBindAddress
+	}
+	if minKAPIConfig.WatchQueueSize == 0 {
+		minKAPIConfig.WatchQueueSize = minkapi.DefaultWatchQueueSize
+	}
+	if minKAPIConfig.WatchTimeout.Duration == 0 {
+		minKAPIConfig.WatchTimeout = metav1.Duration{Duration: minkapi.DefaultWatchTimeout}
+	}
+}
+
+// SetDefaults_SimulatorConfig sets defaults for the SimulatorConfig of the ScalingPlannerConfig.
+func SetDefaults_SimulatorConfig(simulatorConfig *SimulatorConfig) {
+	if simulatorConfig.MaxParallelSimulations == 0 {
+		simulatorConfig.MaxParallelSimulations = plannerapi.DefaultMaxParallelSimulations
+	}
+	if simulatorConfig.TrackPollInterval.Duration == 0 {
+		simulatorConfig.TrackPollInterval = metav1.Duration{Duration: plannerapi.DefaultTrackPollInterval}
+	}
+	if simulatorConfig.MaxUnchangedTrackAttempts == 0 {
+		simulatorConfig.MaxUnchangedTrackAttempts = plannerapi.DefaultMaxUnchangedTrackAttempts
+	}
+	if simulatorConfig.LookaheadDepth == 0 {
+		simulatorConfig.LookaheadDepth = plannerapi.DefaultLookaheadDepth
+	}
+}
+
+// SetDefaults_PlanJobConfig sets defaults for the PlanJobConfig of the ScalingPlannerConfig.
+func SetDefaults_PlanJobConfig(planJobConfig *PlanJobConfig) {
+	if planJobConfig.MaxConcurrentPlanJobs == 0 {
+		planJobConfig.MaxConcurrentPlanJobs = plannerapi.DefaultMaxConcurrentPlanJobs
+	}
+	if planJobConfig.MaxQueuedPlanJobs == 0 {
+		planJobConfig.MaxQueuedPlanJobs = plannerapi.DefaultMaxQueuedPlanJobs
+	}
+	if planJobConfig.Retention.Duration == 0 {
+		planJobConfig.Retention = metav1.Duration{Duration: plannerapi.DefaultPlanJobRetention}
+	}
+}
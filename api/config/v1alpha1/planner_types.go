@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ScalingPlannerConfig defines the configuration for the scaling-planner service.
+//
+//nolint:govet // fieldalignment: intentional layout for wire compatibility
+type ScalingPlannerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+	// Server is the configuration of the HTTP server of the scaling planner.
+	Server commontypes.ServerConfig `json:"server"`
+	// GRPCBindAddress is the bind address of the gRPC server of the scaling planner, which is not started if empty.
+	// +optional
+	GRPCBindAddress string `json:"grpcBindAddress,omitempty"`
+	// MinKAPI is the configuration of the MinKAPI server embedded by the scaling planner.
+	MinKAPI MinKAPIConfig `json:"minKAPI"`
+	// ClientConnection holds the QPS and Burst of the clients of the embedded MinKAPI server.
+	ClientConnection commontypes.QPSBurst `json:"clientConnection"`
+	// CloudProvider is the cloud provider for which the scaling planner is initialized. Defaults to aws.
+	CloudProvider commontypes.CloudProvider `json:"cloudProvider"`
+	// InstancePricingPath is the path to the instance pricing file of the CloudProvider.
+	InstancePricingPath string `json:"instancePricingPath"`
+	// ResourceWeightsConfigPath is the path to the optional ResourceWeightsConfig file overriding resource weights.
+	// +optional
+	ResourceWeightsConfigPath string `json:"resourceWeightsConfigPath,omitempty"`
+	// VolumeLimitsCatalogPath is the path to the optional VolumeLimitsCatalog file overriding the default CSI drivers and
+	// maximum number of attachable volumes per instance type of the CloudProvider. The default catalog only contains
+	// AWS, other cloud providers default to the CSI driver of their block storage with a conservative 16 volumes.
+	// +optional
+	VolumeLimitsCatalogPath string `json:"volumeLimitsCatalogPath,omitempty"`
+	// TraceDir is the base directory for storing trace files produced by the scaling planner.
+	// +optional
+	TraceDir string `json:"traceDir,omitempty"`
+	// Simulator is the configuration of the simulators of the scaling planner.
+	Simulator SimulatorConfig `json:"simulator"`
+	// PlanJobs is the configuration of the plan jobs of the scaling planner.
+	PlanJobs PlanJobConfig `json:"planJobs"`
+	// Tracing is the configuration of the OpenTelemetry tracing of the scaling planner.
+	// +optional
+	Tracing commontypes.TracingConfig `json:"tracing,omitempty"`
+}
+
+// MinKAPIConfig is the configuration of the MinKAPI server embedded by the scaling planner.
+type MinKAPIConfig struct {
+	// BindAddress is the address(host:port) to bind the embedded MinKAPI server to.
+	BindAddress string `json:"bindAddress,omitempty"`
+	// WatchQueueSize is the maximum number of events to queue per watcher.
+	WatchQueueSize int `json:"watchQueueSize,omitempty"`
+	// WatchTimeout is the timeout for watches after which the embedded MinKAPI server closes the connection.
+	WatchTimeout metav1.Duration `json:"watchTimeout,omitempty"`
+}
+
+// SimulatorConfig is the configuration of the simulators of the scaling planner.
+type SimulatorConfig struct {
+	// MaxParallelSimulations is the maximum number of parallel simulations.
+	MaxParallelSimulations int `json:"maxParallelSimulations,omitempty"`
+	// TrackPollInterval is the poll interval for tracking pod scheduling in the view of the simulator.
+	TrackPollInterval metav1.Duration `json:"trackPollInterval,omitempty"`
+	// MaxUnchangedTrackAttempts is the maximum number of unchanged track attempts after which a simulation run is
+	// considered as stabilized.
+	MaxUnchangedTrackAttempts int `json:"maxUnchangedTrackAttempts,omitempty"`
+	// BindVolumeClaimsForImmediateMode specifies whether the simulator binds unbound claims of volume binding mode
+	// Immediate.
+	BindVolumeClaimsForImmediateMode bool `json:"bindVolumeClaimsForImmediateMode,omitempty"`
+	// LookaheadBeamWidth is the number of candidate views retained per pass by the scale-out lookahead search. Values
+	// less than 2 disable it.
+	LookaheadBeamWidth int `json:"lookaheadBeamWidth,omitempty"`
+	// LookaheadDepth is the maximum number of passes expanded by the scale-out lookahead search.
+	LookaheadDepth int `json:"lookaheadDepth,omitempty"`
+	// SpotInterruptionPenaltyPercent is the percentage by which the hourly price of spot capacity is increased to
+	// account for the risk of interruption. The increased price is used by all node scoring strategies and by the
+	// scale-out lookahead search.
+	SpotInterruptionPenaltyPercent float64 `json:"spotInterruptionPenaltyPercent,omitempty"`
+}
+
+// PlanJobConfig is the configuration of the plan jobs of the scaling planner.
+type PlanJobConfig struct {
+	// MaxConcurrentPlanJobs is the maximum number of plan jobs that are run concurrently. Plans requested directly from
+	// the service count towards this limit and are rejected once it is reached.
+	MaxConcurrentPlanJobs int `json:"maxConcurrentPlanJobs,omitempty"`
+	// MaxQueuedPlanJobs is the maximum number of submitted plan jobs that wait to be run.
+	MaxQueuedPlanJobs int `json:"maxQueuedPlanJobs,omitempty"`
+	// Retention is the duration for which finished plan jobs are retained.
+	Retention metav1.Duration `json:"retention,omitempty"`
+}
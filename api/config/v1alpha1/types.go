@@ -99,8 +99,13 @@ type ScalingAdviceGenerationConfig struct {
 	Mode commontypes.ScalingAdviceGenerationMode `json:"mode"`
 	// SimulatorStrategy defines the simulator strategy used by the ScaleOutSimulator implementation.
 	SimulatorStrategy commontypes.SimulatorStrategy `json:"simulatorStrategy"`
-	// ScoringStrategy defines the node scoring strategy to use for scaling decisions.
+	// ScoringStrategy defines the node scoring strategy to use for scaling decisions. Besides the built-in strategies,
+	// this can be any strategy registered with the NodeScorerRegistry of the scaling planner.
 	ScoringStrategy commontypes.NodeScoringStrategy `json:"scoringStrategy"`
+	// CompositeScoringWeights holds the weights for the composite node scoring strategy. Defaults to
+	// commontypes.DefaultCompositeScoringWeights.
+	// +optional
+	CompositeScoringWeights *commontypes.CompositeScoringWeights `json:"compositeScoringWeights,omitempty"`
 }
 
 // ControllersConfig defines the configuration for controllers that are run as part of the scaling-advisor.
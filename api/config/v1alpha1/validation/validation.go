@@ -5,6 +5,9 @@
 package validation
 
 import (
+	"strings"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
 	configv1apha1 "github.com/gardener/scaling-advisor/api/config/v1alpha1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -16,10 +19,71 @@ func ValidateScalingAdvisorConfiguration(config *configv1apha1.OperatorConfig) f
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, validateClientConnectionConfiguration(config.ClientConnection, field.NewPath("clientConnection"))...)
 	allErrs = append(allErrs, validateLeaderElectionConfiguration(config.LeaderElection, field.NewPath("leaderElection"))...)
+	allErrs = append(allErrs, validateScalingAdviceGenerationConfig(config.AdviceGeneration, field.NewPath("adviceGeneration"))...)
 	// TODO add validation here.
 	return allErrs
 }
 
+// ValidateScalingPlannerConfig validates the ScalingPlannerConfig.
+func ValidateScalingPlannerConfig(config *configv1apha1.ScalingPlannerConfig) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if strings.TrimSpace(config.Server.BindAddress) == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("server", "bindAddress"), "bindAddress is required"))
+	}
+	if _, err := commontypes.AsCloudProvider(string(config.CloudProvider)); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("cloudProvider"), config.CloudProvider, err.Error()))
+	}
+	if strings.TrimSpace(config.InstancePricingPath) == "" {
+		allErrs = append(allErrs, field.Required(field.NewPath("instancePricingPath"), "instancePricingPath is required"))
+	}
+	if config.ClientConnection.Burst < 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("clientConnection", "burst"), config.ClientConnection.Burst, "burst must be non-negative"))
+	}
+	allErrs = append(allErrs, validateMinKAPIConfig(config.MinKAPI, field.NewPath("minKAPI"))...)
+	allErrs = append(allErrs, validateSimulatorConfig(config.Simulator, field.NewPath("simulator"))...)
+	allErrs = append(allErrs, validatePlanJobConfig(config.PlanJobs, field.NewPath("planJobs"))...)
+	if ratio := config.Tracing.SamplingRatio; ratio != nil && (*ratio < 0 || *ratio > 1) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("tracing", "samplingRatio"), *ratio, "samplingRatio must be between 0 and 1"))
+	}
+	return allErrs
+}
+
+// validateMinKAPIConfig validates the configuration of the embedded MinKAPI server.
+func validateMinKAPIConfig(config configv1apha1.MinKAPIConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if strings.TrimSpace(config.BindAddress) == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("bindAddress"), "bindAddress is required"))
+	}
+	allErrs = append(allErrs, mustBeGreaterThanZero(config.WatchQueueSize, fldPath.Child("watchQueueSize"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(config.WatchTimeout, fldPath.Child("watchTimeout"))...)
+	return allErrs
+}
+
+// validateSimulatorConfig validates the simulator configuration.
+func validateSimulatorConfig(config configv1apha1.SimulatorConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, mustBeGreaterThanZero(config.MaxParallelSimulations, fldPath.Child("maxParallelSimulations"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(config.TrackPollInterval, fldPath.Child("trackPollInterval"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZero(config.MaxUnchangedTrackAttempts, fldPath.Child("maxUnchangedTrackAttempts"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZero(config.LookaheadDepth, fldPath.Child("lookaheadDepth"))...)
+	if config.LookaheadBeamWidth < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("lookaheadBeamWidth"), config.LookaheadBeamWidth, "lookaheadBeamWidth must be non-negative"))
+	}
+	if config.SpotInterruptionPenaltyPercent < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("spotInterruptionPenaltyPercent"), config.SpotInterruptionPenaltyPercent, "spotInterruptionPenaltyPercent must be non-negative"))
+	}
+	return allErrs
+}
+
+// validatePlanJobConfig validates the plan job configuration.
+func validatePlanJobConfig(config configv1apha1.PlanJobConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	allErrs = append(allErrs, mustBeGreaterThanZero(config.MaxConcurrentPlanJobs, fldPath.Child("maxConcurrentPlanJobs"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZero(config.MaxQueuedPlanJobs, fldPath.Child("maxQueuedPlanJobs"))...)
+	allErrs = append(allErrs, mustBeGreaterThanZeroDuration(config.Retention, fldPath.Child("retention"))...)
+	return allErrs
+}
+
 // validateClientConnectionConfiguration validates the client connection configuration.
 func validateClientConnectionConfiguration(config configv1apha1.ClientConnectionConfig, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
@@ -29,6 +93,17 @@ func validateClientConnectionConfiguration(config configv1apha1.ClientConnection
 	return allErrs
 }
 
+// validateScalingAdviceGenerationConfig validates the scaling advice generation configuration.
+func validateScalingAdviceGenerationConfig(config configv1apha1.ScalingAdviceGenerationConfig, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if config.CompositeScoringWeights != nil {
+		if err := config.CompositeScoringWeights.Validate(); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("compositeScoringWeights"), *config.CompositeScoringWeights, err.Error()))
+		}
+	}
+	return allErrs
+}
+
 // validateLeaderElectionConfiguration validates the leader election configuration.
 func validateLeaderElectionConfiguration(config configv1apha1.LeaderElectionConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -61,3 +136,12 @@ func mustBeGreaterThanZeroDuration(duration metav1.Duration, fldPath *field.Path
 	}
 	return allErrs
 }
+
+// mustBeGreaterThanZero validates that a value is greater than zero.
+func mustBeGreaterThanZero(value int, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if value <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, value, "must be greater than 0"))
+	}
+	return allErrs
+}
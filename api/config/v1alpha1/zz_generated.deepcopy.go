@@ -9,6 +9,7 @@
 package v1alpha1
 
 import (
+	types "github.com/gardener/scaling-advisor/api/common/types"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -64,12 +65,29 @@ func (in *LeaderElectionConfig) DeepCopy() *LeaderElectionConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MinKAPIConfig) DeepCopyInto(out *MinKAPIConfig) {
+	*out = *in
+	out.WatchTimeout = in.WatchTimeout
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MinKAPIConfig.
+func (in *MinKAPIConfig) DeepCopy() *MinKAPIConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MinKAPIConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperatorConfig) DeepCopyInto(out *OperatorConfig) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	out.Server = in.Server
-	out.AdviceGeneration = in.AdviceGeneration
+	in.AdviceGeneration.DeepCopyInto(&out.AdviceGeneration)
 	out.ClientConnection = in.ClientConnection
 	out.LeaderElection = in.LeaderElection
 	out.Controllers = in.Controllers
@@ -94,9 +112,31 @@ func (in *OperatorConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlanJobConfig) DeepCopyInto(out *PlanJobConfig) {
+	*out = *in
+	out.Retention = in.Retention
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlanJobConfig.
+func (in *PlanJobConfig) DeepCopy() *PlanJobConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(PlanJobConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScalingAdviceGenerationConfig) DeepCopyInto(out *ScalingAdviceGenerationConfig) {
 	*out = *in
+	if in.CompositeScoringWeights != nil {
+		in, out := &in.CompositeScoringWeights, &out.CompositeScoringWeights
+		*out = new(types.CompositeScoringWeights)
+		**out = **in
+	}
 	return
 }
 
@@ -141,3 +181,51 @@ func (in *ScalingConstraintsControllerConfig) DeepCopy() *ScalingConstraintsCont
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScalingPlannerConfig) DeepCopyInto(out *ScalingPlannerConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	out.Server = in.Server
+	out.MinKAPI = in.MinKAPI
+	out.ClientConnection = in.ClientConnection
+	out.Simulator = in.Simulator
+	out.PlanJobs = in.PlanJobs
+	in.Tracing.DeepCopyInto(&out.Tracing)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScalingPlannerConfig.
+func (in *ScalingPlannerConfig) DeepCopy() *ScalingPlannerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScalingPlannerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ScalingPlannerConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SimulatorConfig) DeepCopyInto(out *SimulatorConfig) {
+	*out = *in
+	out.TrackPollInterval = in.TrackPollInterval
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SimulatorConfig.
+func (in *SimulatorConfig) DeepCopy() *SimulatorConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(SimulatorConfig)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -16,5 +16,13 @@ import (
 // Public to allow building arbitrary schemes.
 // All generated defaulters are covering - they call all nested defaulters.
 func RegisterDefaults(scheme *runtime.Scheme) error {
+	scheme.AddTypeDefaultingFunc(&ScalingPlannerConfig{}, func(obj interface{}) { SetObjectDefaults_ScalingPlannerConfig(obj.(*ScalingPlannerConfig)) })
 	return nil
 }
+
+func SetObjectDefaults_ScalingPlannerConfig(in *ScalingPlannerConfig) {
+	SetDefaults_ScalingPlannerConfig(in)
+	SetDefaults_MinKAPIConfig(&in.MinKAPI)
+	SetDefaults_SimulatorConfig(&in.Simulator)
+	SetDefaults_PlanJobConfig(&in.PlanJobs)
+}
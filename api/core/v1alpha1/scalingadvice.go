@@ -5,6 +5,8 @@
 package v1alpha1
 
 import (
+	"cmp"
+
 	apicommon "github.com/gardener/scaling-advisor/api/common/types"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -62,8 +64,39 @@ type ScalingAdviceStatus struct {
 type ScaleOutPlan struct {
 	// UnsatisfiedPodNames is the list of all pods (namespace/name) that could not be satisfied by the scale out plan.
 	UnsatisfiedPodNames []string `json:"unsatisfiedPodNames,omitempty"`
+	// QuotaUnsatisfiedPodNames is the list of all pods (namespace/name) that could not be satisfied by the scale out plan
+	// since scaling the node pools that could host them would exceed the quota of these pools. Pods that could not be
+	// satisfied since it would exceed the maximum node count of these pools are part of UnsatisfiedPodNames.
+	QuotaUnsatisfiedPodNames []string `json:"quotaUnsatisfiedPodNames,omitempty"`
+	// UnsatisfiedPodExplanations explains why the pods of UnsatisfiedPodNames for which the kube-scheduler reported a
+	// scheduling failure in the simulations could not be scheduled.
+	UnsatisfiedPodExplanations []UnsatisfiedPodExplanation `json:"unsatisfiedPodExplanations,omitempty"`
+	// UnsatisfiedPodReasons aggregates the reasons of the UnsatisfiedPodExplanations by reason.
+	UnsatisfiedPodReasons []UnsatisfiedPodReason `json:"unsatisfiedPodReasons,omitempty"`
 	// Items is the slice of scaling-out advice for a node pool.
 	Items []ScaleOutItem `json:"items"`
+	// TotalHourlyCostDelta is the projected change in hourly cost if the plan is applied, which is the sum of the
+	// HourlyCostDelta of all Items. It is nil if the HourlyCostDelta of any of the Items is unknown, since a partial sum
+	// would understate the cost of the plan.
+	// +optional
+	TotalHourlyCostDelta *float64 `json:"totalHourlyCostDelta,omitempty"`
+}
+
+// UnsatisfiedPodExplanation explains why a pod could not be satisfied by a scale out plan.
+type UnsatisfiedPodExplanation struct {
+	// PodName is the namespace/name of the pod.
+	PodName string `json:"podName"`
+	// Reasons are the distinct kube-scheduler failure reasons for the pod without node counts, e.g. "Insufficient
+	// nvidia.com/gpu", reported by the simulations of the last pass in which the pod could not be scheduled.
+	Reasons []string `json:"reasons"`
+}
+
+// UnsatisfiedPodReason is a kube-scheduler failure reason along with the pods for which it was reported.
+type UnsatisfiedPodReason struct {
+	// Reason is the kube-scheduler failure reason.
+	Reason string `json:"reason"`
+	// PodNames is the list of pods (namespace/name) for which the Reason was reported.
+	PodNames []string `json:"podNames"`
 }
 
 // ScaleInPlan is the plan for scaling in a node pool and/or targeted set of nodes.
@@ -86,6 +119,13 @@ type ScaleOutItem struct {
 	CurrentReplicas int32 `json:"currentReplicas"`
 	// Delta is the delta change in the number of nodes for the NodePlacement.
 	Delta int32 `json:"delta"`
+	// HourlyCostDelta is the change in hourly cost caused by the Delta, which is the Delta times the hourly price of
+	// the instance type of the NodePlacement. It is nil if no price is known for the NodePlacement.
+	// +optional
+	HourlyCostDelta *float64 `json:"hourlyCostDelta,omitempty"`
+	// PodNames is the list of pods (namespace/name) that are placed on the nodes of the Delta in the simulations and
+	// hence motivate this item.
+	PodNames []string `json:"podNames,omitempty"`
 }
 
 // NodePlacement provides information about the placement of a node.
@@ -100,6 +140,21 @@ type NodePlacement struct {
 	Region string `json:"region"`
 	// AvailabilityZone is the availability zone of the node pool.
 	AvailabilityZone string `json:"availabilityZone"`
+	// CapacityType is the capacity type of the Node. It is empty for on-demand capacity.
+	CapacityType apicommon.CapacityType `json:"capacityType,omitempty"`
+}
+
+// CmpNodePlacement is a cmp function for NodePlacement that compares by PoolName, TemplateName, AvailabilityZone,
+// InstanceType, Region and CapacityType.
+func CmpNodePlacement(a, b NodePlacement) int {
+	return cmp.Or(
+		cmp.Compare(a.PoolName, b.PoolName),
+		cmp.Compare(a.TemplateName, b.TemplateName),
+		cmp.Compare(a.AvailabilityZone, b.AvailabilityZone),
+		cmp.Compare(a.InstanceType, b.InstanceType),
+		cmp.Compare(a.Region, b.Region),
+		cmp.Compare(a.CapacityType, b.CapacityType),
+	)
 }
 
 // ScalingAdviceDiagnostic provides diagnostics information for the scaling advice.
@@ -7,6 +7,8 @@ package v1alpha1
 import (
 	"slices"
 
+	apicommon "github.com/gardener/scaling-advisor/api/common/types"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -43,7 +45,8 @@ type ScalingConstraintSpec struct {
 	// DefaultBackoffPolicy defines a default backoff policy for all NodePools of a cluster. Backoff policy can be overridden at the NodePool level.
 	// +optional
 	DefaultBackoffPolicy *BackoffPolicy `json:"defaultBackoffPolicy,omitempty"`
-	// ScaleInPolicy defines the default scale in policy to be used when scaling in a node pool.
+	// ScaleInPolicy defines the default scale in policy to be used when scaling in a node pool. Scale-in is only
+	// simulated for node pools with a ScaleInPolicy of their own or this default ScaleInPolicy.
 	// +optional
 	ScaleInPolicy *ScaleInPolicy `json:"scaleInPolicy,omitempty"`
 	// ConsumerID is the Name of the consumer who creates the scaling constraint and is the target for cluster scaling advice.
@@ -54,6 +57,16 @@ type ScalingConstraintSpec struct {
 	NodePools []NodePool `json:"nodePools,omitempty"`
 }
 
+// GetNodePool returns the NodePool with the given name or nil if no such NodePool exists.
+func (c *ScalingConstraintSpec) GetNodePool(name string) *NodePool {
+	for i := range c.NodePools {
+		if c.NodePools[i].Name == name {
+			return &c.NodePools[i]
+		}
+	}
+	return nil
+}
+
 // GetAllAvailabilityZones gets all the availability zones across all node pools as a sorted slice.
 func (c *ScalingConstraintSpec) GetAllAvailabilityZones() []string {
 	zoneSet := sets.NewString()
@@ -79,7 +92,18 @@ type NodePool struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 	// Quota defines the quota for the node pool.
 	Quota corev1.ResourceList `json:"quota,omitempty"`
-	// ScaleInPolicy defines the scale in policy for this node pool.
+	// MinNodes is the minimum number of nodes of the node pool across all its node templates and availability zones.
+	// +optional
+	MinNodes *int32 `json:"minNodes,omitempty"`
+	// MaxNodes is the maximum number of nodes of the node pool across all its node templates and availability zones.
+	// +optional
+	MaxNodes *int32 `json:"maxNodes,omitempty"`
+	// ZoneBalance defines how scale-out of this node pool is balanced across its availability zones. Defaults to
+	// ZoneBalancePolicyNone.
+	// +optional
+	ZoneBalance ZoneBalancePolicy `json:"zoneBalance,omitempty"`
+	// ScaleInPolicy defines the scale in policy for this node pool. Scale-in of this node pool is only simulated if it or
+	// the default ScaleInPolicy of the ScalingConstraintSpec is set.
 	// +optional
 	ScaleInPolicy *ScaleInPolicy `json:"scaleInPolicy,omitempty"`
 	// BackoffPolicy defines the backoff policy applicable to resource exhaustion of any instance type + zone combination in this node pool.
@@ -109,6 +133,7 @@ func (p *NodePool) GetNodePlacements() []NodePlacement {
 				InstanceType:     nt.InstanceType,
 				Region:           p.Region,
 				AvailabilityZone: az,
+				CapacityType:     nt.CapacityType.Canonical(),
 			})
 		}
 	}
@@ -137,8 +162,20 @@ type NodeTemplate struct {
 	InstanceType string `json:"instanceType"`
 	// Priority is the priority of the node template. The lower the number, the higher the priority.
 	Priority int32 `json:"priority"`
-	// MaxVolumes is the max number of volumes that can be attached to a node of this instance type.
+	// MaxVolumes is the max number of volumes that can be attached to a node of this instance type. If unset, the max
+	// number of volumes of the instance type in the volume limits catalog of the cloud provider is used.
+	// +optional
 	MaxVolumes int32 `json:"maxVolumes,omitzero"`
+	// CapacityType is the capacity type of the nodes of this node template. Defaults to on-demand.
+	// +kubebuilder:validation:Enum=on-demand;spot
+	// +optional
+	CapacityType apicommon.CapacityType `json:"capacityType,omitempty"`
+	// MinNodes is the minimum number of nodes of this node template across all availability zones of the node pool.
+	// +optional
+	MinNodes *int32 `json:"minNodes,omitempty"`
+	// MaxNodes is the maximum number of nodes of this node template across all availability zones of the node pool.
+	// +optional
+	MaxNodes *int32 `json:"maxNodes,omitempty"`
 }
 
 // InstancePricing contains the pricing information for an instance type.
@@ -159,6 +196,27 @@ type InstancePricing struct {
 	Price float64 `json:"price"`
 }
 
+// ZoneBalancePolicy defines how scale-out of a node pool is balanced across its availability zones.
+// +enum
+// +kubebuilder:validation:Enum=strict;preferred;none
+type ZoneBalancePolicy string
+
+const (
+	// ZoneBalancePolicyStrict always scales the node pool in the availability zone with the fewest existing plus planned
+	// nodes of the pool, irrespective of the scores of the candidate nodes in other zones of the pool.
+	ZoneBalancePolicyStrict ZoneBalancePolicy = "strict"
+	// ZoneBalancePolicyPreferred scales the node pool in the availability zone with the fewest existing plus planned nodes
+	// of the pool when the candidate nodes in several zones have equal scores or scores within a tolerance.
+	ZoneBalancePolicyPreferred ZoneBalancePolicy = "preferred"
+	// ZoneBalancePolicyNone does not balance scale-out of the node pool across its availability zones.
+	ZoneBalancePolicyNone ZoneBalancePolicy = "none"
+)
+
+// IsBalanced checks whether the ZoneBalancePolicy requires balancing across availability zones.
+func (z ZoneBalancePolicy) IsBalanced() bool {
+	return z == ZoneBalancePolicyStrict || z == ZoneBalancePolicyPreferred
+}
+
 // BackoffPolicy defines the backoff policy to be used when backing off from suggesting an instance type + zone in subsequence scaling advice upon failed scaling operation.
 type BackoffPolicy struct {
 	// InitialBackoffDuration defines the lower limit of the backoff duration.
@@ -169,5 +227,51 @@ type BackoffPolicy struct {
 
 // ScaleInPolicy defines the scale in policy to be used when scaling in a node pool.
 type ScaleInPolicy struct {
-	//TODO design this better.
+	// UtilizationThresholdPercent is the resource utilization, in percent of node allocatable, below which a node is
+	// considered underutilized and becomes a candidate for scale-in. The utilization of a node is the maximum of the ratio
+	// of the aggregated pod requests to the allocatable of the node across cpu and memory.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	UtilizationThresholdPercent *int32 `json:"utilizationThresholdPercent,omitempty"`
+	// MaxNodesPerPlan is the maximum number of nodes that can be removed from a node pool in a single scale-in plan.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxNodesPerPlan *int32 `json:"maxNodesPerPlan,omitempty"`
+	// MinNodeAge is the minimum duration since creation of a node before it is considered for scale-in. Recent scale
+	// activity of the node pool is not tracked, so the age of each node guards against removing freshly scaled nodes.
+	// +optional
+	MinNodeAge *metav1.Duration `json:"minNodeAge,omitempty"`
+}
+
+// IsScaleInEnabled checks whether this ScalingConstraintSpec has a default ScaleInPolicy or a NodePool with a
+// ScaleInPolicy.
+func (c *ScalingConstraintSpec) IsScaleInEnabled() bool {
+	if c.ScaleInPolicy != nil {
+		return true
+	}
+	for _, p := range c.NodePools {
+		if p.ScaleInPolicy != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// GetScaleInPolicy returns the ScaleInPolicy of the given NodePool if set, else the default ScaleInPolicy of this
+// ScalingConstraintSpec which may be nil.
+func (c *ScalingConstraintSpec) GetScaleInPolicy(pool *NodePool) *ScaleInPolicy {
+	if pool.ScaleInPolicy != nil {
+		return pool.ScaleInPolicy
+	}
+	return c.ScaleInPolicy
+}
+
+// GetBackoffPolicy returns the BackoffPolicy of the given NodePool if set, else the default BackoffPolicy of this
+// ScalingConstraintSpec which may be nil.
+func (c *ScalingConstraintSpec) GetBackoffPolicy(pool *NodePool) *BackoffPolicy {
+	if pool.BackoffPolicy != nil {
+		return pool.BackoffPolicy
+	}
+	return c.DefaultBackoffPolicy
 }
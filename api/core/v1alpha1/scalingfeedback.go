@@ -55,16 +55,24 @@ const (
 	ScalingErrorTypeCreationTimeout ScalingErrorType = "CreationTimeoutError"
 )
 
-// ScaleOutErrorInfo is the backoff information for each instance type + zone.
+// ScaleOutErrorInfo is the backoff information for each instance type + capacity type + zone.
 type ScaleOutErrorInfo struct {
 	// AvailabilityZone is the availability zone of the node pool.
 	AvailabilityZone string `json:"availabilityZone"`
 	// InstanceType is the instance type of the node pool.
 	InstanceType string `json:"instanceType"`
+	// CapacityType is the capacity type of the nodes that have failed creation. Defaults to on-demand.
+	// +kubebuilder:validation:Enum=on-demand;spot
+	// +optional
+	CapacityType apicommon.CapacityType `json:"capacityType,omitempty"`
 	// ErrorType is the type of error that occurred during scale-out.
 	ErrorType ScalingErrorType `json:"errorType"`
 	// FailCount is the number of nodes that have failed creation.
 	FailCount int32 `json:"failCount"`
+	// LastFailureTime is the time at which the last node creation failed. If not set, no failure time is known and the
+	// instance type, capacity type and availability zone are not backed off.
+	// +optional
+	LastFailureTime metav1.Time `json:"lastFailureTime,omitzero"`
 }
 
 // ScaleInErrorInfo is the information about nodes that could not be deleted for scale-in.
@@ -10,6 +10,17 @@ import (
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// ValidateScalingConstraintSpec validates the given ScalingConstraintSpec including all of its NodePools.
+func ValidateScalingConstraintSpec(spec *ScalingConstraintSpec, fldPath *field.Path) (allErrs field.ErrorList) {
+	if spec.ScaleInPolicy != nil {
+		allErrs = append(allErrs, ValidateScaleInPolicy(spec.ScaleInPolicy, fldPath.Child("scaleInPolicy"))...)
+	}
+	for i := range spec.NodePools {
+		allErrs = append(allErrs, ValidateNodePool(&spec.NodePools[i], fldPath.Child("nodePools").Index(i))...)
+	}
+	return allErrs
+}
+
 // ValidateNodePool validates a NodePool object.
 func ValidateNodePool(np *NodePool, fldPath *field.Path) (allErrs field.ErrorList) {
 	if strings.TrimSpace(np.Region) == "" {
@@ -25,6 +36,51 @@ func ValidateNodePool(np *NodePool, fldPath *field.Path) (allErrs field.ErrorLis
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("priority"), np.Priority, "priority must be non-negative"))
 	}
 	// TODO add checks for Quota
+	switch np.ZoneBalance {
+	case "", ZoneBalancePolicyStrict, ZoneBalancePolicyPreferred, ZoneBalancePolicyNone:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("zoneBalance"), np.ZoneBalance,
+			[]ZoneBalancePolicy{ZoneBalancePolicyStrict, ZoneBalancePolicyPreferred, ZoneBalancePolicyNone}))
+	}
+	allErrs = append(allErrs, validateMinMaxNodes(np.MinNodes, np.MaxNodes, fldPath)...)
+	for i, nt := range np.NodeTemplates {
+		ntPath := fldPath.Child("nodeTemplates").Index(i)
+		allErrs = append(allErrs, validateMinMaxNodes(nt.MinNodes, nt.MaxNodes, ntPath)...)
+		if nt.MinNodes != nil && np.MaxNodes != nil && *nt.MinNodes > *np.MaxNodes {
+			allErrs = append(allErrs, field.Invalid(ntPath.Child("minNodes"), *nt.MinNodes, "minNodes must not be greater than maxNodes of the node pool"))
+		}
+	}
+	if np.ScaleInPolicy != nil {
+		allErrs = append(allErrs, ValidateScaleInPolicy(np.ScaleInPolicy, fldPath.Child("scaleInPolicy"))...)
+	}
+	return allErrs
+}
+
+// ValidateScaleInPolicy validates a ScaleInPolicy object.
+func ValidateScaleInPolicy(p *ScaleInPolicy, fldPath *field.Path) (allErrs field.ErrorList) {
+	if p.UtilizationThresholdPercent != nil && (*p.UtilizationThresholdPercent < 0 || *p.UtilizationThresholdPercent > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("utilizationThresholdPercent"), *p.UtilizationThresholdPercent, "utilizationThresholdPercent must be between 0 and 100"))
+	}
+	if p.MaxNodesPerPlan != nil && *p.MaxNodesPerPlan < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNodesPerPlan"), *p.MaxNodesPerPlan, "maxNodesPerPlan must be non-negative"))
+	}
+	if p.MinNodeAge != nil && p.MinNodeAge.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minNodeAge"), p.MinNodeAge.Duration.String(), "minNodeAge must be non-negative"))
+	}
+	return allErrs
+}
+
+// validateMinMaxNodes validates the given minNodes and maxNodes of a NodePool or NodeTemplate under the given fldPath.
+func validateMinMaxNodes(minNodes, maxNodes *int32, fldPath *field.Path) (allErrs field.ErrorList) {
+	if minNodes != nil && *minNodes < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minNodes"), *minNodes, "minNodes must be non-negative"))
+	}
+	if maxNodes != nil && *maxNodes < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxNodes"), *maxNodes, "maxNodes must be non-negative"))
+	}
+	if minNodes != nil && maxNodes != nil && *minNodes > *maxNodes {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minNodes"), *minNodes, "minNodes must not be greater than maxNodes"))
+	}
 	return allErrs
 }
 
@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateNodePool(t *testing.T) {
+	validPool := func() NodePool {
+		return NodePool{
+			Name:              "p",
+			Region:            "r",
+			AvailabilityZones: []string{"r-a"},
+			NodeTemplates:     []NodeTemplate{{Name: "t", InstanceType: "m5.large"}},
+		}
+	}
+	tests := map[string]struct {
+		mutate        func(np *NodePool)
+		expectedPaths []string
+	}{
+		"valid pool": {
+			mutate: func(*NodePool) {},
+		},
+		"missing region, zones and templates": {
+			mutate: func(np *NodePool) {
+				np.Region = " "
+				np.AvailabilityZones = nil
+				np.NodeTemplates = nil
+			},
+			expectedPaths: []string{"pool.region", "pool.availabilityZones", "pool.nodeTemplates"},
+		},
+		"negative priority": {
+			mutate:        func(np *NodePool) { np.Priority = -1 },
+			expectedPaths: []string{"pool.priority"},
+		},
+		"unsupported zone balance": {
+			mutate:        func(np *NodePool) { np.ZoneBalance = "always" },
+			expectedPaths: []string{"pool.zoneBalance"},
+		},
+		"supported zone balance": {
+			mutate: func(np *NodePool) { np.ZoneBalance = ZoneBalancePolicyStrict },
+		},
+		"negative minNodes and maxNodes": {
+			mutate: func(np *NodePool) {
+				np.MinNodes = ptr.To[int32](-1)
+				np.MaxNodes = ptr.To[int32](-1)
+			},
+			expectedPaths: []string{"pool.minNodes", "pool.maxNodes"},
+		},
+		"minNodes greater than maxNodes": {
+			mutate: func(np *NodePool) {
+				np.MinNodes = ptr.To[int32](3)
+				np.MaxNodes = ptr.To[int32](2)
+			},
+			expectedPaths: []string{"pool.minNodes"},
+		},
+		"template minNodes greater than template maxNodes": {
+			mutate: func(np *NodePool) {
+				np.NodeTemplates[0].MinNodes = ptr.To[int32](3)
+				np.NodeTemplates[0].MaxNodes = ptr.To[int32](2)
+			},
+			expectedPaths: []string{"pool.nodeTemplates[0].minNodes"},
+		},
+		"template minNodes greater than pool maxNodes": {
+			mutate: func(np *NodePool) {
+				np.MaxNodes = ptr.To[int32](2)
+				np.NodeTemplates[0].MinNodes = ptr.To[int32](3)
+			},
+			expectedPaths: []string{"pool.nodeTemplates[0].minNodes"},
+		},
+		"invalid scale-in policy": {
+			mutate: func(np *NodePool) {
+				np.ScaleInPolicy = &ScaleInPolicy{
+					UtilizationThresholdPercent: ptr.To[int32](101),
+					MaxNodesPerPlan:             ptr.To[int32](-1),
+					MinNodeAge:                  &metav1.Duration{Duration: -time.Minute},
+				}
+			},
+			expectedPaths: []string{"pool.scaleInPolicy.utilizationThresholdPercent", "pool.scaleInPolicy.maxNodesPerPlan", "pool.scaleInPolicy.minNodeAge"},
+		},
+		"valid scale-in policy": {
+			mutate: func(np *NodePool) {
+				np.ScaleInPolicy = &ScaleInPolicy{UtilizationThresholdPercent: ptr.To[int32](50), MaxNodesPerPlan: ptr.To[int32](1)}
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			np := validPool()
+			tc.mutate(&np)
+			errs := ValidateNodePool(&np, field.NewPath("pool"))
+			if diff := cmp.Diff(tc.expectedPaths, getErrorFields(errs)); diff != "" {
+				t.Errorf("unexpected error fields (-want +got):\n%s\nerrors: %v", diff, errs)
+			}
+		})
+	}
+}
+
+func TestValidateScalingConstraintSpec(t *testing.T) {
+	validPool := NodePool{Name: "a", Region: "r", AvailabilityZones: []string{"r-a"}, NodeTemplates: []NodeTemplate{{Name: "t"}}}
+	invalidPool := NodePool{Name: "b", Region: "r", AvailabilityZones: []string{"r-a"}, NodeTemplates: []NodeTemplate{{Name: "t"}}, MinNodes: ptr.To[int32](2), MaxNodes: ptr.To[int32](1)}
+	tests := map[string]struct {
+		spec          ScalingConstraintSpec
+		expectedPaths []string
+	}{
+		"valid spec": {
+			spec: ScalingConstraintSpec{ScaleInPolicy: &ScaleInPolicy{}, NodePools: []NodePool{validPool}},
+		},
+		"invalid node pool": {
+			spec:          ScalingConstraintSpec{NodePools: []NodePool{validPool, invalidPool}},
+			expectedPaths: []string{"spec.nodePools[1].minNodes"},
+		},
+		"invalid default scale-in policy": {
+			spec: ScalingConstraintSpec{
+				ScaleInPolicy: &ScaleInPolicy{UtilizationThresholdPercent: ptr.To[int32](-1)},
+				NodePools:     []NodePool{validPool},
+			},
+			expectedPaths: []string{"spec.scaleInPolicy.utilizationThresholdPercent"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs := ValidateScalingConstraintSpec(&tc.spec, field.NewPath("spec"))
+			if diff := cmp.Diff(tc.expectedPaths, getErrorFields(errs)); diff != "" {
+				t.Errorf("unexpected error fields (-want +got):\n%s\nerrors: %v", diff, errs)
+			}
+		})
+	}
+}
+
+func getErrorFields(errs field.ErrorList) (fields []string) {
+	for _, err := range errs {
+		fields = append(fields, err.Field)
+	}
+	return fields
+}
@@ -98,10 +98,20 @@ func (in *NodePool) DeepCopyInto(out *NodePool) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.MinNodes != nil {
+		in, out := &in.MinNodes, &out.MinNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNodes != nil {
+		in, out := &in.MaxNodes, &out.MaxNodes
+		*out = new(int32)
+		**out = **in
+	}
 	if in.ScaleInPolicy != nil {
 		in, out := &in.ScaleInPolicy, &out.ScaleInPolicy
 		*out = new(ScaleInPolicy)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BackoffPolicy != nil {
 		in, out := &in.BackoffPolicy, &out.BackoffPolicy
@@ -164,6 +174,16 @@ func (in *NodeTemplate) DeepCopyInto(out *NodeTemplate) {
 			(*out)[key] = val.DeepCopy()
 		}
 	}
+	if in.MinNodes != nil {
+		in, out := &in.MinNodes, &out.MinNodes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNodes != nil {
+		in, out := &in.MaxNodes, &out.MaxNodes
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -239,6 +259,21 @@ func (in *ScaleInPlan) DeepCopy() *ScaleInPlan {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleInPolicy) DeepCopyInto(out *ScaleInPolicy) {
 	*out = *in
+	if in.UtilizationThresholdPercent != nil {
+		in, out := &in.UtilizationThresholdPercent, &out.UtilizationThresholdPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxNodesPerPlan != nil {
+		in, out := &in.MaxNodesPerPlan, &out.MaxNodesPerPlan
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinNodeAge != nil {
+		in, out := &in.MinNodeAge, &out.MinNodeAge
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -255,6 +290,7 @@ func (in *ScaleInPolicy) DeepCopy() *ScaleInPolicy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScaleOutErrorInfo) DeepCopyInto(out *ScaleOutErrorInfo) {
 	*out = *in
+	in.LastFailureTime.DeepCopyInto(&out.LastFailureTime)
 	return
 }
 
@@ -272,6 +308,16 @@ func (in *ScaleOutErrorInfo) DeepCopy() *ScaleOutErrorInfo {
 func (in *ScaleOutItem) DeepCopyInto(out *ScaleOutItem) {
 	*out = *in
 	out.NodePlacement = in.NodePlacement
+	if in.HourlyCostDelta != nil {
+		in, out := &in.HourlyCostDelta, &out.HourlyCostDelta
+		*out = new(float64)
+		**out = **in
+	}
+	if in.PodNames != nil {
+		in, out := &in.PodNames, &out.PodNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -293,10 +339,36 @@ func (in *ScaleOutPlan) DeepCopyInto(out *ScaleOutPlan) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.QuotaUnsatisfiedPodNames != nil {
+		in, out := &in.QuotaUnsatisfiedPodNames, &out.QuotaUnsatisfiedPodNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnsatisfiedPodExplanations != nil {
+		in, out := &in.UnsatisfiedPodExplanations, &out.UnsatisfiedPodExplanations
+		*out = make([]UnsatisfiedPodExplanation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UnsatisfiedPodReasons != nil {
+		in, out := &in.UnsatisfiedPodReasons, &out.UnsatisfiedPodReasons
+		*out = make([]UnsatisfiedPodReason, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
 		*out = make([]ScaleOutItem, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TotalHourlyCostDelta != nil {
+		in, out := &in.TotalHourlyCostDelta, &out.TotalHourlyCostDelta
+		*out = new(float64)
+		**out = **in
 	}
 	return
 }
@@ -522,7 +594,7 @@ func (in *ScalingConstraintSpec) DeepCopyInto(out *ScalingConstraintSpec) {
 	if in.ScaleInPolicy != nil {
 		in, out := &in.ScaleInPolicy, &out.ScaleInPolicy
 		*out = new(ScaleInPolicy)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.NodePools != nil {
 		in, out := &in.NodePools, &out.NodePools
@@ -634,7 +706,9 @@ func (in *ScalingFeedbackSpec) DeepCopyInto(out *ScalingFeedbackSpec) {
 	if in.ScaleOutErrorInfos != nil {
 		in, out := &in.ScaleOutErrorInfos, &out.ScaleOutErrorInfos
 		*out = make([]ScaleOutErrorInfo, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	in.ScaleInErrorInfo.DeepCopyInto(&out.ScaleInErrorInfo)
 	return
@@ -670,3 +744,45 @@ func (in *ScalingSimRunResult) DeepCopy() *ScalingSimRunResult {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsatisfiedPodExplanation) DeepCopyInto(out *UnsatisfiedPodExplanation) {
+	*out = *in
+	if in.Reasons != nil {
+		in, out := &in.Reasons, &out.Reasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsatisfiedPodExplanation.
+func (in *UnsatisfiedPodExplanation) DeepCopy() *UnsatisfiedPodExplanation {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsatisfiedPodExplanation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnsatisfiedPodReason) DeepCopyInto(out *UnsatisfiedPodReason) {
+	*out = *in
+	if in.PodNames != nil {
+		in, out := &in.PodNames, &out.PodNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnsatisfiedPodReason.
+func (in *UnsatisfiedPodReason) DeepCopy() *UnsatisfiedPodReason {
+	if in == nil {
+		return nil
+	}
+	out := new(UnsatisfiedPodReason)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,39 @@
+#!/usr/bin/env bash
+# SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+#
+# SPDX-License-Identifier: Apache-2.0
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+SCRIPT_DIR="$( cd "$( dirname "${BASH_SOURCE[0]}" )" &> /dev/null && pwd )"
+API_GO_MODULE_ROOT="$(dirname "$SCRIPT_DIR")"
+PROTO_DIR="${API_GO_MODULE_ROOT}/proto"
+
+function check_prereq() {
+  for tool in protoc protoc-gen-go protoc-gen-go-grpc; do
+    if ! command -v "${tool}" &>/dev/null; then
+      echo >&2 "${tool} is not available, cannot generate the protobuf messages and gRPC services of the API"
+      exit 1
+    fi
+  done
+}
+
+function generate_proto() {
+  local proto_files
+  proto_files=$(cd "${PROTO_DIR}" && find . -name '*.proto' | sed 's|^\./||')
+  # shellcheck disable=SC2086
+  protoc --proto_path="${PROTO_DIR}" \
+    --go_out="${PROTO_DIR}" --go_opt=paths=source_relative \
+    --go-grpc_out="${PROTO_DIR}" --go-grpc_opt=paths=source_relative \
+    ${proto_files}
+}
+
+function main() {
+  check_prereq
+  echo "> Generate protobuf messages and gRPC services..."
+  generate_proto
+}
+
+main
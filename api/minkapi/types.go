@@ -118,6 +118,10 @@ type EventSink interface {
 	events.EventSink
 	// List returns all events in the sink.
 	List() []eventsv1.Event
+	// Notify returns a channel that receives a notification whenever an event is created, updated or patched in the sink.
+	// Notifications are coalesced: at most one notification is pending on the channel regardless of the number of
+	// changed events.
+	Notify() <-chan struct{}
 }
 
 // View is the high-level facade to a repository of objects of different types (GVK).
@@ -17,6 +17,36 @@ const (
 	//DefaultMaxUnchangedTrackAttempts is the default value for the maximum number of unchanged simulation track attempts after which
 	// a simulation run is considered as stabilized.
 	DefaultMaxUnchangedTrackAttempts = 65
+	// DefaultScaleInUtilizationThresholdPercent is the default resource utilization, in percent of node allocatable, below
+	// which a node is considered a candidate for scale-in.
+	DefaultScaleInUtilizationThresholdPercent = 50
+	// DefaultScaleInMaxNodesPerPlan is the default maximum number of nodes that can be removed from a node pool in a
+	// single scale-in plan.
+	DefaultScaleInMaxNodesPerPlan = 1
+	// DefaultScaleInMinNodeAge is the default minimum duration since creation of a node before it is considered for
+	// scale-in.
+	DefaultScaleInMinNodeAge = 10 * time.Minute
+	// DefaultInitialBackoffDuration is the default lower limit of the duration for which a node placement is not
+	// considered for scale-out after a reported scale-out error.
+	DefaultInitialBackoffDuration = 1 * time.Minute
+	// DefaultMaxBackoffDuration is the default upper limit of the duration for which a node placement is not considered
+	// for scale-out after reported scale-out errors.
+	DefaultMaxBackoffDuration = 30 * time.Minute
+	// ZoneBalanceTolerancePercent is the relative difference, in percent, within which the selection criteria of node
+	// scores of node pools with ZoneBalancePolicyPreferred are considered equal.
+	ZoneBalanceTolerancePercent = 5
+	// DefaultLookaheadDepth is the default maximum number of passes expanded by the lookahead search of the scale-out
+	// simulator before the lowest cost sequence of scaled nodes is chosen.
+	DefaultLookaheadDepth = 3
+	// DefaultMaxConcurrentPlanJobs is the default maximum number of plan jobs that are run concurrently by the scaling
+	// planner service.
+	DefaultMaxConcurrentPlanJobs = 1
+	// DefaultMaxQueuedPlanJobs is the default maximum number of submitted plan jobs that wait to be run by the scaling
+	// planner service.
+	DefaultMaxQueuedPlanJobs = 16
+	// DefaultPlanJobRetention is the default duration for which finished plan jobs are retained by the scaling planner
+	// service.
+	DefaultPlanJobRetention = 15 * time.Minute
 	// ServiceName is the program binary name for the independent scaling planner microservice.
 	ServiceName = "scaling-planner"
 )
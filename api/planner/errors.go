@@ -48,14 +48,33 @@ var (
 	ErrNoUnscheduledPods = errors.New("no unscheduled pods")
 	// ErrNoScaleOutPlan is a sentinel error indicating that no ScaleOutPlan was generated.
 	ErrNoScaleOutPlan = errors.New("no scale-out plan")
+	// ErrNoScaleInPlan is a sentinel error indicating that no ScaleInPlan was generated.
+	ErrNoScaleInPlan = errors.New("no scale-in plan")
 	// ErrCreateNodeScorer is a sentinel error indicating that the planner cannot create a NodeScorer.
 	ErrCreateNodeScorer = errors.New("cannot create node scorer")
+	// ErrRegisterNodeScorer is a sentinel error indicating that a NodeScorer cannot be registered with the NodeScorerRegistry.
+	ErrRegisterNodeScorer = errors.New("cannot register node scorer")
+	// ErrCreateResourceWeigher is a sentinel error indicating that the resource weigher could not be created.
+	ErrCreateResourceWeigher = errors.New("cannot create resource weigher")
+	// ErrCreateStorageMetaAccess is a sentinel error indicating that the StorageMetaAccess could not be created.
+	ErrCreateStorageMetaAccess = errors.New("cannot create storage meta access")
 	// ErrInvalidScalingConstraint is a sentinel error indicating that the provided scaling constraint is invalid.
 	ErrInvalidScalingConstraint = errors.New("invalid scaling constraint")
 	// ErrUnsupportedSimulatorStrategy is a sentinel error indicating that an unsupported simulator strategy was specified.
 	ErrUnsupportedSimulatorStrategy = errors.New("unsupported simulator strategy")
 	// ErrInvalidRequest is a sentinel error indicating that the scaling planner request is invalid.
 	ErrInvalidRequest = errors.New("invalid planner request")
+	// ErrAdviceGenerationTimeout is a sentinel error indicating that the AdviceGenerationTimeout of the scaling planner
+	// request elapsed.
+	ErrAdviceGenerationTimeout = errors.New("advice generation timeout elapsed")
+	// ErrPlanJobNotFound is a sentinel error indicating that no plan job exists for a request ID.
+	ErrPlanJobNotFound = errors.New("plan job not found")
+	// ErrPlanJobExists is a sentinel error indicating that a plan job already exists for a request ID.
+	ErrPlanJobExists = errors.New("plan job already exists")
+	// ErrPlanJobQueueFull is a sentinel error indicating that the maximum number of queued plan jobs is reached.
+	ErrPlanJobQueueFull = errors.New("plan job queue is full")
+	// ErrTooManyConcurrentPlans is a sentinel error indicating that the maximum number of concurrently run plans is reached.
+	ErrTooManyConcurrentPlans = errors.New("too many concurrent plans")
 	// ErrServiceInitFailed is a sentinel error indicating that the ScalingPlannerService cannot initialize.
 	ErrServiceInitFailed = fmt.Errorf(commonerrors.FmtInitFailed, ServiceName)
 	// ErrStartFailed is a sentinel error indicating that the  ScalingPlannerService cannot start.
@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package planner
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+)
+
+// ScaleInSimulator is a facade that executes [ScaleInSimulation]'s to generate one or more [ScaleInPlanResult]'s sent
+// on a result channel.
+//
+// A [ScaleInSimulator] implementation will do the following when Simulate is invoked:
+//   - Selects underutilized candidate nodes of the node pools in the ScalingConstraint according to the effective
+//     [sacorev1alpha1.ScaleInPolicy] of each pool.
+//   - Creates a [ScaleInSimulation] for each candidate node using the [SimulationFactory] given as parameter.
+//   - Runs each simulation over the view produced by the last accepted simulation. A simulation removes the candidate
+//     node, evicts its pods and checks whether the embedded `kube-scheduler` can re-place all evicted pods on the
+//     remaining nodes.
+//   - Candidate nodes whose pods could all be re-placed become [sacorev1alpha1.ScaleInItem]'s of the
+//     [sacorev1alpha1.ScaleInPlan].
+type ScaleInSimulator interface {
+	io.Closer
+
+	// Simulate is the high level activity that runs [ScaleInSimulation] created from given
+	// [SimulationFactory] with the given planner [Request].
+	Simulate(ctx context.Context, request *Request, simulationFactory SimulationFactory) (planResult <-chan ScaleInPlanResult)
+}
+
+// ScaleInPlanResult represents a result from the ScaleInSimulator.Simulate
+type ScaleInPlanResult struct {
+	// Error is any error encountered during plan generation. Represents a terminal error that occurred during plan generation
+	// No further responses will be sent for the associated request.
+	Error error `json:"error,omitempty"`
+	// Labels is the associated metadata.
+	Labels map[string]string `json:"labels,omitempty"`
+	// ScaleInPlan is the generated scale-in plan.
+	ScaleInPlan *sacorev1alpha1.ScaleInPlan `json:"scaleInPlan,omitempty"`
+	// Metrics holds the metrics of the simulations run to generate the plan.
+	Metrics Metrics `json:"metrics"`
+}
+
+// ScaleInSimulation represents a simulation that removes node(s) from a minkapi View, evicts the pods bound to them and
+// checks whether the evicted pods can be bound to the remaining nodes.
+// The default ScaleInSimulation implementation uses an embedded k8s scheduler to perform this work.
+type ScaleInSimulation interface {
+	commontypes.Resettable
+	// Name returns the logical simulation name
+	Name() string
+	// Status returns the current ActivityStatus of the simulation
+	Status() ActivityStatus
+	// Run executes the simulation against the given simulation [minkapi.View] to completion and returns any encountered error.
+	// This is a blocking call, and callers are expected to manage concurrency and ScaleInSimResult consumption.
+	Run(ctx context.Context, view minkapi.View) error
+	// Result returns the latest ScaleInSimResult if the simulation is in ActivityStatusSuccess,
+	// or an error if the ActivityStatus is ActivityStatusPending, ActivityStatusRunning or ActivityStatusFailure
+	Result() (ScaleInSimResult, error)
+}
+
+// ScaleInSimArgs represents the arguments necessary for creating a [ScaleInSimulation] instance.
+type ScaleInSimArgs struct {
+	// SchedulerLauncher is used to launch scheduler instances for the simulation.
+	SchedulerLauncher SchedulerLauncher
+	// RunCounter is an atomic counter for tracking simulation runs.
+	RunCounter *atomic.Uint32
+	// Name is the name of the simulation instance
+	Name string
+	// TraceDir is the base directory for storing trace logs and other dump data by the simulation
+	TraceDir string
+	// CandidateNodeNames are the names of the nodes which are removed by the simulation.
+	CandidateNodeNames []string
+	// SchedulerProfile selects the kube-scheduler configuration with which the scheduler instances of the simulation are
+	// launched.
+	SchedulerProfile *SchedulerProfile
+	// Config is the simulation configuration.
+	Config SimulatorConfig
+}
+
+// ScaleInSimResult contains the results of a completed scale-in simulation run.
+type ScaleInSimResult struct {
+	// Name of the ScaleInSimulation that produced this result.
+	Name string
+	// View is the minkapi View against which the simulation was run.
+	View minkapi.View
+	// RemovedNodeNames are the names of the nodes removed by the simulation.
+	RemovedNodeNames []string
+	// NodePodAssignments represents the assignment of evicted Pods to the remaining Nodes.
+	NodePodAssignments []NodePodAssignment
+	// LeftoverUnscheduledPods is the slice of evicted pods that could not be re-placed on the remaining nodes after the
+	// simulation Run is completed.
+	LeftoverUnscheduledPods []commontypes.NamespacedName
+}
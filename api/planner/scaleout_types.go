@@ -74,6 +74,8 @@ type ScaleOutPlanResult struct {
 	Labels map[string]string `json:"labels,omitempty"`
 	// ScaleOutPlan is the generated scale-out plan.
 	ScaleOutPlan *sacorev1alpha1.ScaleOutPlan `json:"scaleOutPlan,omitempty"`
+	// Metrics holds the metrics of the simulations run to generate the plan.
+	Metrics Metrics `json:"metrics"`
 }
 
 // ScaleOutSimulation represents a simulation that scales virtual node(s) and performs valid unscheduled pod to ready node
@@ -97,6 +99,8 @@ type ScaleOutSimulation interface {
 	// or nil if the simulation is in ActivityStatusPending or ActivityStatusRunning
 	// or an error if the ActivityStatus is ActivityStatusFailure
 	Result() (ScaleOutSimResult, error)
+	// NodeTemplates returns the ScaleOutNodeTemplate's for which the simulation scales nodes.
+	NodeTemplates() []ScaleOutNodeTemplate
 }
 
 // ScaleOutSimArgs represents the arguments necessary for creating a [ScaleOutSimulation] instance.
@@ -115,6 +119,12 @@ type ScaleOutSimArgs struct {
 	Strategy commontypes.SimulatorStrategy
 	// NodeTemplates is a slice of [ScaleOutNodeTemplate] representing information needed to create scale-out simulated nodes.
 	NodeTemplates []ScaleOutNodeTemplate
+	// DaemonSetPods are the DaemonSet pod templates of the [ClusterSnapshot] from which daemon pods are created on the
+	// scale-out simulated nodes.
+	DaemonSetPods []PodInfo
+	// SchedulerProfile selects the kube-scheduler configuration with which the scheduler instances of the simulation are
+	// launched.
+	SchedulerProfile *SchedulerProfile
 	// Config is the simulation configuration.
 	Config SimulatorConfig
 }
@@ -134,6 +144,13 @@ type ScaleOutNodeTemplate struct {
 	Annotations map[string]string `json:"annotations,omitempty"`
 	// Quota defines the resource quota for the node pool.
 	Quota corev1.ResourceList `json:"quota,omitempty"`
+	// PoolMaxNodes is the maximum number of nodes of the node pool.
+	PoolMaxNodes *int32 `json:"poolMaxNodes,omitempty"`
+	// MaxNodes is the maximum number of nodes of the node template across all availability zones of the node pool.
+	MaxNodes *int32 `json:"maxNodes,omitempty"`
+	// MaxVolumes is the maximum number of volumes that can be attached to a node of the node template. If positive, it
+	// overrides the number of allocatable volumes of the CSINodeSpec returned by the StorageMetaAccess.
+	MaxVolumes int32 `json:"maxVolumes,omitzero"`
 	// Capacity defines the capacity for node resources that are available for the node's instance type.
 	Capacity corev1.ResourceList `json:"capacity"`
 	// KubeReserved defines the capacity for kube reserved resources.
@@ -168,6 +185,16 @@ type ScaleOutSimResult struct {
 	// LeftoverUnscheduledPods is the slice of unscheduled pods that remain unscheduled after the simulation Run is
 	// completed.
 	LeftoverUnscheduledPods []commontypes.NamespacedName
+	// QuotaExhaustedPlacements is the slice of [sacorev1alpha1.NodePlacement] of the simulation's ScaleOutNodeTemplate's
+	// for which a further node could not be scaled since it would exceed the quota of the node pool.
+	QuotaExhaustedPlacements []sacorev1alpha1.NodePlacement
+	// MaxNodesExhaustedPlacements is the slice of [sacorev1alpha1.NodePlacement] of the simulation's
+	// ScaleOutNodeTemplate's for which a further node could not be scaled since it would exceed the maximum node count
+	// of the node pool or node template.
+	MaxNodesExhaustedPlacements []sacorev1alpha1.NodePlacement
+	// PodSchedulingFailures maps the LeftoverUnscheduledPods to the message of the last FailedScheduling event reported
+	// for them by the kube-scheduler during the simulation run.
+	PodSchedulingFailures map[commontypes.NamespacedName]string
 }
 
 // ScaleOutSimGroup is a group of ScaleOutSimulation's at the same priority level (ie a partition of simulations).
@@ -181,6 +208,9 @@ type ScaleOutSimGroup interface {
 	GetSimulations() []ScaleOutSimulation
 	// AddSimulation adds a simulation to the group.
 	AddSimulation(simulation ScaleOutSimulation)
+	// DropSimulations removes the simulations for which the given drop function returns true from the group and
+	// returns the number of dropped simulations.
+	DropSimulations(drop func(simulation ScaleOutSimulation) bool) int
 	// Run executes all simulations in the group and returns all the simulation run results or any error.
 	Run(ctx context.Context, getViewFn minkapi.GetViewFunc) ([]ScaleOutSimResult, error)
 }
@@ -207,6 +237,12 @@ type ScaleOutSimGroupCycleResult struct {
 	WinnerNodeScores []NodeScore
 	// LeftoverUnscheduledPods contains the namespaced names of pods that could not be scheduled.
 	LeftoverUnscheduledPods []commontypes.NamespacedName
+	// QuotaExhaustedPlacements contains the node placements that could not be scaled further in this group since it
+	// would exceed the quota of their node pool.
+	QuotaExhaustedPlacements []sacorev1alpha1.NodePlacement
+	// PodSchedulingFailures maps pods to the distinct PodSchedulingFailures messages reported for them by the simulations
+	// of the last pass of this group in which they could not be scheduled.
+	PodSchedulingFailures map[commontypes.NamespacedName][]string
 	// PassNum is the number of passes executed in this group before moving to the next group.
 	// A pass is defined as the execution of all simulations in a group.
 	PassNum int
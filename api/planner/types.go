@@ -6,6 +6,8 @@ package planner
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"time"
@@ -57,18 +59,41 @@ type Request struct {
 	RequestRef
 	// SimulatorStrategy defines the simulation strategy to be used for scaling virtual nodes for generation of scaling advice.
 	SimulatorStrategy commontypes.SimulatorStrategy `json:"simulatorStrategy,omitempty"`
-	// ScoringStrategy defines the node scoring strategy to use for scaling decisions.
+	// ScoringStrategy defines the node scoring strategy to use for scaling decisions. This can be any strategy registered
+	// with the NodeScorerRegistry of the planner.
 	ScoringStrategy commontypes.NodeScoringStrategy `json:"scoringStrategy,omitempty"`
+	// CompositeScoringWeights holds the weights for the NodeScoringStrategyComposite scoring strategy. Defaults to
+	// commontypes.DefaultCompositeScoringWeights.
+	CompositeScoringWeights *commontypes.CompositeScoringWeights `json:"compositeScoringWeights,omitempty"`
 	// AdviceGenerationMode defines the mode in which scaling advice is generated.
 	AdviceGenerationMode commontypes.ScalingAdviceGenerationMode `json:"adviceGenerationMode,omitempty"`
 	// Snapshot is the snapshot of the resources in the cluster at the time of the request.
 	Snapshot ClusterSnapshot `json:"snapshot,omitzero"`
-	// AdviceGenerationTimeout is the maximum duration allowed for generating scaling advice.
+	// Feedback is the scaling feedback reported by the lifecycle manager for previous scaling advice. Node placements
+	// with reported scale-out errors are backed off according to the effective BackoffPolicy of their node pool.
+	Feedback *sacorev1alpha1.ScalingFeedbackSpec `json:"feedback,omitempty"`
+	// AdviceGenerationTimeout is the maximum duration allowed for generating scaling advice, measured from the
+	// time the scaling planner starts processing the request. If it elapses while generating a scale-out plan, the plan accumulated so far is sent
+	// labelled with LabelPartialPlan. Zero means no timeout.
 	AdviceGenerationTimeout time.Duration `json:",omitzero"`
 	// DiagnosticVerbosity indicates the level of diagnostics produced during scaling advice generation.
 	// By default, its value is 0 that disables diagnostics.
 	// The verbosity level is also passed to the logging framework (e.g. klog) used by scaling advisor components (e.g. kube-scheduler).
 	DiagnosticVerbosity uint32 `json:"diagnosticVerbosity,omitzero"`
+	// Seed enables the deterministic planning mode if set. In this mode ties between node scores are broken by a random
+	// source seeded with Seed, and the simulations of a simulation group are run one after the other in their order, so
+	// that the run numbers and node names of simulations are reproducible. The same request with the same Seed hence
+	// yields the same plan items. The kube-scheduler is not seeded, hence the pods attributed to the items may differ
+	// if it orders pods of equal priority or breaks ties between equally scored nodes differently.
+	Seed *int64 `json:"seed,omitempty"`
+	// SchedulerProfile selects the kube-scheduler configuration with which the embedded kube-scheduler instances of the
+	// simulations are launched. The kube-scheduler configuration of the SchedulerLauncher is used if nil.
+	SchedulerProfile *SchedulerProfile `json:"schedulerProfile,omitempty"`
+}
+
+// IsDeterministic returns true if the deterministic planning mode is enabled by the Seed of the request.
+func (r *Request) IsDeterministic() bool {
+	return r.Seed != nil
 }
 
 // GetRef returns the unique reference for the scaling advice request.
@@ -96,10 +121,59 @@ type Response struct {
 	ID string `json:"id,omitempty"`
 }
 
+// MarshalJSON marshals the Response with the message of its Error, since errors have no JSON representation.
+func (r Response) MarshalJSON() ([]byte, error) {
+	type response Response
+	var errMessage string
+	if r.Error != nil {
+		errMessage = r.Error.Error()
+	}
+	return json.Marshal(struct {
+		response
+		Error string `json:"error,omitempty"`
+	}{response: response(r), Error: errMessage})
+}
+
+// UnmarshalJSON unmarshals the Response, where its Error is unmarshalled as an error with the marshalled message.
+func (r *Response) UnmarshalJSON(data []byte) error {
+	type response Response
+	aux := struct {
+		*response
+		Error string `json:"error,omitempty"`
+	}{response: (*response)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Error = nil
+	if aux.Error != "" {
+		r.Error = errors.New(aux.Error)
+	}
+	return nil
+}
+
+// SchedulerProfile selects the kube-scheduler configuration derived from the kube-scheduler configuration of a
+// SchedulerLauncher with which a kube-scheduler instance is launched.
+type SchedulerProfile struct {
+	// Name is the scheduler name of the profile of the kube-scheduler configuration with which all pods are scheduled,
+	// regardless of the profile their scheduler name refers to. If empty, pods are scheduled with the profile their
+	// scheduler name refers to.
+	Name string `json:"name,omitempty"`
+	// ConfigPatch is a JSON merge patch (RFC 7386) of the versioned KubeSchedulerConfiguration that is applied after the
+	// profile with Name is selected. It may only patch the profiles, other fields, like the extenders, are rejected.
+	// For example, it can change the scoring strategy of the NodeResourcesFit plugin from MostAllocated to
+	// LeastAllocated or the default constraints of the PodTopologySpread plugin. Note that lists, like the profiles,
+	// are replaced as a whole by a JSON merge patch.
+	// Feature gates, like those of dynamic resource allocation, are process-wide and cannot be changed by a profile.
+	ConfigPatch json.RawMessage `json:"configPatch,omitempty"`
+}
+
 // SchedulerLaunchParams holds the parameters required to launch a kube-scheduler instance.
 type SchedulerLaunchParams struct {
 	// EventSink is the event sink used to send events from the kube-scheduler.
 	EventSink events.EventSink
+	// Profile selects the kube-scheduler configuration to launch the kube-scheduler with. The kube-scheduler
+	// configuration of the SchedulerLauncher is used if nil.
+	Profile *SchedulerProfile
 	commontypes.ClientFacades
 }
 
@@ -110,6 +184,9 @@ type SchedulerLauncher interface {
 	// If the limit of running schedulers is reached, it will block.
 	// An error is returned if the scheduler fails to start.
 	Launch(ctx context.Context, params *SchedulerLaunchParams) (SchedulerHandle, error)
+	// LoadProfile loads the kube-scheduler configuration of the given profile and caches it for subsequent launches.
+	// An error is returned if the profile is unknown or its config patch cannot be applied.
+	LoadProfile(profile *SchedulerProfile) error
 }
 
 // SchedulerHandle defines the interface for managing a kube-scheduler instance.
@@ -117,6 +194,10 @@ type SchedulerHandle interface {
 	io.Closer
 	// GetParams returns the parameters used to launch the scheduler instance.
 	GetParams() SchedulerLaunchParams
+	// IsIdle returns true if the scheduler instance has no pods in its active and backoff queues and no pod in its
+	// scheduling or binding cycle.
+	// Pods that the scheduler found unschedulable are only retried on cluster changes and are hence not considered.
+	IsIdle() bool
 }
 
 // ClusterSnapshot represents a snapshot of the cluster at a specific time and encapsulates the scheduling relevant information required by the kube-scheduler.
@@ -126,6 +207,10 @@ type ClusterSnapshot struct {
 	Pods []PodInfo `json:"pods,omitempty"`
 	// Nodes are the nodes that are present in the cluster.
 	Nodes []NodeInfo `json:"nodes,omitempty"`
+	// DaemonSetPods are the pod templates of the DaemonSets in the cluster, where the name and namespace of each
+	// template are those of its DaemonSet. A daemon pod is created from each template on every simulated scale-out node
+	// that the template can run on according to its node selector, required node affinity and tolerations.
+	DaemonSetPods []PodInfo `json:"daemonSetPods,omitempty"`
 	// PVs are the information about PersistentVolumes in the cluster. Should not contain deleted PVs.
 	// Should only contain *bound* PVs ie those with populated claimRef.
 	PVs []PVInfo `json:"pvs,omitempty"`
@@ -257,6 +342,7 @@ func (n *NodeInfo) GetNodePlacement() (placement sacorev1alpha1.NodePlacement, e
 		InstanceType:     n.InstanceType,
 		Region:           n.Labels[corev1.LabelTopologyRegion],
 		AvailabilityZone: n.Labels[corev1.LabelTopologyZone],
+		CapacityType:     commontypes.CapacityType(n.Labels[commonconstants.LabelCapacityType]).Canonical(),
 	}
 	return
 }
@@ -280,8 +366,31 @@ type PVInfo struct {
 	AccessModes       []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
 }
 
-// GetNodeScorer is a factory function for creating NodeScorer implementations.
-type GetNodeScorer func(scoringStrategy commontypes.NodeScoringStrategy, pricingAccess pricing.InstancePricingAccess, resourceWeigher ResourceWeigher) (NodeScorer, error)
+// NodeScorerParams holds the parameters for creating a NodeScorer.
+type NodeScorerParams struct {
+	// PricingAccess provides access to instance pricing information.
+	PricingAccess pricing.InstancePricingAccess
+	// ResourceWeigher provides resource weights for scoring.
+	ResourceWeigher ResourceWeigher
+	// CompositeWeights holds the weights of the composite node scoring strategy given in the Request.
+	CompositeWeights *commontypes.CompositeScoringWeights
+	// SpotInterruptionPenaltyPercent is the percentage by which the hourly price of spot capacity is increased.
+	SpotInterruptionPenaltyPercent float64
+	// Seed is the Seed of the Request. If set, ties between node scores must be broken reproducibly using it.
+	Seed *int64
+}
+
+// CreateNodeScorerFunc is a factory function for creating a NodeScorer implementation for the given params.
+type CreateNodeScorerFunc func(params NodeScorerParams) (NodeScorer, error)
+
+// NodeScorerRegistry is a registry of factory functions creating NodeScorer implementations by NodeScoringStrategy.
+type NodeScorerRegistry interface {
+	// Register registers the given createFunc for the given scoringStrategy. It returns an error if a function is
+	// already registered for the scoringStrategy.
+	Register(scoringStrategy commontypes.NodeScoringStrategy, createFunc CreateNodeScorerFunc) error
+	// GetNodeScorer creates a NodeScorer for the given scoringStrategy using the given params.
+	GetNodeScorer(scoringStrategy commontypes.NodeScoringStrategy, params NodeScorerParams) (NodeScorer, error)
+}
 
 // NodeScorer defines an interface for computing node scores for scaling decisions.
 type NodeScorer interface {
@@ -315,8 +424,27 @@ type NodeScore struct {
 	// Name uniquely identifies this NodeScore
 	Name            string
 	UnscheduledPods []commontypes.NamespacedName
+	// ScheduledPods are the pods scheduled on the scaled Node.
+	ScheduledPods []commontypes.NamespacedName
 	// Value is the score value for this Node.
 	Value int
+	// ZoneBalance is the ZoneBalancePolicy of the node pool of the Placement.
+	ZoneBalance sacorev1alpha1.ZoneBalancePolicy
+	// PoolZoneNodeCount is the number of existing plus planned nodes of the node pool of the Placement in the availability
+	// zone of the Placement. It is used for balancing across availability zones according to ZoneBalance and as the zone
+	// spread criterion of the composite node scoring strategy.
+	PoolZoneNodeCount int
+	// CompositeCriteria holds the criteria computed by the composite node scoring strategy. It is nil for other strategies.
+	CompositeCriteria *CompositeScoreCriteria
+}
+
+// CompositeScoreCriteria holds the criteria of a NodeScore computed by the composite node scoring strategy, which are
+// normalized across the candidate node scores when selecting the winner.
+type CompositeScoreCriteria struct {
+	// CostPerUnit is the effective hourly price of the scaled Node per normalized resource unit scheduled.
+	CostPerUnit float64
+	// WastedUnits is the number of normalized resource units of the scaled Node left unused.
+	WastedUnits float64
 }
 
 // NodePodAssignment represents the assignment of pods to a node for simulation purposes.
@@ -341,6 +469,39 @@ type ResourceWeigher interface {
 	GetWeights(instanceType string) (map[corev1.ResourceName]float64, error)
 }
 
+// ResourceWeightsConfig holds the resource weights configured for a ResourceWeigher. A weight applies to one unit of
+// the canonical quantity of a resource, i.e. to one core of cpu, one byte of memory or ephemeral-storage and one
+// device of an extended resource.
+type ResourceWeightsConfig struct {
+	// Default holds the weights of resources for which the ResourceWeigher does not derive weights, such as
+	// ephemeral-storage and extended resources. Derived weights take precedence over these.
+	Default map[corev1.ResourceName]float64 `json:"default,omitempty"`
+	// InstanceFamilies holds the weights by instance family, which take precedence over the derived and default weights.
+	InstanceFamilies map[string]map[corev1.ResourceName]float64 `json:"instanceFamilies,omitempty"`
+}
+
+// VolumeLimitsCatalog holds the CSI driver of the block storage of cloud providers and the maximum number of volumes
+// that can be attached to a node by instance type.
+type VolumeLimitsCatalog struct {
+	// Providers maps cloud providers to their ProviderVolumeLimits.
+	Providers map[commontypes.CloudProvider]ProviderVolumeLimits `json:"providers"`
+}
+
+// ProviderVolumeLimits holds the CSI driver of the block storage of a cloud provider and the maximum number of volumes
+// that can be attached to a node by instance type.
+type ProviderVolumeLimits struct {
+	// DriverName is the name of the CSI driver of the block storage of the cloud provider.
+	DriverName string `json:"driverName"`
+	// TopologyKeys are the topology keys reported by the CSI driver for a node.
+	TopologyKeys []string `json:"topologyKeys,omitempty"`
+	// DefaultMaxVolumes is the maximum number of volumes that can be attached to a node of an instance type that is not
+	// contained in InstanceTypes.
+	DefaultMaxVolumes int32 `json:"defaultMaxVolumes"`
+	// InstanceTypes maps instance types to the maximum number of volumes that can be attached to a node of the instance
+	// type.
+	InstanceTypes map[string]int32 `json:"instanceTypes,omitempty"`
+}
+
 // StorageMetaAccess defines an interface for querying misc storage metadata
 type StorageMetaAccess interface {
 	// GetFallbackCSINodeSpec gets the default storagev1.CSINodeSpec which is suitable for the given instanceType.
@@ -366,20 +527,36 @@ type NodeResourceInfo struct {
 	Name string
 	// InstanceType is the cloud instance type of the node.
 	InstanceType string
+	// Overhead is the aggregated resource requests of the daemon pods placed on the node, which are a fixed overhead
+	// that is not available to the scheduled pods.
+	Overhead corev1.ResourceList
 }
 
 // SimulatorConfig holds the configuration for the internal simulator used by the scaling advisor planner.
 type SimulatorConfig struct {
 	// MaxParallelSimulations is the maximum number of parallel simulations that can be run by the scaling advisor planner.
 	MaxParallelSimulations int
-	// TrackPollInterval is the polling interval for tracking pod scheduling in the view of the simulator.
+	// TrackPollInterval is the maximum interval for tracking pod scheduling in the view of the simulator. Tracking is
+	// triggered earlier by events of the kube-scheduler, and a simulation run ends once the kube-scheduler is idle and no
+	// events were received within this interval.
 	TrackPollInterval time.Duration
 	// MaxUnchangedTrackAttempts is the maximum number of unchanged simulation track attempts after which a simulation run is
-	// considered as stabilized.
+	// considered as stabilized, even if the kube-scheduler is not idle, for example because of pods in backoff.
 	MaxUnchangedTrackAttempts int
 	// BindVolumeClaimsForImmediateMode should be set if simulator is expected to bind unbound PVC<->PV for
 	// [corev1.VolumeBindingImmediate], also creating a simulated PV if a matching existing PV doesn't exist.
 	BindVolumeClaimsForImmediateMode bool
+	// LookaheadBeamWidth is the number of candidate views retained per pass by the lookahead search of the
+	// SimulatorStrategySingleNodeMultiSim scale-out simulator. A value less than 2 disables the lookahead search, in
+	// which case the winner of each pass is chosen greedily.
+	LookaheadBeamWidth int
+	// LookaheadDepth is the maximum number of passes expanded by the lookahead search before the sequence of scaled
+	// nodes with the lowest total cost is chosen. Defaults to DefaultLookaheadDepth if not positive.
+	LookaheadDepth int
+	// SpotInterruptionPenaltyPercent is the percentage by which the hourly price of spot capacity is increased to
+	// account for the risk of interruption. The increased price is used by all node scoring strategies and by the
+	// lookahead search. Zero means no penalty.
+	SpotInterruptionPenaltyPercent float64
 }
 
 // ScalingPlannerArgs encapsulates the arguments required to create a ScalingPlanner.
@@ -388,6 +565,8 @@ type ScalingPlannerArgs struct {
 	ViewAccess minkapi.ViewAccess
 	// ResourceWeigher provides resource weights for scoring.
 	ResourceWeigher ResourceWeigher
+	// NodeScorerRegistry provides the NodeScorer for the ScoringStrategy of a Request.
+	NodeScorerRegistry NodeScorerRegistry
 	// PricingAccess provides access to instance pricing information.
 	PricingAccess pricing.InstancePricingAccess
 	// StorageMetaAccess provides access to storage metadata.
@@ -402,6 +581,42 @@ type ScalingPlannerArgs struct {
 	TraceDir string
 	// SimulatorConfig holds the configuration for the internal simulator.
 	SimulatorConfig SimulatorConfig
+	// MetricsRecorder records the metrics of plan generation. Metrics are not recorded if it is nil.
+	MetricsRecorder MetricsRecorder
+}
+
+// PlanKind is the kind of scaling plan.
+type PlanKind string
+
+const (
+	// PlanKindScaleOut is the kind of a ScaleOutPlan.
+	PlanKindScaleOut PlanKind = "ScaleOut"
+	// PlanKindScaleIn is the kind of a ScaleInPlan.
+	PlanKindScaleIn PlanKind = "ScaleIn"
+)
+
+// Metrics holds the metrics of the simulations run to generate a scaling plan.
+type Metrics struct {
+	// GroupPasses is the number of passes executed for each simulation group, in the order in which the groups were run.
+	GroupPasses []int `json:"groupPasses,omitempty"`
+	// SimulationRuns is the total number of simulation runs made across all simulation groups to generate the plan.
+	SimulationRuns uint32 `json:"simulationRuns,omitempty"`
+}
+
+// MetricsRecorder is the facade for recording the metrics of the ScalingPlanner and its simulations into a metrics
+// backend like Prometheus.
+type MetricsRecorder interface {
+	// RecordPlan records the given Metrics of a scaling plan of the given PlanKind that was generated in the given duration
+	// and left numUnsatisfiedPods pods unsatisfied.
+	RecordPlan(kind PlanKind, duration time.Duration, metrics Metrics, numUnsatisfiedPods int)
+	// RecordError records the given error of plan generation by the sentinel error it wraps.
+	RecordError(err error)
+	// RecordSchedulerLaunch records the duration taken to launch an embedded kube-scheduler, excluding the wait for a
+	// free slot of the SchedulerLauncher.
+	RecordSchedulerLaunch(duration time.Duration)
+	// RecordSemaphoreWait records the duration waited by the SchedulerLauncher for a free slot to launch an embedded
+	// kube-scheduler.
+	RecordSemaphoreWait(duration time.Duration)
 }
 
 // ScalingPlanner defines the interface for computing scaling plans.
@@ -440,15 +655,18 @@ type ScalingPlannerFactory interface {
 
 // SimulatorFactory is a factory facade for constructing various kinds of simulators.
 type SimulatorFactory interface {
+	// GetScaleOutSimulator returns a ScaleOutSimulator for the Strategy given in the SimulatorArgs.
 	GetScaleOutSimulator(args SimulatorArgs) (ScaleOutSimulator, error)
-	// TODO: Add GetScaleInSimulator here.
+	// GetScaleInSimulator returns a ScaleInSimulator constructed with the given SimulatorArgs.
+	GetScaleInSimulator(args SimulatorArgs) (ScaleInSimulator, error)
 }
 
 // SimulationFactory is a factory facade for creating Simulation objects
 type SimulationFactory interface {
 	// NewScaleOut creates a ScaleOutSimulation instance with the given name and arguments.
 	NewScaleOut(args ScaleOutSimArgs) (ScaleOutSimulation, error)
-	// TODO: Add NewScaleIn method here.
+	// NewScaleIn creates a ScaleInSimulation instance with the given name and arguments.
+	NewScaleIn(args ScaleInSimArgs) (ScaleInSimulation, error)
 }
 
 // SimulatorArgs is an encapsulation of the arguments used to create a ScaleOutSimulator or ScaleInSimulator.
@@ -462,6 +680,8 @@ type SimulatorArgs struct {
 	StorageMetaAccess StorageMetaAccess
 	// NodeScorer holds the facade to compute NodeScores for simulated scaled nodes.
 	NodeScorer NodeScorer
+	// PricingAccess holds the access facade to instance pricing, used to compute the cost of scaled nodes.
+	PricingAccess pricing.InstancePricingAccess
 	// Strategy holds the simulator strategy which customizes simulator implementation and behaviorchanges simulator implementation and behavior
 	Strategy commontypes.SimulatorStrategy
 	// TraceDir is the base directory for storing trace logs and other dump data by the simulator
@@ -470,11 +690,55 @@ type SimulatorArgs struct {
 	Config SimulatorConfig
 }
 
+// PlanJob represents the asynchronous generation of scaling plans for a Request that was submitted to a PlanJobManager.
+type PlanJob struct {
+	// CreationTime is the time when the plan job was submitted.
+	CreationTime time.Time `json:"creationTime"`
+	// CompletionTime is the time when the plan job finished. It is zero while the plan job is pending or running.
+	CompletionTime time.Time `json:"completionTime,omitzero"`
+	// RequestRef is the reference to the submitted request.
+	RequestRef RequestRef `json:"requestRef"`
+	// Status is the status of the plan job, which is ActivityStatusFailure if it was canceled or any of its responses
+	// carries an error.
+	Status ActivityStatus `json:"status"`
+	// Message describes why the plan job failed, if it failed.
+	Message string `json:"message,omitempty"`
+	// Responses are the responses accumulated by the plan job so far.
+	Responses []Response `json:"responses,omitempty"`
+}
+
+// PlanJobManager runs plan jobs for submitted requests asynchronously. Plan jobs wait in a bounded queue until one of a
+// bounded number of concurrent runs is available, and are retained for a bounded duration once finished.
+type PlanJobManager interface {
+	// SubmitPlanJob enqueues a plan job for the given request and returns the reference to the request. It returns an
+	// error wrapping ErrPlanJobQueueFull if the queue is full, or ErrPlanJobExists if a plan job with the ID of the
+	// request is retained. The trace context of the given ctx is propagated to the plan job, which is not canceled
+	// when the ctx is done.
+	SubmitPlanJob(ctx context.Context, req Request) (RequestRef, error)
+	// GetPlanJob returns a snapshot of the plan job for the given request ID, or an error wrapping ErrPlanJobNotFound.
+	GetPlanJob(requestID string) (PlanJob, error)
+	// CancelPlanJob cancels the pending or running plan job for the given request ID and returns a snapshot of it. A
+	// finished plan job is returned unchanged. It returns an error wrapping ErrPlanJobNotFound if there is no plan job.
+	CancelPlanJob(requestID string) (PlanJob, error)
+}
+
+// PlanJobConfig holds the configuration for plan jobs of the scaling planner service.
+type PlanJobConfig struct {
+	// MaxConcurrentPlanJobs is the maximum number of plan jobs that are run concurrently. Plans requested directly from
+	// the service count towards this limit and are rejected with ErrTooManyConcurrentPlans once it is reached.
+	MaxConcurrentPlanJobs int
+	// MaxQueuedPlanJobs is the maximum number of submitted plan jobs that wait to be run.
+	MaxQueuedPlanJobs int
+	// Retention is the duration for which finished plan jobs are retained.
+	Retention time.Duration
+}
+
 // ScalingPlannerService is the facade for the scaling planner microservice that embeds a ScalingPlanner
-// Offers a REST API for the embedded ScalingPlanner
+// Offers a REST API for the embedded ScalingPlanner and runs plan jobs for it as a PlanJobManager
 type ScalingPlannerService interface {
 	commontypes.Service
 	ScalingPlanner
+	PlanJobManager
 }
 
 // ScalingPlannerServiceConfig holds the service configuration for the scaling planner microservice.
@@ -485,18 +749,26 @@ type ScalingPlannerServiceConfig struct {
 	TraceDir string
 	// ServerConfig holds the server configuration for the scaling advisor planner.
 	ServerConfig commontypes.ServerConfig
+	// GRPCBindAddress is the bind address of the gRPC server of the scaling advisor planner, which is not started if
+	// empty.
+	GRPCBindAddress string
 	// MinKAPIConfig holds the configuration for the MinKAPI server used by the scaling advisor planner.
 	MinKAPIConfig minkapi.Config
 	// ClientConfig holds the client QPS and Burst settings for the scaling advisor planner.
 	ClientConfig commontypes.QPSBurst
 	// SimulatorConfig holds the configuration used by the internal simulator.
 	SimulatorConfig SimulatorConfig
+	// PlanJobConfig holds the configuration for plan jobs.
+	PlanJobConfig PlanJobConfig
+	// TracingConfig holds the configuration of the OpenTelemetry tracing of the scaling advisor planner.
+	TracingConfig commontypes.TracingConfig
 }
 
 // Factories is a struct that holds all planner factories.
 type Factories struct {
-	Planner         ScalingPlannerFactory
-	Simulator       SimulatorFactory
-	Simulation      SimulationFactory
-	ResourceWeigher ResourceWeigher
+	Planner            ScalingPlannerFactory
+	Simulator          SimulatorFactory
+	Simulation         SimulationFactory
+	ResourceWeigher    ResourceWeigher
+	NodeScorerRegistry NodeScorerRegistry
 }
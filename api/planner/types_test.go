@@ -5,6 +5,7 @@
 package planner
 
 import (
+	"encoding/json"
 	"testing"
 
 	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
@@ -15,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestClusterSnapshot_GetUnscheduledPods(t *testing.T) {
@@ -360,3 +362,49 @@ func TestSimGroupKey_String(t *testing.T) {
 		})
 	}
 }
+
+func TestResponse_JSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		response Response
+		wantJSON string
+	}{
+		{
+			name: "plan",
+			response: Response{
+				RequestRef:   RequestRef{ID: "r1", CorrelationID: "c1"},
+				Labels:       map[string]string{"k": "v"},
+				ScaleOutPlan: &sacorev1alpha1.ScaleOutPlan{TotalHourlyCostDelta: ptr.To(0.1)},
+				ID:           "plan-1",
+			},
+			wantJSON: `{"RequestRef":{"id":"r1","correlationID":"c1"},"labels":{"k":"v"},"scaleOutPlan":{"items":null,"totalHourlyCostDelta":0.1},"id":"plan-1"}`,
+		},
+		{
+			name:     "error",
+			response: Response{RequestRef: RequestRef{ID: "r2"}, Error: ErrNoScaleOutPlan, ID: "plan-error"},
+			wantJSON: `{"RequestRef":{"id":"r2"},"id":"plan-error","error":"no scale-out plan"}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.response)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+			if string(data) != tc.wantJSON {
+				t.Errorf("Marshal() = %s, want %s", data, tc.wantJSON)
+			}
+			var got Response
+			if err = json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if (got.Error == nil) != (tc.response.Error == nil) || (got.Error != nil && got.Error.Error() != tc.response.Error.Error()) {
+				t.Errorf("Unmarshal() Error = %v, want %v", got.Error, tc.response.Error)
+			}
+			got.Error, tc.response.Error = nil, nil
+			if diff := cmp.Diff(tc.response, got); diff != "" {
+				t.Errorf("Unmarshal() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -11,6 +11,8 @@ type InstancePriceInfo struct {
 	Region string
 	// OS is the operating system for the instance type.
 	OS string
+	// CapacityType is the capacity type to which the HourlyPrice applies. Empty denotes on-demand capacity.
+	CapacityType commontypes.CapacityType `json:"capacityType,omitempty"`
 	// Memory is the amount of memory in GB for the instance type.
 	Memory int64
 	// GPUMemory is the amount of GPU memory in GB for the instance type.
@@ -29,13 +31,18 @@ type PriceKey struct {
 	Name string
 	// Region is the cloud region.
 	Region string
+	// CapacityType is the canonical capacity type, which is empty for on-demand capacity.
+	CapacityType commontypes.CapacityType
 }
 
 // InstancePricingAccess defines an interface for accessing instance pricing information.
 type InstancePricingAccess interface {
-	// GetInfo gets the InstancePriceInfo (whicn includes price) for the given region and instance type.
+	// GetInfo gets the InstancePriceInfo (whicn includes price) for the given region, instance type and capacity type.
+	// An empty capacityType denotes on-demand capacity.
 	// TODO: should we also pass OS name here ? if so, we need to need to change ScalingConstraint.
-	GetInfo(region, instanceTypeName string) (InstancePriceInfo, error)
+	GetInfo(region, instanceTypeName string, capacityType commontypes.CapacityType) (InstancePriceInfo, error)
+	// ListInfos lists the InstancePriceInfo of all instance types across all regions and capacity types.
+	ListInfos() []InstancePriceInfo
 }
 
 // GetProviderInstancePricingAccessFunc is a factory function for creating InstancePricingAccess implementations.
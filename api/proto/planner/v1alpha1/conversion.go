@@ -0,0 +1,707 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plannerv1alpha1
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/planner"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	nodev1 "k8s.io/api/node/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// ErrConvert is a sentinel error indicating that a message cannot be converted from or to the types of the planner API.
+var ErrConvert = errors.New("cannot convert message")
+
+// FromRequest converts the given planner request to a PlanRequest.
+func FromRequest(req *planner.Request) (*PlanRequest, error) {
+	snapshot, err := fromClusterSnapshot(&req.Snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("%w: request %q: %w", ErrConvert, req.ID, err)
+	}
+	out := &PlanRequest{
+		Ref:                  &RequestRef{Id: req.ID, CorrelationId: req.CorrelationID},
+		SimulatorStrategy:    string(req.SimulatorStrategy),
+		ScoringStrategy:      string(req.ScoringStrategy),
+		AdviceGenerationMode: string(req.AdviceGenerationMode),
+		Snapshot:             snapshot,
+		Constraint:           fromScalingConstraint(req.Constraint),
+		Feedback:             fromScalingFeedback(req.Feedback),
+		DiagnosticVerbosity:  req.DiagnosticVerbosity,
+		Seed:                 req.Seed,
+	}
+	if !req.CreationTime.IsZero() {
+		out.CreationTime = timestamppb.New(req.CreationTime)
+	}
+	if req.AdviceGenerationTimeout != 0 {
+		out.AdviceGenerationTimeout = durationpb.New(req.AdviceGenerationTimeout)
+	}
+	if w := req.CompositeScoringWeights; w != nil {
+		out.CompositeScoringWeights = &CompositeScoringWeights{Cost: w.Cost, Waste: w.Waste, UnscheduledPods: w.UnscheduledPods, ZoneSpread: w.ZoneSpread}
+	}
+	if p := req.SchedulerProfile; p != nil {
+		out.SchedulerProfile = &SchedulerProfile{Name: p.Name, ConfigPatch: p.ConfigPatch}
+	}
+	return out, nil
+}
+
+// ToRequest converts the given PlanRequest to a planner request.
+func ToRequest(in *PlanRequest) (planner.Request, error) {
+	req := planner.Request{
+		RequestRef:           planner.RequestRef{ID: in.GetRef().GetId(), CorrelationID: in.GetRef().GetCorrelationId()},
+		SimulatorStrategy:    commontypes.SimulatorStrategy(in.GetSimulatorStrategy()),
+		ScoringStrategy:      commontypes.NodeScoringStrategy(in.GetScoringStrategy()),
+		AdviceGenerationMode: commontypes.ScalingAdviceGenerationMode(in.GetAdviceGenerationMode()),
+		DiagnosticVerbosity:  in.GetDiagnosticVerbosity(),
+		Seed:                 in.Seed,
+		Feedback:             toScalingFeedback(in.GetFeedback()),
+	}
+	if in.GetCreationTime() != nil {
+		req.CreationTime = in.GetCreationTime().AsTime()
+	}
+	if in.GetAdviceGenerationTimeout() != nil {
+		req.AdviceGenerationTimeout = in.GetAdviceGenerationTimeout().AsDuration()
+	}
+	if w := in.GetCompositeScoringWeights(); w != nil {
+		req.CompositeScoringWeights = &commontypes.CompositeScoringWeights{Cost: w.GetCost(), Waste: w.GetWaste(), UnscheduledPods: w.GetUnscheduledPods(), ZoneSpread: w.GetZoneSpread()}
+	}
+	if p := in.GetSchedulerProfile(); p != nil {
+		req.SchedulerProfile = &planner.SchedulerProfile{Name: p.GetName(), ConfigPatch: p.GetConfigPatch()}
+	}
+	var err error
+	if req.Constraint, err = toScalingConstraint(in.GetConstraint()); err != nil {
+		return req, fmt.Errorf("%w: request %q: %w", ErrConvert, req.ID, err)
+	}
+	if err = toClusterSnapshot(in.GetSnapshot(), &req.Snapshot); err != nil {
+		return req, fmt.Errorf("%w: request %q: %w", ErrConvert, req.ID, err)
+	}
+	return req, nil
+}
+
+// FromResponse converts the given planner response to a PlanResponse.
+func FromResponse(r *planner.Response) *PlanResponse {
+	out := &PlanResponse{
+		Id:         r.ID,
+		RequestRef: &RequestRef{Id: r.RequestRef.ID, CorrelationId: r.RequestRef.CorrelationID},
+		Labels:     r.Labels,
+	}
+	if r.Error != nil {
+		out.Error = r.Error.Error()
+	}
+	if p := r.ScaleOutPlan; p != nil {
+		out.ScaleOutPlan = &ScaleOutPlan{
+			TotalHourlyCostDelta:     p.TotalHourlyCostDelta,
+			UnsatisfiedPodNames:      p.UnsatisfiedPodNames,
+			QuotaUnsatisfiedPodNames: p.QuotaUnsatisfiedPodNames,
+		}
+		for _, item := range p.Items {
+			out.ScaleOutPlan.Items = append(out.ScaleOutPlan.Items, &ScaleOutItem{
+				Placement:       fromNodePlacement(item.NodePlacement),
+				CurrentReplicas: item.CurrentReplicas,
+				Delta:           item.Delta,
+				HourlyCostDelta: item.HourlyCostDelta,
+				PodNames:        item.PodNames,
+			})
+		}
+		for _, e := range p.UnsatisfiedPodExplanations {
+			out.ScaleOutPlan.UnsatisfiedPodExplanations = append(out.ScaleOutPlan.UnsatisfiedPodExplanations, &UnsatisfiedPodExplanation{PodName: e.PodName, Reasons: e.Reasons})
+		}
+		for _, reason := range p.UnsatisfiedPodReasons {
+			out.ScaleOutPlan.UnsatisfiedPodReasons = append(out.ScaleOutPlan.UnsatisfiedPodReasons, &UnsatisfiedPodReason{Reason: reason.Reason, PodNames: reason.PodNames})
+		}
+	}
+	if p := r.ScaleInPlan; p != nil {
+		out.ScaleInPlan = &ScaleInPlan{}
+		for _, item := range p.Items {
+			out.ScaleInPlan.Items = append(out.ScaleInPlan.Items, &ScaleInItem{Placement: fromNodePlacement(item.NodePlacement), NodeName: item.NodeName})
+		}
+	}
+	return out
+}
+
+// ToResponse converts the given PlanResponse to a planner response.
+func ToResponse(in *PlanResponse) planner.Response {
+	r := planner.Response{
+		ID:         in.GetId(),
+		RequestRef: planner.RequestRef{ID: in.GetRequestRef().GetId(), CorrelationID: in.GetRequestRef().GetCorrelationId()},
+		Labels:     in.GetLabels(),
+	}
+	if in.GetError() != "" {
+		r.Error = errors.New(in.GetError())
+	}
+	if p := in.GetScaleOutPlan(); p != nil {
+		r.ScaleOutPlan = &sacorev1alpha1.ScaleOutPlan{
+			TotalHourlyCostDelta:     p.TotalHourlyCostDelta,
+			UnsatisfiedPodNames:      p.GetUnsatisfiedPodNames(),
+			QuotaUnsatisfiedPodNames: p.GetQuotaUnsatisfiedPodNames(),
+		}
+		for _, item := range p.GetItems() {
+			r.ScaleOutPlan.Items = append(r.ScaleOutPlan.Items, sacorev1alpha1.ScaleOutItem{
+				NodePlacement:   toNodePlacement(item.GetPlacement()),
+				CurrentReplicas: item.GetCurrentReplicas(),
+				Delta:           item.GetDelta(),
+				HourlyCostDelta: item.HourlyCostDelta,
+				PodNames:        item.GetPodNames(),
+			})
+		}
+		for _, e := range p.GetUnsatisfiedPodExplanations() {
+			r.ScaleOutPlan.UnsatisfiedPodExplanations = append(r.ScaleOutPlan.UnsatisfiedPodExplanations, sacorev1alpha1.UnsatisfiedPodExplanation{PodName: e.GetPodName(), Reasons: e.GetReasons()})
+		}
+		for _, reason := range p.GetUnsatisfiedPodReasons() {
+			r.ScaleOutPlan.UnsatisfiedPodReasons = append(r.ScaleOutPlan.UnsatisfiedPodReasons, sacorev1alpha1.UnsatisfiedPodReason{Reason: reason.GetReason(), PodNames: reason.GetPodNames()})
+		}
+	}
+	if p := in.GetScaleInPlan(); p != nil {
+		r.ScaleInPlan = &sacorev1alpha1.ScaleInPlan{}
+		for _, item := range p.GetItems() {
+			r.ScaleInPlan.Items = append(r.ScaleInPlan.Items, sacorev1alpha1.ScaleInItem{NodePlacement: toNodePlacement(item.GetPlacement()), NodeName: item.GetNodeName()})
+		}
+	}
+	return r
+}
+
+func fromClusterSnapshot(s *planner.ClusterSnapshot) (*ClusterSnapshot, error) {
+	out := &ClusterSnapshot{}
+	var err error
+	if out.Pods, err = fromPodInfos(s.Pods); err != nil {
+		return nil, err
+	}
+	if out.DaemonSetPods, err = fromPodInfos(s.DaemonSetPods); err != nil {
+		return nil, err
+	}
+	for _, n := range s.Nodes {
+		node := &NodeInfo{
+			Metadata:      fromObjectMeta(&n.ObjectMeta),
+			InstanceType:  n.InstanceType,
+			Capacity:      fromResourceList(n.Capacity),
+			Allocatable:   fromResourceList(n.Allocatable),
+			Taints:        fromTaints(n.Taints),
+			Unschedulable: n.Unschedulable,
+		}
+		if node.ConditionsJson, err = marshalIfSet(n.Conditions, len(n.Conditions) > 0); err != nil {
+			return nil, err
+		}
+		if node.CsiNodeSpecJson, err = marshalIfSet(n.CSINodeSpec, n.CSINodeSpec != nil); err != nil {
+			return nil, err
+		}
+		out.Nodes = append(out.Nodes, node)
+	}
+	for _, pv := range s.PVs {
+		info := &PVInfo{
+			Metadata:         fromObjectMeta(&pv.ObjectMeta),
+			Capacity:         fromResourceList(pv.Capacity),
+			ClaimRef:         &NamespacedName{Namespace: pv.ClaimRef.Namespace, Name: pv.ClaimRef.Name},
+			StorageClassName: pv.StorageClassName,
+			Phase:            string(pv.Phase),
+			VolumeMode:       string(pv.VolumeMode),
+		}
+		for _, m := range pv.AccessModes {
+			info.AccessModes = append(info.AccessModes, string(m))
+		}
+		if info.NodeAffinityJson, err = marshalIfSet(pv.NodeAffinity, pv.NodeAffinity != nil); err != nil {
+			return nil, err
+		}
+		out.Pvs = append(out.Pvs, info)
+	}
+	for _, pvc := range s.PVCs {
+		info := &PVCInfo{Metadata: fromObjectMeta(&pvc.ObjectMeta), Phase: string(pvc.Phase)}
+		if info.SpecJson, err = json.Marshal(pvc.PersistentVolumeClaimSpec); err != nil {
+			return nil, err
+		}
+		out.Pvcs = append(out.Pvcs, info)
+	}
+	if out.StorageClassesJson, err = marshalEach(s.StorageClasses); err != nil {
+		return nil, err
+	}
+	if out.PriorityClassesJson, err = marshalEach(s.PriorityClasses); err != nil {
+		return nil, err
+	}
+	if out.RuntimeClassesJson, err = marshalEach(s.RuntimeClasses); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func toClusterSnapshot(in *ClusterSnapshot, s *planner.ClusterSnapshot) error {
+	var err error
+	if s.Pods, err = toPodInfos(in.GetPods()); err != nil {
+		return err
+	}
+	if s.DaemonSetPods, err = toPodInfos(in.GetDaemonSetPods()); err != nil {
+		return err
+	}
+	for _, n := range in.GetNodes() {
+		node := planner.NodeInfo{
+			ObjectMeta:    toObjectMeta(n.GetMetadata()),
+			InstanceType:  n.GetInstanceType(),
+			Taints:        toTaints(n.GetTaints()),
+			Unschedulable: n.GetUnschedulable(),
+		}
+		if node.Capacity, err = toResourceList(n.GetCapacity()); err != nil {
+			return err
+		}
+		if node.Allocatable, err = toResourceList(n.GetAllocatable()); err != nil {
+			return err
+		}
+		if err = unmarshalIfSet(n.GetConditionsJson(), &node.Conditions); err != nil {
+			return err
+		}
+		if len(n.GetCsiNodeSpecJson()) > 0 {
+			node.CSINodeSpec = &storagev1.CSINodeSpec{}
+			if err = json.Unmarshal(n.GetCsiNodeSpecJson(), node.CSINodeSpec); err != nil {
+				return err
+			}
+		}
+		s.Nodes = append(s.Nodes, node)
+	}
+	for _, pv := range in.GetPvs() {
+		info := planner.PVInfo{
+			ObjectMeta:       toObjectMeta(pv.GetMetadata()),
+			ClaimRef:         commontypes.NamespacedName{Namespace: pv.GetClaimRef().GetNamespace(), Name: pv.GetClaimRef().GetName()},
+			StorageClassName: pv.GetStorageClassName(),
+			Phase:            corev1.PersistentVolumePhase(pv.GetPhase()),
+			VolumeMode:       corev1.PersistentVolumeMode(pv.GetVolumeMode()),
+		}
+		for _, m := range pv.GetAccessModes() {
+			info.AccessModes = append(info.AccessModes, corev1.PersistentVolumeAccessMode(m))
+		}
+		if info.Capacity, err = toResourceList(pv.GetCapacity()); err != nil {
+			return err
+		}
+		if len(pv.GetNodeAffinityJson()) > 0 {
+			info.NodeAffinity = &corev1.NodeSelector{}
+			if err = json.Unmarshal(pv.GetNodeAffinityJson(), info.NodeAffinity); err != nil {
+				return err
+			}
+		}
+		s.PVs = append(s.PVs, info)
+	}
+	for _, pvc := range in.GetPvcs() {
+		info := planner.PVCInfo{ObjectMeta: toObjectMeta(pvc.GetMetadata()), Phase: corev1.PersistentVolumeClaimPhase(pvc.GetPhase())}
+		if err = unmarshalIfSet(pvc.GetSpecJson(), &info.PersistentVolumeClaimSpec); err != nil {
+			return err
+		}
+		s.PVCs = append(s.PVCs, info)
+	}
+	if s.StorageClasses, err = unmarshalEach[storagev1.StorageClass](in.GetStorageClassesJson()); err != nil {
+		return err
+	}
+	if s.PriorityClasses, err = unmarshalEach[schedulingv1.PriorityClass](in.GetPriorityClassesJson()); err != nil {
+		return err
+	}
+	if s.RuntimeClasses, err = unmarshalEach[nodev1.RuntimeClass](in.GetRuntimeClassesJson()); err != nil {
+		return err
+	}
+	return nil
+}
+
+func fromPodInfos(pods []planner.PodInfo) ([]*PodInfo, error) {
+	var out []*PodInfo
+	for _, p := range pods {
+		pod := &PodInfo{
+			Metadata:           fromObjectMeta(&p.ObjectMeta),
+			AggregatedRequests: fromResourceList(p.AggregatedRequests),
+			Overhead:           fromResourceList(p.Overhead),
+			NodeSelector:       p.NodeSelector,
+			NodeName:           p.NodeName,
+			SchedulerName:      p.SchedulerName,
+			PriorityClassName:  p.PriorityClassName,
+			Priority:           p.Priority,
+			PreemptionPolicy:   string(p.PreemptionPolicy),
+			RuntimeClassName:   p.RuntimeClassName,
+		}
+		for _, t := range p.Tolerations {
+			pod.Tolerations = append(pod.Tolerations, &Toleration{Key: t.Key, Operator: string(t.Operator), Value: t.Value, Effect: string(t.Effect), TolerationSeconds: t.TolerationSeconds})
+		}
+		var err error
+		if pod.AffinityJson, err = marshalIfSet(p.Affinity, p.Affinity != nil); err != nil {
+			return nil, err
+		}
+		if pod.VolumesJson, err = marshalIfSet(p.Volumes, len(p.Volumes) > 0); err != nil {
+			return nil, err
+		}
+		if pod.TopologySpreadConstraintsJson, err = marshalIfSet(p.TopologySpreadConstraints, len(p.TopologySpreadConstraints) > 0); err != nil {
+			return nil, err
+		}
+		if pod.ResourceClaimsJson, err = marshalIfSet(p.ResourceClaims, len(p.ResourceClaims) > 0); err != nil {
+			return nil, err
+		}
+		out = append(out, pod)
+	}
+	return out, nil
+}
+
+func toPodInfos(pods []*PodInfo) ([]planner.PodInfo, error) {
+	var out []planner.PodInfo
+	for _, p := range pods {
+		pod := planner.PodInfo{
+			ObjectMeta:        toObjectMeta(p.GetMetadata()),
+			NodeSelector:      p.GetNodeSelector(),
+			NodeName:          p.GetNodeName(),
+			SchedulerName:     p.GetSchedulerName(),
+			PriorityClassName: p.GetPriorityClassName(),
+			Priority:          p.GetPriority(),
+			PreemptionPolicy:  corev1.PreemptionPolicy(p.GetPreemptionPolicy()),
+			RuntimeClassName:  p.GetRuntimeClassName(),
+		}
+		for _, t := range p.GetTolerations() {
+			pod.Tolerations = append(pod.Tolerations, corev1.Toleration{Key: t.GetKey(), Operator: corev1.TolerationOperator(t.GetOperator()), Value: t.GetValue(), Effect: corev1.TaintEffect(t.GetEffect()), TolerationSeconds: t.TolerationSeconds})
+		}
+		var err error
+		if pod.AggregatedRequests, err = toResourceList(p.GetAggregatedRequests()); err != nil {
+			return nil, err
+		}
+		if pod.Overhead, err = toResourceList(p.GetOverhead()); err != nil {
+			return nil, err
+		}
+		if len(p.GetAffinityJson()) > 0 {
+			pod.Affinity = &corev1.Affinity{}
+			if err = json.Unmarshal(p.GetAffinityJson(), pod.Affinity); err != nil {
+				return nil, err
+			}
+		}
+		if err = unmarshalIfSet(p.GetVolumesJson(), &pod.Volumes); err != nil {
+			return nil, err
+		}
+		if err = unmarshalIfSet(p.GetTopologySpreadConstraintsJson(), &pod.TopologySpreadConstraints); err != nil {
+			return nil, err
+		}
+		if err = unmarshalIfSet(p.GetResourceClaimsJson(), &pod.ResourceClaims); err != nil {
+			return nil, err
+		}
+		out = append(out, pod)
+	}
+	return out, nil
+}
+
+func fromScalingConstraint(c *sacorev1alpha1.ScalingConstraint) *ScalingConstraint {
+	if c == nil {
+		return nil
+	}
+	out := &ScalingConstraint{
+		Metadata:             fromObjectMeta(&c.ObjectMeta),
+		DefaultBackoffPolicy: fromBackoffPolicy(c.Spec.DefaultBackoffPolicy),
+		ScaleInPolicy:        fromScaleInPolicy(c.Spec.ScaleInPolicy),
+		ConsumerId:           c.Spec.ConsumerID,
+	}
+	for _, np := range c.Spec.NodePools {
+		pool := &NodePool{
+			Name:              np.Name,
+			Region:            np.Region,
+			AvailabilityZones: np.AvailabilityZones,
+			Labels:            np.Labels,
+			Annotations:       np.Annotations,
+			Taints:            fromTaints(np.Taints),
+			Quota:             fromResourceList(np.Quota),
+			MinNodes:          np.MinNodes,
+			MaxNodes:          np.MaxNodes,
+			ZoneBalance:       string(np.ZoneBalance),
+			ScaleInPolicy:     fromScaleInPolicy(np.ScaleInPolicy),
+			BackoffPolicy:     fromBackoffPolicy(np.BackoffPolicy),
+			Priority:          np.Priority,
+		}
+		for _, nt := range np.NodeTemplates {
+			pool.NodeTemplates = append(pool.NodeTemplates, &NodeTemplate{
+				Name:           nt.Name,
+				Architecture:   nt.Architecture,
+				InstanceType:   nt.InstanceType,
+				Priority:       nt.Priority,
+				Capacity:       fromResourceList(nt.Capacity),
+				KubeReserved:   fromResourceList(nt.KubeReserved),
+				SystemReserved: fromResourceList(nt.SystemReserved),
+				MaxVolumes:     nt.MaxVolumes,
+				CapacityType:   string(nt.CapacityType),
+				MinNodes:       nt.MinNodes,
+				MaxNodes:       nt.MaxNodes,
+			})
+		}
+		out.NodePools = append(out.NodePools, pool)
+	}
+	return out
+}
+
+func toScalingConstraint(in *ScalingConstraint) (*sacorev1alpha1.ScalingConstraint, error) {
+	if in == nil {
+		return nil, nil
+	}
+	c := &sacorev1alpha1.ScalingConstraint{
+		ObjectMeta: toObjectMeta(in.GetMetadata()),
+		Spec: sacorev1alpha1.ScalingConstraintSpec{
+			DefaultBackoffPolicy: toBackoffPolicy(in.GetDefaultBackoffPolicy()),
+			ScaleInPolicy:        toScaleInPolicy(in.GetScaleInPolicy()),
+			ConsumerID:           in.GetConsumerId(),
+		},
+	}
+	for _, np := range in.GetNodePools() {
+		pool := sacorev1alpha1.NodePool{
+			Name:              np.GetName(),
+			Region:            np.GetRegion(),
+			AvailabilityZones: np.GetAvailabilityZones(),
+			Labels:            np.GetLabels(),
+			Annotations:       np.GetAnnotations(),
+			Taints:            toTaints(np.GetTaints()),
+			MinNodes:          np.MinNodes,
+			MaxNodes:          np.MaxNodes,
+			ZoneBalance:       sacorev1alpha1.ZoneBalancePolicy(np.GetZoneBalance()),
+			ScaleInPolicy:     toScaleInPolicy(np.GetScaleInPolicy()),
+			BackoffPolicy:     toBackoffPolicy(np.GetBackoffPolicy()),
+			Priority:          np.GetPriority(),
+		}
+		var err error
+		if pool.Quota, err = toResourceList(np.GetQuota()); err != nil {
+			return nil, err
+		}
+		for _, nt := range np.GetNodeTemplates() {
+			template := sacorev1alpha1.NodeTemplate{
+				Name:         nt.GetName(),
+				Architecture: nt.GetArchitecture(),
+				InstanceType: nt.GetInstanceType(),
+				Priority:     nt.GetPriority(),
+				MaxVolumes:   nt.GetMaxVolumes(),
+				CapacityType: commontypes.CapacityType(nt.GetCapacityType()),
+				MinNodes:     nt.MinNodes,
+				MaxNodes:     nt.MaxNodes,
+			}
+			if template.Capacity, err = toResourceList(nt.GetCapacity()); err != nil {
+				return nil, err
+			}
+			if template.KubeReserved, err = toResourceList(nt.GetKubeReserved()); err != nil {
+				return nil, err
+			}
+			if template.SystemReserved, err = toResourceList(nt.GetSystemReserved()); err != nil {
+				return nil, err
+			}
+			pool.NodeTemplates = append(pool.NodeTemplates, template)
+		}
+		c.Spec.NodePools = append(c.Spec.NodePools, pool)
+	}
+	return c, nil
+}
+
+func fromScalingFeedback(f *sacorev1alpha1.ScalingFeedbackSpec) *ScalingFeedbackSpec {
+	if f == nil {
+		return nil
+	}
+	out := &ScalingFeedbackSpec{
+		ConstraintRef:         &NamespacedName{Namespace: f.ConstraintRef.Namespace, Name: f.ConstraintRef.Name},
+		ScaleInErrorNodeNames: f.ScaleInErrorInfo.NodeNames,
+	}
+	for _, e := range f.ScaleOutErrorInfos {
+		info := &ScaleOutErrorInfo{AvailabilityZone: e.AvailabilityZone, InstanceType: e.InstanceType, CapacityType: string(e.CapacityType), ErrorType: string(e.ErrorType), FailCount: e.FailCount}
+		if !e.LastFailureTime.IsZero() {
+			info.LastFailureTime = timestamppb.New(e.LastFailureTime.Time)
+		}
+		out.ScaleOutErrorInfos = append(out.ScaleOutErrorInfos, info)
+	}
+	return out
+}
+
+func toScalingFeedback(in *ScalingFeedbackSpec) *sacorev1alpha1.ScalingFeedbackSpec {
+	if in == nil {
+		return nil
+	}
+	f := &sacorev1alpha1.ScalingFeedbackSpec{
+		ConstraintRef:    commontypes.NamespacedName{Namespace: in.GetConstraintRef().GetNamespace(), Name: in.GetConstraintRef().GetName()},
+		ScaleInErrorInfo: sacorev1alpha1.ScaleInErrorInfo{NodeNames: in.GetScaleInErrorNodeNames()},
+	}
+	for _, e := range in.GetScaleOutErrorInfos() {
+		info := sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: e.GetAvailabilityZone(), InstanceType: e.GetInstanceType(), CapacityType: commontypes.CapacityType(e.GetCapacityType()), ErrorType: sacorev1alpha1.ScalingErrorType(e.GetErrorType()), FailCount: e.GetFailCount()}
+		if e.GetLastFailureTime() != nil {
+			info.LastFailureTime = metav1.NewTime(e.GetLastFailureTime().AsTime())
+		}
+		f.ScaleOutErrorInfos = append(f.ScaleOutErrorInfos, info)
+	}
+	return f
+}
+
+func fromBackoffPolicy(p *sacorev1alpha1.BackoffPolicy) *BackoffPolicy {
+	if p == nil {
+		return nil
+	}
+	return &BackoffPolicy{InitialBackoff: durationpb.New(p.InitialBackoffDuration.Duration), MaxBackoff: durationpb.New(p.MaxBackoffDuration.Duration)}
+}
+
+func toBackoffPolicy(in *BackoffPolicy) *sacorev1alpha1.BackoffPolicy {
+	if in == nil {
+		return nil
+	}
+	return &sacorev1alpha1.BackoffPolicy{
+		InitialBackoffDuration: metav1.Duration{Duration: in.GetInitialBackoff().AsDuration()},
+		MaxBackoffDuration:     metav1.Duration{Duration: in.GetMaxBackoff().AsDuration()},
+	}
+}
+
+func fromScaleInPolicy(p *sacorev1alpha1.ScaleInPolicy) *ScaleInPolicy {
+	if p == nil {
+		return nil
+	}
+	out := &ScaleInPolicy{UtilizationThresholdPercent: p.UtilizationThresholdPercent, MaxNodesPerPlan: p.MaxNodesPerPlan}
+	if p.MinNodeAge != nil {
+		out.MinNodeAge = durationpb.New(p.MinNodeAge.Duration)
+	}
+	return out
+}
+
+func toScaleInPolicy(in *ScaleInPolicy) *sacorev1alpha1.ScaleInPolicy {
+	if in == nil {
+		return nil
+	}
+	p := &sacorev1alpha1.ScaleInPolicy{UtilizationThresholdPercent: in.UtilizationThresholdPercent, MaxNodesPerPlan: in.MaxNodesPerPlan}
+	if in.GetMinNodeAge() != nil {
+		p.MinNodeAge = &metav1.Duration{Duration: in.GetMinNodeAge().AsDuration()}
+	}
+	return p
+}
+
+func fromNodePlacement(p sacorev1alpha1.NodePlacement) *NodePlacement {
+	return &NodePlacement{PoolName: p.PoolName, TemplateName: p.TemplateName, InstanceType: p.InstanceType, Region: p.Region,
+		AvailabilityZone: p.AvailabilityZone, CapacityType: string(p.CapacityType)}
+}
+
+func toNodePlacement(in *NodePlacement) sacorev1alpha1.NodePlacement {
+	return sacorev1alpha1.NodePlacement{PoolName: in.GetPoolName(), TemplateName: in.GetTemplateName(), InstanceType: in.GetInstanceType(), Region: in.GetRegion(),
+		AvailabilityZone: in.GetAvailabilityZone(), CapacityType: commontypes.CapacityType(in.GetCapacityType())}
+}
+
+func fromObjectMeta(m *metav1.ObjectMeta) *ObjectMeta {
+	out := &ObjectMeta{
+		Name:        m.Name,
+		Namespace:   m.Namespace,
+		Uid:         string(m.UID),
+		Labels:      m.Labels,
+		Annotations: m.Annotations,
+	}
+	if !m.CreationTimestamp.IsZero() {
+		out.CreationTimestamp = timestamppb.New(m.CreationTimestamp.Time)
+	}
+	if m.DeletionTimestamp != nil {
+		out.DeletionTimestamp = timestamppb.New(m.DeletionTimestamp.Time)
+	}
+	for _, ref := range m.OwnerReferences {
+		out.OwnerReferences = append(out.OwnerReferences, &OwnerReference{ApiVersion: ref.APIVersion, Kind: ref.Kind, Name: ref.Name, Uid: string(ref.UID), Controller: ref.Controller})
+	}
+	return out
+}
+
+func toObjectMeta(in *ObjectMeta) metav1.ObjectMeta {
+	m := metav1.ObjectMeta{
+		Name:        in.GetName(),
+		Namespace:   in.GetNamespace(),
+		UID:         types.UID(in.GetUid()),
+		Labels:      in.GetLabels(),
+		Annotations: in.GetAnnotations(),
+	}
+	if in.GetCreationTimestamp() != nil {
+		m.CreationTimestamp = metav1.NewTime(in.GetCreationTimestamp().AsTime())
+	}
+	if in.GetDeletionTimestamp() != nil {
+		deletionTimestamp := metav1.NewTime(in.GetDeletionTimestamp().AsTime())
+		m.DeletionTimestamp = &deletionTimestamp
+	}
+	for _, ref := range in.GetOwnerReferences() {
+		m.OwnerReferences = append(m.OwnerReferences, metav1.OwnerReference{APIVersion: ref.GetApiVersion(), Kind: ref.GetKind(), Name: ref.GetName(), UID: types.UID(ref.GetUid()), Controller: ref.Controller})
+	}
+	return m
+}
+
+func fromTaints(taints []corev1.Taint) []*Taint {
+	var out []*Taint
+	for _, t := range taints {
+		taint := &Taint{Key: t.Key, Value: t.Value, Effect: string(t.Effect)}
+		if t.TimeAdded != nil {
+			taint.TimeAdded = timestamppb.New(t.TimeAdded.Time)
+		}
+		out = append(out, taint)
+	}
+	return out
+}
+
+func toTaints(taints []*Taint) []corev1.Taint {
+	var out []corev1.Taint
+	for _, t := range taints {
+		taint := corev1.Taint{Key: t.GetKey(), Value: t.GetValue(), Effect: corev1.TaintEffect(t.GetEffect())}
+		if t.GetTimeAdded() != nil {
+			timeAdded := metav1.NewTime(t.GetTimeAdded().AsTime())
+			taint.TimeAdded = &timeAdded
+		}
+		out = append(out, taint)
+	}
+	return out
+}
+
+func fromResourceList(resources corev1.ResourceList) map[string]string {
+	if len(resources) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(resources))
+	for name, quantity := range resources {
+		out[string(name)] = quantity.String()
+	}
+	return out
+}
+
+func toResourceList(resources map[string]string) (corev1.ResourceList, error) {
+	if len(resources) == 0 {
+		return nil, nil
+	}
+	out := make(corev1.ResourceList, len(resources))
+	for name, value := range resources {
+		quantity, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid quantity %q of resource %q: %w", value, name, err)
+		}
+		out[corev1.ResourceName(name)] = quantity
+	}
+	return out, nil
+}
+
+func marshalIfSet(v any, set bool) ([]byte, error) {
+	if !set {
+		return nil, nil
+	}
+	return json.Marshal(v)
+}
+
+func unmarshalIfSet(data []byte, v any) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+func marshalEach[T any](objs []T) ([][]byte, error) {
+	var out [][]byte
+	for _, obj := range objs {
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, data)
+	}
+	return out, nil
+}
+
+func unmarshalEach[T any](data [][]byte) ([]T, error) {
+	var out []T
+	for _, d := range data {
+		var obj T
+		if err := json.Unmarshal(d, &obj); err != nil {
+			return nil, err
+		}
+		out = append(out, obj)
+	}
+	return out, nil
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package plannerv1alpha1
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/planner"
+	"github.com/google/go-cmp/cmp"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRequestRoundTrip(t *testing.T) {
+	creationTime := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	resources := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("4Gi")}
+	want := planner.Request{
+		RequestRef:           planner.RequestRef{ID: "r1", CorrelationID: "c1"},
+		CreationTime:         creationTime,
+		SimulatorStrategy:    commontypes.SimulatorStrategySingleNodeMultiSim,
+		ScoringStrategy:      commontypes.NodeScoringStrategyLeastCost,
+		AdviceGenerationMode: commontypes.ScalingAdviceGenerationModeIncremental,
+		Constraint: &sacorev1alpha1.ScalingConstraint{
+			ObjectMeta: metav1.ObjectMeta{Name: "sc", Namespace: "default"},
+			Spec: sacorev1alpha1.ScalingConstraintSpec{
+				ConsumerID:    "ca",
+				ScaleInPolicy: &sacorev1alpha1.ScaleInPolicy{MaxNodesPerPlan: ptr.To[int32](2), MinNodeAge: &metav1.Duration{Duration: time.Minute}},
+				NodePools: []sacorev1alpha1.NodePool{{
+					Name:              "p1",
+					Region:            "eu-west-1",
+					AvailabilityZones: []string{"eu-west-1a"},
+					MaxNodes:          ptr.To[int32](10),
+					ZoneBalance:       sacorev1alpha1.ZoneBalancePolicyPreferred,
+					Taints:            []corev1.Taint{{Key: "k", Value: "v", Effect: corev1.TaintEffectNoSchedule}},
+					NodeTemplates: []sacorev1alpha1.NodeTemplate{{
+						Name:         "t1",
+						Architecture: "amd64",
+						InstanceType: "m5.large",
+						Capacity:     resources,
+						CapacityType: commontypes.CapacityTypeSpot,
+					}},
+				}},
+			},
+		},
+		Snapshot: planner.ClusterSnapshot{
+			Pods: []planner.PodInfo{{
+				ObjectMeta:         metav1.ObjectMeta{Name: "p", Namespace: "default", UID: "uid-p", CreationTimestamp: metav1.NewTime(creationTime)},
+				AggregatedRequests: resources,
+				Tolerations:        []corev1.Toleration{{Key: "k", Operator: corev1.TolerationOpExists, TolerationSeconds: ptr.To[int64](30)}},
+				Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+					NodeSelectorTerms: []corev1.NodeSelectorTerm{{MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "a", Operator: corev1.NodeSelectorOpIn, Values: []string{"b"}}}}},
+				}}},
+				Priority: 100,
+			}},
+			Nodes: []planner.NodeInfo{{
+				ObjectMeta:   metav1.ObjectMeta{Name: "n", Labels: map[string]string{"l": "v"}},
+				InstanceType: "m5.large",
+				Capacity:     resources,
+				Allocatable:  resources,
+				Conditions:   []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+			}},
+			PVCs:            []planner.PVCInfo{{ObjectMeta: metav1.ObjectMeta{Name: "pvc"}, PersistentVolumeClaimSpec: corev1.PersistentVolumeClaimSpec{StorageClassName: ptr.To("standard")}}},
+			PriorityClasses: []schedulingv1.PriorityClass{{ObjectMeta: metav1.ObjectMeta{Name: "high"}, Value: 100}},
+		},
+		Feedback: &sacorev1alpha1.ScalingFeedbackSpec{
+			ConstraintRef:      commontypes.NamespacedName{Namespace: "default", Name: "sc"},
+			ScaleOutErrorInfos: []sacorev1alpha1.ScaleOutErrorInfo{{AvailabilityZone: "eu-west-1a", InstanceType: "m5.large", CapacityType: commontypes.CapacityTypeSpot, ErrorType: sacorev1alpha1.ScalingErrorTypeResourceExhausted, FailCount: 1, LastFailureTime: metav1.NewTime(creationTime)}},
+		},
+		AdviceGenerationTimeout: 30 * time.Second,
+		Seed:                    ptr.To[int64](42),
+		SchedulerProfile:        &planner.SchedulerProfile{Name: "bin-packing-scheduler", ConfigPatch: []byte(`{"profiles":[{"schedulerName":"bin-packing-scheduler","percentageOfNodesToScore":50}]}`)},
+	}
+
+	msg, err := FromRequest(&want)
+	if err != nil {
+		t.Fatalf("FromRequest() error = %v", err)
+	}
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		t.Fatalf("cannot marshal message: %v", err)
+	}
+	var decoded PlanRequest
+	if err = proto.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("cannot unmarshal message: %v", err)
+	}
+	got, err := ToRequest(&decoded)
+	if err != nil {
+		t.Fatalf("ToRequest() error = %v", err)
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("request mismatch after round trip (-want +got):\n%s", diff)
+	}
+
+	decoded.Snapshot.Pods[0].AggregatedRequests["cpu"] = "two"
+	if _, err = ToRequest(&decoded); !errors.Is(err, ErrConvert) {
+		t.Errorf("ToRequest() error = %v for invalid quantity, want %v", err, ErrConvert)
+	}
+}
+
+func TestResponseRoundTrip(t *testing.T) {
+	want := planner.Response{
+		ID:         "plan-1",
+		RequestRef: planner.RequestRef{ID: "r1"},
+		Labels:     map[string]string{commonconstants.LabelPartialPlan: "true"},
+		ScaleOutPlan: &sacorev1alpha1.ScaleOutPlan{
+			Items: []sacorev1alpha1.ScaleOutItem{
+				{
+					NodePlacement:   sacorev1alpha1.NodePlacement{PoolName: "p1", TemplateName: "t1", InstanceType: "m5.large", Region: "eu-west-1", AvailabilityZone: "eu-west-1a"},
+					Delta:           2,
+					HourlyCostDelta: ptr.To(0.2),
+					PodNames:        []string{"default/p"},
+				},
+				{
+					NodePlacement: sacorev1alpha1.NodePlacement{PoolName: "p1", TemplateName: "t1", InstanceType: "m5.large", Region: "eu-west-1", AvailabilityZone: "eu-west-1a",
+						CapacityType: commontypes.CapacityTypeSpot},
+					Delta: 1,
+				},
+			},
+			TotalHourlyCostDelta:  ptr.To(0.2),
+			UnsatisfiedPodReasons: []sacorev1alpha1.UnsatisfiedPodReason{{Reason: "Insufficient cpu", PodNames: []string{"default/q"}}},
+		},
+	}
+	if diff := cmp.Diff(want, ToResponse(FromResponse(&want))); diff != "" {
+		t.Errorf("response mismatch after round trip (-want +got):\n%s", diff)
+	}
+
+	got := ToResponse(FromResponse(&planner.Response{ID: "plan-2", Error: planner.ErrNoScaleOutPlan}))
+	if got.Error == nil || got.Error.Error() != planner.ErrNoScaleOutPlan.Error() {
+		t.Errorf("error = %v after round trip, want %v", got.Error, planner.ErrNoScaleOutPlan)
+	}
+}
@@ -0,0 +1,2855 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        (unknown)
+// source: planner/v1alpha1/planner.proto
+
+package plannerv1alpha1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// RequestRef is the unique reference to a plan request.
+type RequestRef struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CorrelationId string                 `protobuf:"bytes,2,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestRef) Reset() {
+	*x = RequestRef{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestRef) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestRef) ProtoMessage() {}
+
+func (x *RequestRef) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestRef.ProtoReflect.Descriptor instead.
+func (*RequestRef) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RequestRef) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RequestRef) GetCorrelationId() string {
+	if x != nil {
+		return x.CorrelationId
+	}
+	return ""
+}
+
+// PlanRequest is a request to the scaling planner to generate scaling plans.
+type PlanRequest struct {
+	state                   protoimpl.MessageState   `protogen:"open.v1"`
+	Ref                     *RequestRef              `protobuf:"bytes,1,opt,name=ref,proto3" json:"ref,omitempty"`
+	CreationTime            *timestamppb.Timestamp   `protobuf:"bytes,2,opt,name=creation_time,json=creationTime,proto3" json:"creation_time,omitempty"`
+	Constraint              *ScalingConstraint       `protobuf:"bytes,3,opt,name=constraint,proto3" json:"constraint,omitempty"`
+	SimulatorStrategy       string                   `protobuf:"bytes,4,opt,name=simulator_strategy,json=simulatorStrategy,proto3" json:"simulator_strategy,omitempty"`
+	ScoringStrategy         string                   `protobuf:"bytes,5,opt,name=scoring_strategy,json=scoringStrategy,proto3" json:"scoring_strategy,omitempty"`
+	CompositeScoringWeights *CompositeScoringWeights `protobuf:"bytes,6,opt,name=composite_scoring_weights,json=compositeScoringWeights,proto3" json:"composite_scoring_weights,omitempty"`
+	AdviceGenerationMode    string                   `protobuf:"bytes,7,opt,name=advice_generation_mode,json=adviceGenerationMode,proto3" json:"advice_generation_mode,omitempty"`
+	Snapshot                *ClusterSnapshot         `protobuf:"bytes,8,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Feedback                *ScalingFeedbackSpec     `protobuf:"bytes,9,opt,name=feedback,proto3" json:"feedback,omitempty"`
+	AdviceGenerationTimeout *durationpb.Duration     `protobuf:"bytes,10,opt,name=advice_generation_timeout,json=adviceGenerationTimeout,proto3" json:"advice_generation_timeout,omitempty"`
+	DiagnosticVerbosity     uint32                   `protobuf:"varint,11,opt,name=diagnostic_verbosity,json=diagnosticVerbosity,proto3" json:"diagnostic_verbosity,omitempty"`
+	Seed                    *int64                   `protobuf:"varint,12,opt,name=seed,proto3,oneof" json:"seed,omitempty"`
+	SchedulerProfile        *SchedulerProfile        `protobuf:"bytes,13,opt,name=scheduler_profile,json=schedulerProfile,proto3" json:"scheduler_profile,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *PlanRequest) Reset() {
+	*x = PlanRequest{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanRequest) ProtoMessage() {}
+
+func (x *PlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanRequest.ProtoReflect.Descriptor instead.
+func (*PlanRequest) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlanRequest) GetRef() *RequestRef {
+	if x != nil {
+		return x.Ref
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetCreationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTime
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetConstraint() *ScalingConstraint {
+	if x != nil {
+		return x.Constraint
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetSimulatorStrategy() string {
+	if x != nil {
+		return x.SimulatorStrategy
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetScoringStrategy() string {
+	if x != nil {
+		return x.ScoringStrategy
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetCompositeScoringWeights() *CompositeScoringWeights {
+	if x != nil {
+		return x.CompositeScoringWeights
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetAdviceGenerationMode() string {
+	if x != nil {
+		return x.AdviceGenerationMode
+	}
+	return ""
+}
+
+func (x *PlanRequest) GetSnapshot() *ClusterSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetFeedback() *ScalingFeedbackSpec {
+	if x != nil {
+		return x.Feedback
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetAdviceGenerationTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.AdviceGenerationTimeout
+	}
+	return nil
+}
+
+func (x *PlanRequest) GetDiagnosticVerbosity() uint32 {
+	if x != nil {
+		return x.DiagnosticVerbosity
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetSeed() int64 {
+	if x != nil && x.Seed != nil {
+		return *x.Seed
+	}
+	return 0
+}
+
+func (x *PlanRequest) GetSchedulerProfile() *SchedulerProfile {
+	if x != nil {
+		return x.SchedulerProfile
+	}
+	return nil
+}
+
+// CompositeScoringWeights are the weights of the composite node scoring strategy.
+type CompositeScoringWeights struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Cost            float64                `protobuf:"fixed64,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Waste           float64                `protobuf:"fixed64,2,opt,name=waste,proto3" json:"waste,omitempty"`
+	UnscheduledPods float64                `protobuf:"fixed64,3,opt,name=unscheduled_pods,json=unscheduledPods,proto3" json:"unscheduled_pods,omitempty"`
+	ZoneSpread      float64                `protobuf:"fixed64,4,opt,name=zone_spread,json=zoneSpread,proto3" json:"zone_spread,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompositeScoringWeights) Reset() {
+	*x = CompositeScoringWeights{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompositeScoringWeights) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompositeScoringWeights) ProtoMessage() {}
+
+func (x *CompositeScoringWeights) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompositeScoringWeights.ProtoReflect.Descriptor instead.
+func (*CompositeScoringWeights) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CompositeScoringWeights) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *CompositeScoringWeights) GetWaste() float64 {
+	if x != nil {
+		return x.Waste
+	}
+	return 0
+}
+
+func (x *CompositeScoringWeights) GetUnscheduledPods() float64 {
+	if x != nil {
+		return x.UnscheduledPods
+	}
+	return 0
+}
+
+func (x *CompositeScoringWeights) GetZoneSpread() float64 {
+	if x != nil {
+		return x.ZoneSpread
+	}
+	return 0
+}
+
+// SchedulerProfile selects the kube-scheduler configuration of the simulations of a plan request.
+type SchedulerProfile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Name  string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// config_patch is a JSON merge patch applied to the versioned kube-scheduler configuration.
+	ConfigPatch   []byte `protobuf:"bytes,2,opt,name=config_patch,json=configPatch,proto3" json:"config_patch,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulerProfile) Reset() {
+	*x = SchedulerProfile{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulerProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerProfile) ProtoMessage() {}
+
+func (x *SchedulerProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerProfile.ProtoReflect.Descriptor instead.
+func (*SchedulerProfile) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SchedulerProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SchedulerProfile) GetConfigPatch() []byte {
+	if x != nil {
+		return x.ConfigPatch
+	}
+	return nil
+}
+
+// ObjectMeta holds the fields of the Kubernetes object metadata that are used by the scaling planner.
+type ObjectMeta struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace         string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Uid               string                 `protobuf:"bytes,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string      `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CreationTimestamp *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=creation_timestamp,json=creationTimestamp,proto3" json:"creation_timestamp,omitempty"`
+	DeletionTimestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=deletion_timestamp,json=deletionTimestamp,proto3" json:"deletion_timestamp,omitempty"`
+	OwnerReferences   []*OwnerReference      `protobuf:"bytes,8,rep,name=owner_references,json=ownerReferences,proto3" json:"owner_references,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ObjectMeta) Reset() {
+	*x = ObjectMeta{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ObjectMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObjectMeta) ProtoMessage() {}
+
+func (x *ObjectMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObjectMeta.ProtoReflect.Descriptor instead.
+func (*ObjectMeta) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ObjectMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ObjectMeta) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ObjectMeta) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *ObjectMeta) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ObjectMeta) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *ObjectMeta) GetCreationTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreationTimestamp
+	}
+	return nil
+}
+
+func (x *ObjectMeta) GetDeletionTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletionTimestamp
+	}
+	return nil
+}
+
+func (x *ObjectMeta) GetOwnerReferences() []*OwnerReference {
+	if x != nil {
+		return x.OwnerReferences
+	}
+	return nil
+}
+
+// OwnerReference is a reference to the owner of a Kubernetes object.
+type OwnerReference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApiVersion    string                 `protobuf:"bytes,1,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	Kind          string                 `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Uid           string                 `protobuf:"bytes,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	Controller    *bool                  `protobuf:"varint,5,opt,name=controller,proto3,oneof" json:"controller,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OwnerReference) Reset() {
+	*x = OwnerReference{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OwnerReference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerReference) ProtoMessage() {}
+
+func (x *OwnerReference) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OwnerReference.ProtoReflect.Descriptor instead.
+func (*OwnerReference) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *OwnerReference) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *OwnerReference) GetController() bool {
+	if x != nil && x.Controller != nil {
+		return *x.Controller
+	}
+	return false
+}
+
+// Taint is a Kubernetes node taint.
+type Taint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string                 `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Effect        string                 `protobuf:"bytes,3,opt,name=effect,proto3" json:"effect,omitempty"`
+	TimeAdded     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=time_added,json=timeAdded,proto3" json:"time_added,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Taint) Reset() {
+	*x = Taint{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Taint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Taint) ProtoMessage() {}
+
+func (x *Taint) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Taint.ProtoReflect.Descriptor instead.
+func (*Taint) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Taint) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Taint) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Taint) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *Taint) GetTimeAdded() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TimeAdded
+	}
+	return nil
+}
+
+// Toleration is a Kubernetes pod toleration.
+type Toleration struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Key               string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Operator          string                 `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+	Value             string                 `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Effect            string                 `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
+	TolerationSeconds *int64                 `protobuf:"varint,5,opt,name=toleration_seconds,json=tolerationSeconds,proto3,oneof" json:"toleration_seconds,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Toleration) Reset() {
+	*x = Toleration{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Toleration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Toleration) ProtoMessage() {}
+
+func (x *Toleration) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Toleration.ProtoReflect.Descriptor instead.
+func (*Toleration) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Toleration) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *Toleration) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Toleration) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *Toleration) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *Toleration) GetTolerationSeconds() int64 {
+	if x != nil && x.TolerationSeconds != nil {
+		return *x.TolerationSeconds
+	}
+	return 0
+}
+
+// ClusterSnapshot is the snapshot of the resources in a cluster.
+//
+// Kubernetes API types with deeply nested structures are carried as their JSON encoding in the fields suffixed with
+// _json, since the protobuf definitions of the Kubernetes API are not compatible with the Go protobuf API.
+type ClusterSnapshot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pods          []*PodInfo             `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"`
+	Nodes         []*NodeInfo            `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	DaemonSetPods []*PodInfo             `protobuf:"bytes,3,rep,name=daemon_set_pods,json=daemonSetPods,proto3" json:"daemon_set_pods,omitempty"`
+	Pvs           []*PVInfo              `protobuf:"bytes,4,rep,name=pvs,proto3" json:"pvs,omitempty"`
+	Pvcs          []*PVCInfo             `protobuf:"bytes,5,rep,name=pvcs,proto3" json:"pvcs,omitempty"`
+	// storage_classes_json are the JSON encoded storage.k8s.io/v1 StorageClasses.
+	StorageClassesJson [][]byte `protobuf:"bytes,6,rep,name=storage_classes_json,json=storageClassesJson,proto3" json:"storage_classes_json,omitempty"`
+	// priority_classes_json are the JSON encoded scheduling.k8s.io/v1 PriorityClasses.
+	PriorityClassesJson [][]byte `protobuf:"bytes,7,rep,name=priority_classes_json,json=priorityClassesJson,proto3" json:"priority_classes_json,omitempty"`
+	// runtime_classes_json are the JSON encoded node.k8s.io/v1 RuntimeClasses.
+	RuntimeClassesJson [][]byte `protobuf:"bytes,8,rep,name=runtime_classes_json,json=runtimeClassesJson,proto3" json:"runtime_classes_json,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ClusterSnapshot) Reset() {
+	*x = ClusterSnapshot{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClusterSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterSnapshot) ProtoMessage() {}
+
+func (x *ClusterSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterSnapshot.ProtoReflect.Descriptor instead.
+func (*ClusterSnapshot) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ClusterSnapshot) GetPods() []*PodInfo {
+	if x != nil {
+		return x.Pods
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetNodes() []*NodeInfo {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetDaemonSetPods() []*PodInfo {
+	if x != nil {
+		return x.DaemonSetPods
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPvs() []*PVInfo {
+	if x != nil {
+		return x.Pvs
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPvcs() []*PVCInfo {
+	if x != nil {
+		return x.Pvcs
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetStorageClassesJson() [][]byte {
+	if x != nil {
+		return x.StorageClassesJson
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetPriorityClassesJson() [][]byte {
+	if x != nil {
+		return x.PriorityClassesJson
+	}
+	return nil
+}
+
+func (x *ClusterSnapshot) GetRuntimeClassesJson() [][]byte {
+	if x != nil {
+		return x.RuntimeClassesJson
+	}
+	return nil
+}
+
+// PodInfo holds the scheduling relevant information about a pod.
+type PodInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *ObjectMeta            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// aggregated_requests maps resource names to quantities.
+	AggregatedRequests map[string]string `protobuf:"bytes,2,rep,name=aggregated_requests,json=aggregatedRequests,proto3" json:"aggregated_requests,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Overhead           map[string]string `protobuf:"bytes,3,rep,name=overhead,proto3" json:"overhead,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	NodeSelector       map[string]string `protobuf:"bytes,4,rep,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	NodeName           string            `protobuf:"bytes,5,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	SchedulerName      string            `protobuf:"bytes,6,opt,name=scheduler_name,json=schedulerName,proto3" json:"scheduler_name,omitempty"`
+	PriorityClassName  string            `protobuf:"bytes,7,opt,name=priority_class_name,json=priorityClassName,proto3" json:"priority_class_name,omitempty"`
+	Priority           int32             `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	PreemptionPolicy   string            `protobuf:"bytes,9,opt,name=preemption_policy,json=preemptionPolicy,proto3" json:"preemption_policy,omitempty"`
+	RuntimeClassName   string            `protobuf:"bytes,10,opt,name=runtime_class_name,json=runtimeClassName,proto3" json:"runtime_class_name,omitempty"`
+	Tolerations        []*Toleration     `protobuf:"bytes,11,rep,name=tolerations,proto3" json:"tolerations,omitempty"`
+	// affinity_json is the JSON encoded core/v1 Affinity.
+	AffinityJson []byte `protobuf:"bytes,12,opt,name=affinity_json,json=affinityJson,proto3" json:"affinity_json,omitempty"`
+	// volumes_json is the JSON encoded list of core/v1 Volumes.
+	VolumesJson []byte `protobuf:"bytes,13,opt,name=volumes_json,json=volumesJson,proto3" json:"volumes_json,omitempty"`
+	// topology_spread_constraints_json is the JSON encoded list of core/v1 TopologySpreadConstraints.
+	TopologySpreadConstraintsJson []byte `protobuf:"bytes,14,opt,name=topology_spread_constraints_json,json=topologySpreadConstraintsJson,proto3" json:"topology_spread_constraints_json,omitempty"`
+	// resource_claims_json is the JSON encoded list of core/v1 PodResourceClaims.
+	ResourceClaimsJson []byte `protobuf:"bytes,15,opt,name=resource_claims_json,json=resourceClaimsJson,proto3" json:"resource_claims_json,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *PodInfo) Reset() {
+	*x = PodInfo{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PodInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodInfo) ProtoMessage() {}
+
+func (x *PodInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodInfo.ProtoReflect.Descriptor instead.
+func (*PodInfo) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PodInfo) GetMetadata() *ObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PodInfo) GetAggregatedRequests() map[string]string {
+	if x != nil {
+		return x.AggregatedRequests
+	}
+	return nil
+}
+
+func (x *PodInfo) GetOverhead() map[string]string {
+	if x != nil {
+		return x.Overhead
+	}
+	return nil
+}
+
+func (x *PodInfo) GetNodeSelector() map[string]string {
+	if x != nil {
+		return x.NodeSelector
+	}
+	return nil
+}
+
+func (x *PodInfo) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetSchedulerName() string {
+	if x != nil {
+		return x.SchedulerName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetPriorityClassName() string {
+	if x != nil {
+		return x.PriorityClassName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PodInfo) GetPreemptionPolicy() string {
+	if x != nil {
+		return x.PreemptionPolicy
+	}
+	return ""
+}
+
+func (x *PodInfo) GetRuntimeClassName() string {
+	if x != nil {
+		return x.RuntimeClassName
+	}
+	return ""
+}
+
+func (x *PodInfo) GetTolerations() []*Toleration {
+	if x != nil {
+		return x.Tolerations
+	}
+	return nil
+}
+
+func (x *PodInfo) GetAffinityJson() []byte {
+	if x != nil {
+		return x.AffinityJson
+	}
+	return nil
+}
+
+func (x *PodInfo) GetVolumesJson() []byte {
+	if x != nil {
+		return x.VolumesJson
+	}
+	return nil
+}
+
+func (x *PodInfo) GetTopologySpreadConstraintsJson() []byte {
+	if x != nil {
+		return x.TopologySpreadConstraintsJson
+	}
+	return nil
+}
+
+func (x *PodInfo) GetResourceClaimsJson() []byte {
+	if x != nil {
+		return x.ResourceClaimsJson
+	}
+	return nil
+}
+
+// NodeInfo holds the scheduling relevant information about a node.
+type NodeInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Metadata      *ObjectMeta            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	InstanceType  string                 `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	Capacity      map[string]string      `protobuf:"bytes,3,rep,name=capacity,proto3" json:"capacity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Allocatable   map[string]string      `protobuf:"bytes,4,rep,name=allocatable,proto3" json:"allocatable,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Taints        []*Taint               `protobuf:"bytes,5,rep,name=taints,proto3" json:"taints,omitempty"`
+	Unschedulable bool                   `protobuf:"varint,6,opt,name=unschedulable,proto3" json:"unschedulable,omitempty"`
+	// conditions_json is the JSON encoded list of core/v1 NodeConditions.
+	ConditionsJson []byte `protobuf:"bytes,7,opt,name=conditions_json,json=conditionsJson,proto3" json:"conditions_json,omitempty"`
+	// csi_node_spec_json is the JSON encoded storage/v1 CSINodeSpec.
+	CsiNodeSpecJson []byte `protobuf:"bytes,8,opt,name=csi_node_spec_json,json=csiNodeSpecJson,proto3" json:"csi_node_spec_json,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NodeInfo) GetMetadata() *ObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *NodeInfo) GetCapacity() map[string]string {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetAllocatable() map[string]string {
+	if x != nil {
+		return x.Allocatable
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetTaints() []*Taint {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetUnschedulable() bool {
+	if x != nil {
+		return x.Unschedulable
+	}
+	return false
+}
+
+func (x *NodeInfo) GetConditionsJson() []byte {
+	if x != nil {
+		return x.ConditionsJson
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetCsiNodeSpecJson() []byte {
+	if x != nil {
+		return x.CsiNodeSpecJson
+	}
+	return nil
+}
+
+// PVInfo holds the scheduling relevant information about a PersistentVolume.
+type PVInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Metadata         *ObjectMeta            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Capacity         map[string]string      `protobuf:"bytes,2,rep,name=capacity,proto3" json:"capacity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ClaimRef         *NamespacedName        `protobuf:"bytes,3,opt,name=claim_ref,json=claimRef,proto3" json:"claim_ref,omitempty"`
+	StorageClassName string                 `protobuf:"bytes,4,opt,name=storage_class_name,json=storageClassName,proto3" json:"storage_class_name,omitempty"`
+	Phase            string                 `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	VolumeMode       string                 `protobuf:"bytes,6,opt,name=volume_mode,json=volumeMode,proto3" json:"volume_mode,omitempty"`
+	AccessModes      []string               `protobuf:"bytes,7,rep,name=access_modes,json=accessModes,proto3" json:"access_modes,omitempty"`
+	// node_affinity_json is the JSON encoded core/v1 NodeSelector.
+	NodeAffinityJson []byte `protobuf:"bytes,8,opt,name=node_affinity_json,json=nodeAffinityJson,proto3" json:"node_affinity_json,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PVInfo) Reset() {
+	*x = PVInfo{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVInfo) ProtoMessage() {}
+
+func (x *PVInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVInfo.ProtoReflect.Descriptor instead.
+func (*PVInfo) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *PVInfo) GetMetadata() *ObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PVInfo) GetCapacity() map[string]string {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *PVInfo) GetClaimRef() *NamespacedName {
+	if x != nil {
+		return x.ClaimRef
+	}
+	return nil
+}
+
+func (x *PVInfo) GetStorageClassName() string {
+	if x != nil {
+		return x.StorageClassName
+	}
+	return ""
+}
+
+func (x *PVInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *PVInfo) GetVolumeMode() string {
+	if x != nil {
+		return x.VolumeMode
+	}
+	return ""
+}
+
+func (x *PVInfo) GetAccessModes() []string {
+	if x != nil {
+		return x.AccessModes
+	}
+	return nil
+}
+
+func (x *PVInfo) GetNodeAffinityJson() []byte {
+	if x != nil {
+		return x.NodeAffinityJson
+	}
+	return nil
+}
+
+// PVCInfo holds the scheduling relevant information about a PersistentVolumeClaim.
+type PVCInfo struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Metadata *ObjectMeta            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	Phase    string                 `protobuf:"bytes,2,opt,name=phase,proto3" json:"phase,omitempty"`
+	// spec_json is the JSON encoded core/v1 PersistentVolumeClaimSpec.
+	SpecJson      []byte `protobuf:"bytes,3,opt,name=spec_json,json=specJson,proto3" json:"spec_json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PVCInfo) Reset() {
+	*x = PVCInfo{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PVCInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PVCInfo) ProtoMessage() {}
+
+func (x *PVCInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PVCInfo.ProtoReflect.Descriptor instead.
+func (*PVCInfo) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PVCInfo) GetMetadata() *ObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PVCInfo) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *PVCInfo) GetSpecJson() []byte {
+	if x != nil {
+		return x.SpecJson
+	}
+	return nil
+}
+
+// NamespacedName is the namespace and name of an object.
+type NamespacedName struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NamespacedName) Reset() {
+	*x = NamespacedName{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NamespacedName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NamespacedName) ProtoMessage() {}
+
+func (x *NamespacedName) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NamespacedName.ProtoReflect.Descriptor instead.
+func (*NamespacedName) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NamespacedName) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *NamespacedName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ScalingConstraint defines the constraints using which scaling plans are generated.
+type ScalingConstraint struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Metadata             *ObjectMeta            `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	DefaultBackoffPolicy *BackoffPolicy         `protobuf:"bytes,2,opt,name=default_backoff_policy,json=defaultBackoffPolicy,proto3" json:"default_backoff_policy,omitempty"`
+	ScaleInPolicy        *ScaleInPolicy         `protobuf:"bytes,3,opt,name=scale_in_policy,json=scaleInPolicy,proto3" json:"scale_in_policy,omitempty"`
+	ConsumerId           string                 `protobuf:"bytes,4,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	NodePools            []*NodePool            `protobuf:"bytes,5,rep,name=node_pools,json=nodePools,proto3" json:"node_pools,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ScalingConstraint) Reset() {
+	*x = ScalingConstraint{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingConstraint) ProtoMessage() {}
+
+func (x *ScalingConstraint) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingConstraint.ProtoReflect.Descriptor instead.
+func (*ScalingConstraint) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ScalingConstraint) GetMetadata() *ObjectMeta {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ScalingConstraint) GetDefaultBackoffPolicy() *BackoffPolicy {
+	if x != nil {
+		return x.DefaultBackoffPolicy
+	}
+	return nil
+}
+
+func (x *ScalingConstraint) GetScaleInPolicy() *ScaleInPolicy {
+	if x != nil {
+		return x.ScaleInPolicy
+	}
+	return nil
+}
+
+func (x *ScalingConstraint) GetConsumerId() string {
+	if x != nil {
+		return x.ConsumerId
+	}
+	return ""
+}
+
+func (x *ScalingConstraint) GetNodePools() []*NodePool {
+	if x != nil {
+		return x.NodePools
+	}
+	return nil
+}
+
+// NodePool is a node pool of a ScalingConstraint.
+type NodePool struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Name              string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Region            string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	AvailabilityZones []string               `protobuf:"bytes,3,rep,name=availability_zones,json=availabilityZones,proto3" json:"availability_zones,omitempty"`
+	Labels            map[string]string      `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Annotations       map[string]string      `protobuf:"bytes,5,rep,name=annotations,proto3" json:"annotations,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Taints            []*Taint               `protobuf:"bytes,6,rep,name=taints,proto3" json:"taints,omitempty"`
+	Quota             map[string]string      `protobuf:"bytes,7,rep,name=quota,proto3" json:"quota,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MinNodes          *int32                 `protobuf:"varint,8,opt,name=min_nodes,json=minNodes,proto3,oneof" json:"min_nodes,omitempty"`
+	MaxNodes          *int32                 `protobuf:"varint,9,opt,name=max_nodes,json=maxNodes,proto3,oneof" json:"max_nodes,omitempty"`
+	ZoneBalance       string                 `protobuf:"bytes,10,opt,name=zone_balance,json=zoneBalance,proto3" json:"zone_balance,omitempty"`
+	ScaleInPolicy     *ScaleInPolicy         `protobuf:"bytes,11,opt,name=scale_in_policy,json=scaleInPolicy,proto3" json:"scale_in_policy,omitempty"`
+	BackoffPolicy     *BackoffPolicy         `protobuf:"bytes,12,opt,name=backoff_policy,json=backoffPolicy,proto3" json:"backoff_policy,omitempty"`
+	Priority          int32                  `protobuf:"varint,13,opt,name=priority,proto3" json:"priority,omitempty"`
+	NodeTemplates     []*NodeTemplate        `protobuf:"bytes,14,rep,name=node_templates,json=nodeTemplates,proto3" json:"node_templates,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *NodePool) Reset() {
+	*x = NodePool{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePool) ProtoMessage() {}
+
+func (x *NodePool) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePool.ProtoReflect.Descriptor instead.
+func (*NodePool) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *NodePool) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodePool) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *NodePool) GetAvailabilityZones() []string {
+	if x != nil {
+		return x.AvailabilityZones
+	}
+	return nil
+}
+
+func (x *NodePool) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *NodePool) GetAnnotations() map[string]string {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+func (x *NodePool) GetTaints() []*Taint {
+	if x != nil {
+		return x.Taints
+	}
+	return nil
+}
+
+func (x *NodePool) GetQuota() map[string]string {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+func (x *NodePool) GetMinNodes() int32 {
+	if x != nil && x.MinNodes != nil {
+		return *x.MinNodes
+	}
+	return 0
+}
+
+func (x *NodePool) GetMaxNodes() int32 {
+	if x != nil && x.MaxNodes != nil {
+		return *x.MaxNodes
+	}
+	return 0
+}
+
+func (x *NodePool) GetZoneBalance() string {
+	if x != nil {
+		return x.ZoneBalance
+	}
+	return ""
+}
+
+func (x *NodePool) GetScaleInPolicy() *ScaleInPolicy {
+	if x != nil {
+		return x.ScaleInPolicy
+	}
+	return nil
+}
+
+func (x *NodePool) GetBackoffPolicy() *BackoffPolicy {
+	if x != nil {
+		return x.BackoffPolicy
+	}
+	return nil
+}
+
+func (x *NodePool) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *NodePool) GetNodeTemplates() []*NodeTemplate {
+	if x != nil {
+		return x.NodeTemplates
+	}
+	return nil
+}
+
+// NodeTemplate is a template of the nodes of a NodePool.
+type NodeTemplate struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Name           string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Architecture   string                 `protobuf:"bytes,2,opt,name=architecture,proto3" json:"architecture,omitempty"`
+	InstanceType   string                 `protobuf:"bytes,3,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	Priority       int32                  `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty"`
+	Capacity       map[string]string      `protobuf:"bytes,5,rep,name=capacity,proto3" json:"capacity,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	KubeReserved   map[string]string      `protobuf:"bytes,6,rep,name=kube_reserved,json=kubeReserved,proto3" json:"kube_reserved,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	SystemReserved map[string]string      `protobuf:"bytes,7,rep,name=system_reserved,json=systemReserved,proto3" json:"system_reserved,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MaxVolumes     int32                  `protobuf:"varint,8,opt,name=max_volumes,json=maxVolumes,proto3" json:"max_volumes,omitempty"`
+	CapacityType   string                 `protobuf:"bytes,9,opt,name=capacity_type,json=capacityType,proto3" json:"capacity_type,omitempty"`
+	MinNodes       *int32                 `protobuf:"varint,10,opt,name=min_nodes,json=minNodes,proto3,oneof" json:"min_nodes,omitempty"`
+	MaxNodes       *int32                 `protobuf:"varint,11,opt,name=max_nodes,json=maxNodes,proto3,oneof" json:"max_nodes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NodeTemplate) Reset() {
+	*x = NodeTemplate{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodeTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeTemplate) ProtoMessage() {}
+
+func (x *NodeTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeTemplate.ProtoReflect.Descriptor instead.
+func (*NodeTemplate) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *NodeTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NodeTemplate) GetArchitecture() string {
+	if x != nil {
+		return x.Architecture
+	}
+	return ""
+}
+
+func (x *NodeTemplate) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *NodeTemplate) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *NodeTemplate) GetCapacity() map[string]string {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *NodeTemplate) GetKubeReserved() map[string]string {
+	if x != nil {
+		return x.KubeReserved
+	}
+	return nil
+}
+
+func (x *NodeTemplate) GetSystemReserved() map[string]string {
+	if x != nil {
+		return x.SystemReserved
+	}
+	return nil
+}
+
+func (x *NodeTemplate) GetMaxVolumes() int32 {
+	if x != nil {
+		return x.MaxVolumes
+	}
+	return 0
+}
+
+func (x *NodeTemplate) GetCapacityType() string {
+	if x != nil {
+		return x.CapacityType
+	}
+	return ""
+}
+
+func (x *NodeTemplate) GetMinNodes() int32 {
+	if x != nil && x.MinNodes != nil {
+		return *x.MinNodes
+	}
+	return 0
+}
+
+func (x *NodeTemplate) GetMaxNodes() int32 {
+	if x != nil && x.MaxNodes != nil {
+		return *x.MaxNodes
+	}
+	return 0
+}
+
+// BackoffPolicy is the backoff policy of node placements with reported scale-out errors.
+type BackoffPolicy struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	InitialBackoff *durationpb.Duration   `protobuf:"bytes,1,opt,name=initial_backoff,json=initialBackoff,proto3" json:"initial_backoff,omitempty"`
+	MaxBackoff     *durationpb.Duration   `protobuf:"bytes,2,opt,name=max_backoff,json=maxBackoff,proto3" json:"max_backoff,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BackoffPolicy) Reset() {
+	*x = BackoffPolicy{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackoffPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackoffPolicy) ProtoMessage() {}
+
+func (x *BackoffPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackoffPolicy.ProtoReflect.Descriptor instead.
+func (*BackoffPolicy) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BackoffPolicy) GetInitialBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.InitialBackoff
+	}
+	return nil
+}
+
+func (x *BackoffPolicy) GetMaxBackoff() *durationpb.Duration {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return nil
+}
+
+// ScaleInPolicy is the policy for scaling in node pools.
+type ScaleInPolicy struct {
+	state                       protoimpl.MessageState `protogen:"open.v1"`
+	UtilizationThresholdPercent *int32                 `protobuf:"varint,1,opt,name=utilization_threshold_percent,json=utilizationThresholdPercent,proto3,oneof" json:"utilization_threshold_percent,omitempty"`
+	MaxNodesPerPlan             *int32                 `protobuf:"varint,2,opt,name=max_nodes_per_plan,json=maxNodesPerPlan,proto3,oneof" json:"max_nodes_per_plan,omitempty"`
+	MinNodeAge                  *durationpb.Duration   `protobuf:"bytes,3,opt,name=min_node_age,json=minNodeAge,proto3" json:"min_node_age,omitempty"`
+	unknownFields               protoimpl.UnknownFields
+	sizeCache                   protoimpl.SizeCache
+}
+
+func (x *ScaleInPolicy) Reset() {
+	*x = ScaleInPolicy{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleInPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleInPolicy) ProtoMessage() {}
+
+func (x *ScaleInPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleInPolicy.ProtoReflect.Descriptor instead.
+func (*ScaleInPolicy) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ScaleInPolicy) GetUtilizationThresholdPercent() int32 {
+	if x != nil && x.UtilizationThresholdPercent != nil {
+		return *x.UtilizationThresholdPercent
+	}
+	return 0
+}
+
+func (x *ScaleInPolicy) GetMaxNodesPerPlan() int32 {
+	if x != nil && x.MaxNodesPerPlan != nil {
+		return *x.MaxNodesPerPlan
+	}
+	return 0
+}
+
+func (x *ScaleInPolicy) GetMinNodeAge() *durationpb.Duration {
+	if x != nil {
+		return x.MinNodeAge
+	}
+	return nil
+}
+
+// ScalingFeedbackSpec is the scaling feedback reported for previous scaling plans.
+type ScalingFeedbackSpec struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	ConstraintRef         *NamespacedName        `protobuf:"bytes,1,opt,name=constraint_ref,json=constraintRef,proto3" json:"constraint_ref,omitempty"`
+	ScaleOutErrorInfos    []*ScaleOutErrorInfo   `protobuf:"bytes,2,rep,name=scale_out_error_infos,json=scaleOutErrorInfos,proto3" json:"scale_out_error_infos,omitempty"`
+	ScaleInErrorNodeNames []string               `protobuf:"bytes,3,rep,name=scale_in_error_node_names,json=scaleInErrorNodeNames,proto3" json:"scale_in_error_node_names,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *ScalingFeedbackSpec) Reset() {
+	*x = ScalingFeedbackSpec{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScalingFeedbackSpec) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScalingFeedbackSpec) ProtoMessage() {}
+
+func (x *ScalingFeedbackSpec) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScalingFeedbackSpec.ProtoReflect.Descriptor instead.
+func (*ScalingFeedbackSpec) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ScalingFeedbackSpec) GetConstraintRef() *NamespacedName {
+	if x != nil {
+		return x.ConstraintRef
+	}
+	return nil
+}
+
+func (x *ScalingFeedbackSpec) GetScaleOutErrorInfos() []*ScaleOutErrorInfo {
+	if x != nil {
+		return x.ScaleOutErrorInfos
+	}
+	return nil
+}
+
+func (x *ScalingFeedbackSpec) GetScaleInErrorNodeNames() []string {
+	if x != nil {
+		return x.ScaleInErrorNodeNames
+	}
+	return nil
+}
+
+// ScaleOutErrorInfo is the information about failed scale-outs of a node placement.
+type ScaleOutErrorInfo struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AvailabilityZone string                 `protobuf:"bytes,1,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone,omitempty"`
+	InstanceType     string                 `protobuf:"bytes,2,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	ErrorType        string                 `protobuf:"bytes,3,opt,name=error_type,json=errorType,proto3" json:"error_type,omitempty"`
+	FailCount        int32                  `protobuf:"varint,4,opt,name=fail_count,json=failCount,proto3" json:"fail_count,omitempty"`
+	LastFailureTime  *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_failure_time,json=lastFailureTime,proto3" json:"last_failure_time,omitempty"`
+	// capacity_type is the capacity type of the nodes that have failed creation. It is empty for on-demand capacity.
+	CapacityType  string `protobuf:"bytes,6,opt,name=capacity_type,json=capacityType,proto3" json:"capacity_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleOutErrorInfo) Reset() {
+	*x = ScaleOutErrorInfo{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleOutErrorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleOutErrorInfo) ProtoMessage() {}
+
+func (x *ScaleOutErrorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleOutErrorInfo.ProtoReflect.Descriptor instead.
+func (*ScaleOutErrorInfo) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ScaleOutErrorInfo) GetAvailabilityZone() string {
+	if x != nil {
+		return x.AvailabilityZone
+	}
+	return ""
+}
+
+func (x *ScaleOutErrorInfo) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *ScaleOutErrorInfo) GetErrorType() string {
+	if x != nil {
+		return x.ErrorType
+	}
+	return ""
+}
+
+func (x *ScaleOutErrorInfo) GetFailCount() int32 {
+	if x != nil {
+		return x.FailCount
+	}
+	return 0
+}
+
+func (x *ScaleOutErrorInfo) GetLastFailureTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastFailureTime
+	}
+	return nil
+}
+
+func (x *ScaleOutErrorInfo) GetCapacityType() string {
+	if x != nil {
+		return x.CapacityType
+	}
+	return ""
+}
+
+// PlanResponse is a response of the scaling planner carrying a scaling plan or an error.
+type PlanResponse struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	RequestRef   *RequestRef            `protobuf:"bytes,2,opt,name=request_ref,json=requestRef,proto3" json:"request_ref,omitempty"`
+	Labels       map[string]string      `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ScaleOutPlan *ScaleOutPlan          `protobuf:"bytes,4,opt,name=scale_out_plan,json=scaleOutPlan,proto3" json:"scale_out_plan,omitempty"`
+	ScaleInPlan  *ScaleInPlan           `protobuf:"bytes,5,opt,name=scale_in_plan,json=scaleInPlan,proto3" json:"scale_in_plan,omitempty"`
+	// error is the message of the terminal error of plan generation. No further responses are streamed after it.
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PlanResponse) Reset() {
+	*x = PlanResponse{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PlanResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlanResponse) ProtoMessage() {}
+
+func (x *PlanResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlanResponse.ProtoReflect.Descriptor instead.
+func (*PlanResponse) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PlanResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlanResponse) GetRequestRef() *RequestRef {
+	if x != nil {
+		return x.RequestRef
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetLabels() map[string]string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetScaleOutPlan() *ScaleOutPlan {
+	if x != nil {
+		return x.ScaleOutPlan
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetScaleInPlan() *ScaleInPlan {
+	if x != nil {
+		return x.ScaleInPlan
+	}
+	return nil
+}
+
+func (x *PlanResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// NodePlacement is the placement of nodes in a node pool.
+type NodePlacement struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	PoolName         string                 `protobuf:"bytes,1,opt,name=pool_name,json=poolName,proto3" json:"pool_name,omitempty"`
+	TemplateName     string                 `protobuf:"bytes,2,opt,name=template_name,json=templateName,proto3" json:"template_name,omitempty"`
+	InstanceType     string                 `protobuf:"bytes,3,opt,name=instance_type,json=instanceType,proto3" json:"instance_type,omitempty"`
+	Region           string                 `protobuf:"bytes,4,opt,name=region,proto3" json:"region,omitempty"`
+	AvailabilityZone string                 `protobuf:"bytes,5,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone,omitempty"`
+	// capacity_type is the capacity type of the nodes. It is empty for on-demand capacity.
+	CapacityType  string `protobuf:"bytes,6,opt,name=capacity_type,json=capacityType,proto3" json:"capacity_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NodePlacement) Reset() {
+	*x = NodePlacement{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NodePlacement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodePlacement) ProtoMessage() {}
+
+func (x *NodePlacement) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodePlacement.ProtoReflect.Descriptor instead.
+func (*NodePlacement) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NodePlacement) GetPoolName() string {
+	if x != nil {
+		return x.PoolName
+	}
+	return ""
+}
+
+func (x *NodePlacement) GetTemplateName() string {
+	if x != nil {
+		return x.TemplateName
+	}
+	return ""
+}
+
+func (x *NodePlacement) GetInstanceType() string {
+	if x != nil {
+		return x.InstanceType
+	}
+	return ""
+}
+
+func (x *NodePlacement) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *NodePlacement) GetAvailabilityZone() string {
+	if x != nil {
+		return x.AvailabilityZone
+	}
+	return ""
+}
+
+func (x *NodePlacement) GetCapacityType() string {
+	if x != nil {
+		return x.CapacityType
+	}
+	return ""
+}
+
+// ScaleOutPlan is the plan for scaling out node pools.
+type ScaleOutPlan struct {
+	state                      protoimpl.MessageState       `protogen:"open.v1"`
+	Items                      []*ScaleOutItem              `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalHourlyCostDelta       *float64                     `protobuf:"fixed64,2,opt,name=total_hourly_cost_delta,json=totalHourlyCostDelta,proto3,oneof" json:"total_hourly_cost_delta,omitempty"`
+	UnsatisfiedPodNames        []string                     `protobuf:"bytes,3,rep,name=unsatisfied_pod_names,json=unsatisfiedPodNames,proto3" json:"unsatisfied_pod_names,omitempty"`
+	QuotaUnsatisfiedPodNames   []string                     `protobuf:"bytes,4,rep,name=quota_unsatisfied_pod_names,json=quotaUnsatisfiedPodNames,proto3" json:"quota_unsatisfied_pod_names,omitempty"`
+	UnsatisfiedPodExplanations []*UnsatisfiedPodExplanation `protobuf:"bytes,5,rep,name=unsatisfied_pod_explanations,json=unsatisfiedPodExplanations,proto3" json:"unsatisfied_pod_explanations,omitempty"`
+	UnsatisfiedPodReasons      []*UnsatisfiedPodReason      `protobuf:"bytes,6,rep,name=unsatisfied_pod_reasons,json=unsatisfiedPodReasons,proto3" json:"unsatisfied_pod_reasons,omitempty"`
+	unknownFields              protoimpl.UnknownFields
+	sizeCache                  protoimpl.SizeCache
+}
+
+func (x *ScaleOutPlan) Reset() {
+	*x = ScaleOutPlan{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleOutPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleOutPlan) ProtoMessage() {}
+
+func (x *ScaleOutPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleOutPlan.ProtoReflect.Descriptor instead.
+func (*ScaleOutPlan) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ScaleOutPlan) GetItems() []*ScaleOutItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ScaleOutPlan) GetTotalHourlyCostDelta() float64 {
+	if x != nil && x.TotalHourlyCostDelta != nil {
+		return *x.TotalHourlyCostDelta
+	}
+	return 0
+}
+
+func (x *ScaleOutPlan) GetUnsatisfiedPodNames() []string {
+	if x != nil {
+		return x.UnsatisfiedPodNames
+	}
+	return nil
+}
+
+func (x *ScaleOutPlan) GetQuotaUnsatisfiedPodNames() []string {
+	if x != nil {
+		return x.QuotaUnsatisfiedPodNames
+	}
+	return nil
+}
+
+func (x *ScaleOutPlan) GetUnsatisfiedPodExplanations() []*UnsatisfiedPodExplanation {
+	if x != nil {
+		return x.UnsatisfiedPodExplanations
+	}
+	return nil
+}
+
+func (x *ScaleOutPlan) GetUnsatisfiedPodReasons() []*UnsatisfiedPodReason {
+	if x != nil {
+		return x.UnsatisfiedPodReasons
+	}
+	return nil
+}
+
+// ScaleOutItem is the scale-out of a node placement.
+type ScaleOutItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Placement       *NodePlacement         `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	CurrentReplicas int32                  `protobuf:"varint,2,opt,name=current_replicas,json=currentReplicas,proto3" json:"current_replicas,omitempty"`
+	Delta           int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	HourlyCostDelta *float64               `protobuf:"fixed64,4,opt,name=hourly_cost_delta,json=hourlyCostDelta,proto3,oneof" json:"hourly_cost_delta,omitempty"`
+	PodNames        []string               `protobuf:"bytes,5,rep,name=pod_names,json=podNames,proto3" json:"pod_names,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ScaleOutItem) Reset() {
+	*x = ScaleOutItem{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleOutItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleOutItem) ProtoMessage() {}
+
+func (x *ScaleOutItem) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleOutItem.ProtoReflect.Descriptor instead.
+func (*ScaleOutItem) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ScaleOutItem) GetPlacement() *NodePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *ScaleOutItem) GetCurrentReplicas() int32 {
+	if x != nil {
+		return x.CurrentReplicas
+	}
+	return 0
+}
+
+func (x *ScaleOutItem) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *ScaleOutItem) GetHourlyCostDelta() float64 {
+	if x != nil && x.HourlyCostDelta != nil {
+		return *x.HourlyCostDelta
+	}
+	return 0
+}
+
+func (x *ScaleOutItem) GetPodNames() []string {
+	if x != nil {
+		return x.PodNames
+	}
+	return nil
+}
+
+// UnsatisfiedPodExplanation explains why a pod could not be satisfied by a scale-out plan.
+type UnsatisfiedPodExplanation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PodName       string                 `protobuf:"bytes,1,opt,name=pod_name,json=podName,proto3" json:"pod_name,omitempty"`
+	Reasons       []string               `protobuf:"bytes,2,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsatisfiedPodExplanation) Reset() {
+	*x = UnsatisfiedPodExplanation{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsatisfiedPodExplanation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsatisfiedPodExplanation) ProtoMessage() {}
+
+func (x *UnsatisfiedPodExplanation) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsatisfiedPodExplanation.ProtoReflect.Descriptor instead.
+func (*UnsatisfiedPodExplanation) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *UnsatisfiedPodExplanation) GetPodName() string {
+	if x != nil {
+		return x.PodName
+	}
+	return ""
+}
+
+func (x *UnsatisfiedPodExplanation) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+// UnsatisfiedPodReason is a kube-scheduler failure reason along with the pods for which it was reported.
+type UnsatisfiedPodReason struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reason        string                 `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	PodNames      []string               `protobuf:"bytes,2,rep,name=pod_names,json=podNames,proto3" json:"pod_names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnsatisfiedPodReason) Reset() {
+	*x = UnsatisfiedPodReason{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnsatisfiedPodReason) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsatisfiedPodReason) ProtoMessage() {}
+
+func (x *UnsatisfiedPodReason) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsatisfiedPodReason.ProtoReflect.Descriptor instead.
+func (*UnsatisfiedPodReason) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UnsatisfiedPodReason) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UnsatisfiedPodReason) GetPodNames() []string {
+	if x != nil {
+		return x.PodNames
+	}
+	return nil
+}
+
+// ScaleInPlan is the plan for scaling in nodes.
+type ScaleInPlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ScaleInItem         `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleInPlan) Reset() {
+	*x = ScaleInPlan{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleInPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleInPlan) ProtoMessage() {}
+
+func (x *ScaleInPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleInPlan.ProtoReflect.Descriptor instead.
+func (*ScaleInPlan) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ScaleInPlan) GetItems() []*ScaleInItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ScaleInItem is the removal of a node of a node placement.
+type ScaleInItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Placement     *NodePlacement         `protobuf:"bytes,1,opt,name=placement,proto3" json:"placement,omitempty"`
+	NodeName      string                 `protobuf:"bytes,2,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScaleInItem) Reset() {
+	*x = ScaleInItem{}
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScaleInItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScaleInItem) ProtoMessage() {}
+
+func (x *ScaleInItem) ProtoReflect() protoreflect.Message {
+	mi := &file_planner_v1alpha1_planner_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScaleInItem.ProtoReflect.Descriptor instead.
+func (*ScaleInItem) Descriptor() ([]byte, []int) {
+	return file_planner_v1alpha1_planner_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ScaleInItem) GetPlacement() *NodePlacement {
+	if x != nil {
+		return x.Placement
+	}
+	return nil
+}
+
+func (x *ScaleInItem) GetNodeName() string {
+	if x != nil {
+		return x.NodeName
+	}
+	return ""
+}
+
+var File_planner_v1alpha1_planner_proto protoreflect.FileDescriptor
+
+const file_planner_v1alpha1_planner_proto_rawDesc = "" +
+	"\n" +
+	"\x1eplanner/v1alpha1/planner.proto\x12\x1fscalingadvisor.planner.v1alpha1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"C\n" +
+	"\n" +
+	"RequestRef\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12%\n" +
+	"\x0ecorrelation_id\x18\x02 \x01(\tR\rcorrelationId\"\x93\a\n" +
+	"\vPlanRequest\x12=\n" +
+	"\x03ref\x18\x01 \x01(\v2+.scalingadvisor.planner.v1alpha1.RequestRefR\x03ref\x12?\n" +
+	"\rcreation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fcreationTime\x12R\n" +
+	"\n" +
+	"constraint\x18\x03 \x01(\v22.scalingadvisor.planner.v1alpha1.ScalingConstraintR\n" +
+	"constraint\x12-\n" +
+	"\x12simulator_strategy\x18\x04 \x01(\tR\x11simulatorStrategy\x12)\n" +
+	"\x10scoring_strategy\x18\x05 \x01(\tR\x0fscoringStrategy\x12t\n" +
+	"\x19composite_scoring_weights\x18\x06 \x01(\v28.scalingadvisor.planner.v1alpha1.CompositeScoringWeightsR\x17compositeScoringWeights\x124\n" +
+	"\x16advice_generation_mode\x18\a \x01(\tR\x14adviceGenerationMode\x12L\n" +
+	"\bsnapshot\x18\b \x01(\v20.scalingadvisor.planner.v1alpha1.ClusterSnapshotR\bsnapshot\x12P\n" +
+	"\bfeedback\x18\t \x01(\v24.scalingadvisor.planner.v1alpha1.ScalingFeedbackSpecR\bfeedback\x12U\n" +
+	"\x19advice_generation_timeout\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\x17adviceGenerationTimeout\x121\n" +
+	"\x14diagnostic_verbosity\x18\v \x01(\rR\x13diagnosticVerbosity\x12\x17\n" +
+	"\x04seed\x18\f \x01(\x03H\x00R\x04seed\x88\x01\x01\x12^\n" +
+	"\x11scheduler_profile\x18\r \x01(\v21.scalingadvisor.planner.v1alpha1.SchedulerProfileR\x10schedulerProfileB\a\n" +
+	"\x05_seed\"\x8f\x01\n" +
+	"\x17CompositeScoringWeights\x12\x12\n" +
+	"\x04cost\x18\x01 \x01(\x01R\x04cost\x12\x14\n" +
+	"\x05waste\x18\x02 \x01(\x01R\x05waste\x12)\n" +
+	"\x10unscheduled_pods\x18\x03 \x01(\x01R\x0funscheduledPods\x12\x1f\n" +
+	"\vzone_spread\x18\x04 \x01(\x01R\n" +
+	"zoneSpread\"I\n" +
+	"\x10SchedulerProfile\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fconfig_patch\x18\x02 \x01(\fR\vconfigPatch\"\xee\x04\n" +
+	"\n" +
+	"ObjectMeta\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x10\n" +
+	"\x03uid\x18\x03 \x01(\tR\x03uid\x12O\n" +
+	"\x06labels\x18\x04 \x03(\v27.scalingadvisor.planner.v1alpha1.ObjectMeta.LabelsEntryR\x06labels\x12^\n" +
+	"\vannotations\x18\x05 \x03(\v2<.scalingadvisor.planner.v1alpha1.ObjectMeta.AnnotationsEntryR\vannotations\x12I\n" +
+	"\x12creation_timestamp\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x11creationTimestamp\x12I\n" +
+	"\x12deletion_timestamp\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x11deletionTimestamp\x12Z\n" +
+	"\x10owner_references\x18\b \x03(\v2/.scalingadvisor.planner.v1alpha1.OwnerReferenceR\x0fownerReferences\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9f\x01\n" +
+	"\x0eOwnerReference\x12\x1f\n" +
+	"\vapi_version\x18\x01 \x01(\tR\n" +
+	"apiVersion\x12\x12\n" +
+	"\x04kind\x18\x02 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x10\n" +
+	"\x03uid\x18\x04 \x01(\tR\x03uid\x12#\n" +
+	"\n" +
+	"controller\x18\x05 \x01(\bH\x00R\n" +
+	"controller\x88\x01\x01B\r\n" +
+	"\v_controller\"\x82\x01\n" +
+	"\x05Taint\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x03 \x01(\tR\x06effect\x129\n" +
+	"\n" +
+	"time_added\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\ttimeAdded\"\xb3\x01\n" +
+	"\n" +
+	"Toleration\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x1a\n" +
+	"\boperator\x18\x02 \x01(\tR\boperator\x12\x14\n" +
+	"\x05value\x18\x03 \x01(\tR\x05value\x12\x16\n" +
+	"\x06effect\x18\x04 \x01(\tR\x06effect\x122\n" +
+	"\x12toleration_seconds\x18\x05 \x01(\x03H\x00R\x11tolerationSeconds\x88\x01\x01B\x15\n" +
+	"\x13_toleration_seconds\"\xf3\x03\n" +
+	"\x0fClusterSnapshot\x12<\n" +
+	"\x04pods\x18\x01 \x03(\v2(.scalingadvisor.planner.v1alpha1.PodInfoR\x04pods\x12?\n" +
+	"\x05nodes\x18\x02 \x03(\v2).scalingadvisor.planner.v1alpha1.NodeInfoR\x05nodes\x12P\n" +
+	"\x0fdaemon_set_pods\x18\x03 \x03(\v2(.scalingadvisor.planner.v1alpha1.PodInfoR\rdaemonSetPods\x129\n" +
+	"\x03pvs\x18\x04 \x03(\v2'.scalingadvisor.planner.v1alpha1.PVInfoR\x03pvs\x12<\n" +
+	"\x04pvcs\x18\x05 \x03(\v2(.scalingadvisor.planner.v1alpha1.PVCInfoR\x04pvcs\x120\n" +
+	"\x14storage_classes_json\x18\x06 \x03(\fR\x12storageClassesJson\x122\n" +
+	"\x15priority_classes_json\x18\a \x03(\fR\x13priorityClassesJson\x120\n" +
+	"\x14runtime_classes_json\x18\b \x03(\fR\x12runtimeClassesJson\"\xbc\b\n" +
+	"\aPodInfo\x12G\n" +
+	"\bmetadata\x18\x01 \x01(\v2+.scalingadvisor.planner.v1alpha1.ObjectMetaR\bmetadata\x12q\n" +
+	"\x13aggregated_requests\x18\x02 \x03(\v2@.scalingadvisor.planner.v1alpha1.PodInfo.AggregatedRequestsEntryR\x12aggregatedRequests\x12R\n" +
+	"\boverhead\x18\x03 \x03(\v26.scalingadvisor.planner.v1alpha1.PodInfo.OverheadEntryR\boverhead\x12_\n" +
+	"\rnode_selector\x18\x04 \x03(\v2:.scalingadvisor.planner.v1alpha1.PodInfo.NodeSelectorEntryR\fnodeSelector\x12\x1b\n" +
+	"\tnode_name\x18\x05 \x01(\tR\bnodeName\x12%\n" +
+	"\x0escheduler_name\x18\x06 \x01(\tR\rschedulerName\x12.\n" +
+	"\x13priority_class_name\x18\a \x01(\tR\x11priorityClassName\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12+\n" +
+	"\x11preemption_policy\x18\t \x01(\tR\x10preemptionPolicy\x12,\n" +
+	"\x12runtime_class_name\x18\n" +
+	" \x01(\tR\x10runtimeClassName\x12M\n" +
+	"\vtolerations\x18\v \x03(\v2+.scalingadvisor.planner.v1alpha1.TolerationR\vtolerations\x12#\n" +
+	"\raffinity_json\x18\f \x01(\fR\faffinityJson\x12!\n" +
+	"\fvolumes_json\x18\r \x01(\fR\vvolumesJson\x12G\n" +
+	" topology_spread_constraints_json\x18\x0e \x01(\fR\x1dtopologySpreadConstraintsJson\x120\n" +
+	"\x14resource_claims_json\x18\x0f \x01(\fR\x12resourceClaimsJson\x1aE\n" +
+	"\x17AggregatedRequestsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
+	"\rOverheadEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11NodeSelectorEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe4\x04\n" +
+	"\bNodeInfo\x12G\n" +
+	"\bmetadata\x18\x01 \x01(\v2+.scalingadvisor.planner.v1alpha1.ObjectMetaR\bmetadata\x12#\n" +
+	"\rinstance_type\x18\x02 \x01(\tR\finstanceType\x12S\n" +
+	"\bcapacity\x18\x03 \x03(\v27.scalingadvisor.planner.v1alpha1.NodeInfo.CapacityEntryR\bcapacity\x12\\\n" +
+	"\vallocatable\x18\x04 \x03(\v2:.scalingadvisor.planner.v1alpha1.NodeInfo.AllocatableEntryR\vallocatable\x12>\n" +
+	"\x06taints\x18\x05 \x03(\v2&.scalingadvisor.planner.v1alpha1.TaintR\x06taints\x12$\n" +
+	"\runschedulable\x18\x06 \x01(\bR\runschedulable\x12'\n" +
+	"\x0fconditions_json\x18\a \x01(\fR\x0econditionsJson\x12+\n" +
+	"\x12csi_node_spec_json\x18\b \x01(\fR\x0fcsiNodeSpecJson\x1a;\n" +
+	"\rCapacityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AllocatableEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe5\x03\n" +
+	"\x06PVInfo\x12G\n" +
+	"\bmetadata\x18\x01 \x01(\v2+.scalingadvisor.planner.v1alpha1.ObjectMetaR\bmetadata\x12Q\n" +
+	"\bcapacity\x18\x02 \x03(\v25.scalingadvisor.planner.v1alpha1.PVInfo.CapacityEntryR\bcapacity\x12L\n" +
+	"\tclaim_ref\x18\x03 \x01(\v2/.scalingadvisor.planner.v1alpha1.NamespacedNameR\bclaimRef\x12,\n" +
+	"\x12storage_class_name\x18\x04 \x01(\tR\x10storageClassName\x12\x14\n" +
+	"\x05phase\x18\x05 \x01(\tR\x05phase\x12\x1f\n" +
+	"\vvolume_mode\x18\x06 \x01(\tR\n" +
+	"volumeMode\x12!\n" +
+	"\faccess_modes\x18\a \x03(\tR\vaccessModes\x12,\n" +
+	"\x12node_affinity_json\x18\b \x01(\fR\x10nodeAffinityJson\x1a;\n" +
+	"\rCapacityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x85\x01\n" +
+	"\aPVCInfo\x12G\n" +
+	"\bmetadata\x18\x01 \x01(\v2+.scalingadvisor.planner.v1alpha1.ObjectMetaR\bmetadata\x12\x14\n" +
+	"\x05phase\x18\x02 \x01(\tR\x05phase\x12\x1b\n" +
+	"\tspec_json\x18\x03 \x01(\fR\bspecJson\"B\n" +
+	"\x0eNamespacedName\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x85\x03\n" +
+	"\x11ScalingConstraint\x12G\n" +
+	"\bmetadata\x18\x01 \x01(\v2+.scalingadvisor.planner.v1alpha1.ObjectMetaR\bmetadata\x12d\n" +
+	"\x16default_backoff_policy\x18\x02 \x01(\v2..scalingadvisor.planner.v1alpha1.BackoffPolicyR\x14defaultBackoffPolicy\x12V\n" +
+	"\x0fscale_in_policy\x18\x03 \x01(\v2..scalingadvisor.planner.v1alpha1.ScaleInPolicyR\rscaleInPolicy\x12\x1f\n" +
+	"\vconsumer_id\x18\x04 \x01(\tR\n" +
+	"consumerId\x12H\n" +
+	"\n" +
+	"node_pools\x18\x05 \x03(\v2).scalingadvisor.planner.v1alpha1.NodePoolR\tnodePools\"\xf7\a\n" +
+	"\bNodePool\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06region\x18\x02 \x01(\tR\x06region\x12-\n" +
+	"\x12availability_zones\x18\x03 \x03(\tR\x11availabilityZones\x12M\n" +
+	"\x06labels\x18\x04 \x03(\v25.scalingadvisor.planner.v1alpha1.NodePool.LabelsEntryR\x06labels\x12\\\n" +
+	"\vannotations\x18\x05 \x03(\v2:.scalingadvisor.planner.v1alpha1.NodePool.AnnotationsEntryR\vannotations\x12>\n" +
+	"\x06taints\x18\x06 \x03(\v2&.scalingadvisor.planner.v1alpha1.TaintR\x06taints\x12J\n" +
+	"\x05quota\x18\a \x03(\v24.scalingadvisor.planner.v1alpha1.NodePool.QuotaEntryR\x05quota\x12 \n" +
+	"\tmin_nodes\x18\b \x01(\x05H\x00R\bminNodes\x88\x01\x01\x12 \n" +
+	"\tmax_nodes\x18\t \x01(\x05H\x01R\bmaxNodes\x88\x01\x01\x12!\n" +
+	"\fzone_balance\x18\n" +
+	" \x01(\tR\vzoneBalance\x12V\n" +
+	"\x0fscale_in_policy\x18\v \x01(\v2..scalingadvisor.planner.v1alpha1.ScaleInPolicyR\rscaleInPolicy\x12U\n" +
+	"\x0ebackoff_policy\x18\f \x01(\v2..scalingadvisor.planner.v1alpha1.BackoffPolicyR\rbackoffPolicy\x12\x1a\n" +
+	"\bpriority\x18\r \x01(\x05R\bpriority\x12T\n" +
+	"\x0enode_templates\x18\x0e \x03(\v2-.scalingadvisor.planner.v1alpha1.NodeTemplateR\rnodeTemplates\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10AnnotationsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a8\n" +
+	"\n" +
+	"QuotaEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_min_nodesB\f\n" +
+	"\n" +
+	"_max_nodes\"\x99\x06\n" +
+	"\fNodeTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\"\n" +
+	"\farchitecture\x18\x02 \x01(\tR\farchitecture\x12#\n" +
+	"\rinstance_type\x18\x03 \x01(\tR\finstanceType\x12\x1a\n" +
+	"\bpriority\x18\x04 \x01(\x05R\bpriority\x12W\n" +
+	"\bcapacity\x18\x05 \x03(\v2;.scalingadvisor.planner.v1alpha1.NodeTemplate.CapacityEntryR\bcapacity\x12d\n" +
+	"\rkube_reserved\x18\x06 \x03(\v2?.scalingadvisor.planner.v1alpha1.NodeTemplate.KubeReservedEntryR\fkubeReserved\x12j\n" +
+	"\x0fsystem_reserved\x18\a \x03(\v2A.scalingadvisor.planner.v1alpha1.NodeTemplate.SystemReservedEntryR\x0esystemReserved\x12\x1f\n" +
+	"\vmax_volumes\x18\b \x01(\x05R\n" +
+	"maxVolumes\x12#\n" +
+	"\rcapacity_type\x18\t \x01(\tR\fcapacityType\x12 \n" +
+	"\tmin_nodes\x18\n" +
+	" \x01(\x05H\x00R\bminNodes\x88\x01\x01\x12 \n" +
+	"\tmax_nodes\x18\v \x01(\x05H\x01R\bmaxNodes\x88\x01\x01\x1a;\n" +
+	"\rCapacityEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a?\n" +
+	"\x11KubeReservedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13SystemReservedEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\f\n" +
+	"\n" +
+	"_min_nodesB\f\n" +
+	"\n" +
+	"_max_nodes\"\x8f\x01\n" +
+	"\rBackoffPolicy\x12B\n" +
+	"\x0finitial_backoff\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x0einitialBackoff\x12:\n" +
+	"\vmax_backoff\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"maxBackoff\"\x80\x02\n" +
+	"\rScaleInPolicy\x12G\n" +
+	"\x1dutilization_threshold_percent\x18\x01 \x01(\x05H\x00R\x1butilizationThresholdPercent\x88\x01\x01\x120\n" +
+	"\x12max_nodes_per_plan\x18\x02 \x01(\x05H\x01R\x0fmaxNodesPerPlan\x88\x01\x01\x12;\n" +
+	"\fmin_node_age\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\n" +
+	"minNodeAgeB \n" +
+	"\x1e_utilization_threshold_percentB\x15\n" +
+	"\x13_max_nodes_per_plan\"\x8e\x02\n" +
+	"\x13ScalingFeedbackSpec\x12V\n" +
+	"\x0econstraint_ref\x18\x01 \x01(\v2/.scalingadvisor.planner.v1alpha1.NamespacedNameR\rconstraintRef\x12e\n" +
+	"\x15scale_out_error_infos\x18\x02 \x03(\v22.scalingadvisor.planner.v1alpha1.ScaleOutErrorInfoR\x12scaleOutErrorInfos\x128\n" +
+	"\x19scale_in_error_node_names\x18\x03 \x03(\tR\x15scaleInErrorNodeNames\"\x90\x02\n" +
+	"\x11ScaleOutErrorInfo\x12+\n" +
+	"\x11availability_zone\x18\x01 \x01(\tR\x10availabilityZone\x12#\n" +
+	"\rinstance_type\x18\x02 \x01(\tR\finstanceType\x12\x1d\n" +
+	"\n" +
+	"error_type\x18\x03 \x01(\tR\terrorType\x12\x1d\n" +
+	"\n" +
+	"fail_count\x18\x04 \x01(\x05R\tfailCount\x12F\n" +
+	"\x11last_failure_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x0flastFailureTime\x12#\n" +
+	"\rcapacity_type\x18\x06 \x01(\tR\fcapacityType\"\xb7\x03\n" +
+	"\fPlanResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12L\n" +
+	"\vrequest_ref\x18\x02 \x01(\v2+.scalingadvisor.planner.v1alpha1.RequestRefR\n" +
+	"requestRef\x12Q\n" +
+	"\x06labels\x18\x03 \x03(\v29.scalingadvisor.planner.v1alpha1.PlanResponse.LabelsEntryR\x06labels\x12S\n" +
+	"\x0escale_out_plan\x18\x04 \x01(\v2-.scalingadvisor.planner.v1alpha1.ScaleOutPlanR\fscaleOutPlan\x12P\n" +
+	"\rscale_in_plan\x18\x05 \x01(\v2,.scalingadvisor.planner.v1alpha1.ScaleInPlanR\vscaleInPlan\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\x1a9\n" +
+	"\vLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe0\x01\n" +
+	"\rNodePlacement\x12\x1b\n" +
+	"\tpool_name\x18\x01 \x01(\tR\bpoolName\x12#\n" +
+	"\rtemplate_name\x18\x02 \x01(\tR\ftemplateName\x12#\n" +
+	"\rinstance_type\x18\x03 \x01(\tR\finstanceType\x12\x16\n" +
+	"\x06region\x18\x04 \x01(\tR\x06region\x12+\n" +
+	"\x11availability_zone\x18\x05 \x01(\tR\x10availabilityZone\x12#\n" +
+	"\rcapacity_type\x18\x06 \x01(\tR\fcapacityType\"\x8b\x04\n" +
+	"\fScaleOutPlan\x12C\n" +
+	"\x05items\x18\x01 \x03(\v2-.scalingadvisor.planner.v1alpha1.ScaleOutItemR\x05items\x12:\n" +
+	"\x17total_hourly_cost_delta\x18\x02 \x01(\x01H\x00R\x14totalHourlyCostDelta\x88\x01\x01\x122\n" +
+	"\x15unsatisfied_pod_names\x18\x03 \x03(\tR\x13unsatisfiedPodNames\x12=\n" +
+	"\x1bquota_unsatisfied_pod_names\x18\x04 \x03(\tR\x18quotaUnsatisfiedPodNames\x12|\n" +
+	"\x1cunsatisfied_pod_explanations\x18\x05 \x03(\v2:.scalingadvisor.planner.v1alpha1.UnsatisfiedPodExplanationR\x1aunsatisfiedPodExplanations\x12m\n" +
+	"\x17unsatisfied_pod_reasons\x18\x06 \x03(\v25.scalingadvisor.planner.v1alpha1.UnsatisfiedPodReasonR\x15unsatisfiedPodReasonsB\x1a\n" +
+	"\x18_total_hourly_cost_delta\"\x81\x02\n" +
+	"\fScaleOutItem\x12L\n" +
+	"\tplacement\x18\x01 \x01(\v2..scalingadvisor.planner.v1alpha1.NodePlacementR\tplacement\x12)\n" +
+	"\x10current_replicas\x18\x02 \x01(\x05R\x0fcurrentReplicas\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12/\n" +
+	"\x11hourly_cost_delta\x18\x04 \x01(\x01H\x00R\x0fhourlyCostDelta\x88\x01\x01\x12\x1b\n" +
+	"\tpod_names\x18\x05 \x03(\tR\bpodNamesB\x14\n" +
+	"\x12_hourly_cost_delta\"P\n" +
+	"\x19UnsatisfiedPodExplanation\x12\x19\n" +
+	"\bpod_name\x18\x01 \x01(\tR\apodName\x12\x18\n" +
+	"\areasons\x18\x02 \x03(\tR\areasons\"K\n" +
+	"\x14UnsatisfiedPodReason\x12\x16\n" +
+	"\x06reason\x18\x01 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tpod_names\x18\x02 \x03(\tR\bpodNames\"Q\n" +
+	"\vScaleInPlan\x12B\n" +
+	"\x05items\x18\x01 \x03(\v2,.scalingadvisor.planner.v1alpha1.ScaleInItemR\x05items\"x\n" +
+	"\vScaleInItem\x12L\n" +
+	"\tplacement\x18\x01 \x01(\v2..scalingadvisor.planner.v1alpha1.NodePlacementR\tplacement\x12\x1b\n" +
+	"\tnode_name\x18\x02 \x01(\tR\bnodeName2w\n" +
+	"\x0eScalingPlanner\x12e\n" +
+	"\x04Plan\x12,.scalingadvisor.planner.v1alpha1.PlanRequest\x1a-.scalingadvisor.planner.v1alpha1.PlanResponse0\x01BPZNgithub.com/gardener/scaling-advisor/api/proto/planner/v1alpha1;plannerv1alpha1b\x06proto3"
+
+var (
+	file_planner_v1alpha1_planner_proto_rawDescOnce sync.Once
+	file_planner_v1alpha1_planner_proto_rawDescData []byte
+)
+
+func file_planner_v1alpha1_planner_proto_rawDescGZIP() []byte {
+	file_planner_v1alpha1_planner_proto_rawDescOnce.Do(func() {
+		file_planner_v1alpha1_planner_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_planner_v1alpha1_planner_proto_rawDesc), len(file_planner_v1alpha1_planner_proto_rawDesc)))
+	})
+	return file_planner_v1alpha1_planner_proto_rawDescData
+}
+
+var file_planner_v1alpha1_planner_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_planner_v1alpha1_planner_proto_goTypes = []any{
+	(*RequestRef)(nil),                // 0: scalingadvisor.planner.v1alpha1.RequestRef
+	(*PlanRequest)(nil),               // 1: scalingadvisor.planner.v1alpha1.PlanRequest
+	(*CompositeScoringWeights)(nil),   // 2: scalingadvisor.planner.v1alpha1.CompositeScoringWeights
+	(*SchedulerProfile)(nil),          // 3: scalingadvisor.planner.v1alpha1.SchedulerProfile
+	(*ObjectMeta)(nil),                // 4: scalingadvisor.planner.v1alpha1.ObjectMeta
+	(*OwnerReference)(nil),            // 5: scalingadvisor.planner.v1alpha1.OwnerReference
+	(*Taint)(nil),                     // 6: scalingadvisor.planner.v1alpha1.Taint
+	(*Toleration)(nil),                // 7: scalingadvisor.planner.v1alpha1.Toleration
+	(*ClusterSnapshot)(nil),           // 8: scalingadvisor.planner.v1alpha1.ClusterSnapshot
+	(*PodInfo)(nil),                   // 9: scalingadvisor.planner.v1alpha1.PodInfo
+	(*NodeInfo)(nil),                  // 10: scalingadvisor.planner.v1alpha1.NodeInfo
+	(*PVInfo)(nil),                    // 11: scalingadvisor.planner.v1alpha1.PVInfo
+	(*PVCInfo)(nil),                   // 12: scalingadvisor.planner.v1alpha1.PVCInfo
+	(*NamespacedName)(nil),            // 13: scalingadvisor.planner.v1alpha1.NamespacedName
+	(*ScalingConstraint)(nil),         // 14: scalingadvisor.planner.v1alpha1.ScalingConstraint
+	(*NodePool)(nil),                  // 15: scalingadvisor.planner.v1alpha1.NodePool
+	(*NodeTemplate)(nil),              // 16: scalingadvisor.planner.v1alpha1.NodeTemplate
+	(*BackoffPolicy)(nil),             // 17: scalingadvisor.planner.v1alpha1.BackoffPolicy
+	(*ScaleInPolicy)(nil),             // 18: scalingadvisor.planner.v1alpha1.ScaleInPolicy
+	(*ScalingFeedbackSpec)(nil),       // 19: scalingadvisor.planner.v1alpha1.ScalingFeedbackSpec
+	(*ScaleOutErrorInfo)(nil),         // 20: scalingadvisor.planner.v1alpha1.ScaleOutErrorInfo
+	(*PlanResponse)(nil),              // 21: scalingadvisor.planner.v1alpha1.PlanResponse
+	(*NodePlacement)(nil),             // 22: scalingadvisor.planner.v1alpha1.NodePlacement
+	(*ScaleOutPlan)(nil),              // 23: scalingadvisor.planner.v1alpha1.ScaleOutPlan
+	(*ScaleOutItem)(nil),              // 24: scalingadvisor.planner.v1alpha1.ScaleOutItem
+	(*UnsatisfiedPodExplanation)(nil), // 25: scalingadvisor.planner.v1alpha1.UnsatisfiedPodExplanation
+	(*UnsatisfiedPodReason)(nil),      // 26: scalingadvisor.planner.v1alpha1.UnsatisfiedPodReason
+	(*ScaleInPlan)(nil),               // 27: scalingadvisor.planner.v1alpha1.ScaleInPlan
+	(*ScaleInItem)(nil),               // 28: scalingadvisor.planner.v1alpha1.ScaleInItem
+	nil,                               // 29: scalingadvisor.planner.v1alpha1.ObjectMeta.LabelsEntry
+	nil,                               // 30: scalingadvisor.planner.v1alpha1.ObjectMeta.AnnotationsEntry
+	nil,                               // 31: scalingadvisor.planner.v1alpha1.PodInfo.AggregatedRequestsEntry
+	nil,                               // 32: scalingadvisor.planner.v1alpha1.PodInfo.OverheadEntry
+	nil,                               // 33: scalingadvisor.planner.v1alpha1.PodInfo.NodeSelectorEntry
+	nil,                               // 34: scalingadvisor.planner.v1alpha1.NodeInfo.CapacityEntry
+	nil,                               // 35: scalingadvisor.planner.v1alpha1.NodeInfo.AllocatableEntry
+	nil,                               // 36: scalingadvisor.planner.v1alpha1.PVInfo.CapacityEntry
+	nil,                               // 37: scalingadvisor.planner.v1alpha1.NodePool.LabelsEntry
+	nil,                               // 38: scalingadvisor.planner.v1alpha1.NodePool.AnnotationsEntry
+	nil,                               // 39: scalingadvisor.planner.v1alpha1.NodePool.QuotaEntry
+	nil,                               // 40: scalingadvisor.planner.v1alpha1.NodeTemplate.CapacityEntry
+	nil,                               // 41: scalingadvisor.planner.v1alpha1.NodeTemplate.KubeReservedEntry
+	nil,                               // 42: scalingadvisor.planner.v1alpha1.NodeTemplate.SystemReservedEntry
+	nil,                               // 43: scalingadvisor.planner.v1alpha1.PlanResponse.LabelsEntry
+	(*timestamppb.Timestamp)(nil),     // 44: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),       // 45: google.protobuf.Duration
+}
+var file_planner_v1alpha1_planner_proto_depIdxs = []int32{
+	0,  // 0: scalingadvisor.planner.v1alpha1.PlanRequest.ref:type_name -> scalingadvisor.planner.v1alpha1.RequestRef
+	44, // 1: scalingadvisor.planner.v1alpha1.PlanRequest.creation_time:type_name -> google.protobuf.Timestamp
+	14, // 2: scalingadvisor.planner.v1alpha1.PlanRequest.constraint:type_name -> scalingadvisor.planner.v1alpha1.ScalingConstraint
+	2,  // 3: scalingadvisor.planner.v1alpha1.PlanRequest.composite_scoring_weights:type_name -> scalingadvisor.planner.v1alpha1.CompositeScoringWeights
+	8,  // 4: scalingadvisor.planner.v1alpha1.PlanRequest.snapshot:type_name -> scalingadvisor.planner.v1alpha1.ClusterSnapshot
+	19, // 5: scalingadvisor.planner.v1alpha1.PlanRequest.feedback:type_name -> scalingadvisor.planner.v1alpha1.ScalingFeedbackSpec
+	45, // 6: scalingadvisor.planner.v1alpha1.PlanRequest.advice_generation_timeout:type_name -> google.protobuf.Duration
+	3,  // 7: scalingadvisor.planner.v1alpha1.PlanRequest.scheduler_profile:type_name -> scalingadvisor.planner.v1alpha1.SchedulerProfile
+	29, // 8: scalingadvisor.planner.v1alpha1.ObjectMeta.labels:type_name -> scalingadvisor.planner.v1alpha1.ObjectMeta.LabelsEntry
+	30, // 9: scalingadvisor.planner.v1alpha1.ObjectMeta.annotations:type_name -> scalingadvisor.planner.v1alpha1.ObjectMeta.AnnotationsEntry
+	44, // 10: scalingadvisor.planner.v1alpha1.ObjectMeta.creation_timestamp:type_name -> google.protobuf.Timestamp
+	44, // 11: scalingadvisor.planner.v1alpha1.ObjectMeta.deletion_timestamp:type_name -> google.protobuf.Timestamp
+	5,  // 12: scalingadvisor.planner.v1alpha1.ObjectMeta.owner_references:type_name -> scalingadvisor.planner.v1alpha1.OwnerReference
+	44, // 13: scalingadvisor.planner.v1alpha1.Taint.time_added:type_name -> google.protobuf.Timestamp
+	9,  // 14: scalingadvisor.planner.v1alpha1.ClusterSnapshot.pods:type_name -> scalingadvisor.planner.v1alpha1.PodInfo
+	10, // 15: scalingadvisor.planner.v1alpha1.ClusterSnapshot.nodes:type_name -> scalingadvisor.planner.v1alpha1.NodeInfo
+	9,  // 16: scalingadvisor.planner.v1alpha1.ClusterSnapshot.daemon_set_pods:type_name -> scalingadvisor.planner.v1alpha1.PodInfo
+	11, // 17: scalingadvisor.planner.v1alpha1.ClusterSnapshot.pvs:type_name -> scalingadvisor.planner.v1alpha1.PVInfo
+	12, // 18: scalingadvisor.planner.v1alpha1.ClusterSnapshot.pvcs:type_name -> scalingadvisor.planner.v1alpha1.PVCInfo
+	4,  // 19: scalingadvisor.planner.v1alpha1.PodInfo.metadata:type_name -> scalingadvisor.planner.v1alpha1.ObjectMeta
+	31, // 20: scalingadvisor.planner.v1alpha1.PodInfo.aggregated_requests:type_name -> scalingadvisor.planner.v1alpha1.PodInfo.AggregatedRequestsEntry
+	32, // 21: scalingadvisor.planner.v1alpha1.PodInfo.overhead:type_name -> scalingadvisor.planner.v1alpha1.PodInfo.OverheadEntry
+	33, // 22: scalingadvisor.planner.v1alpha1.PodInfo.node_selector:type_name -> scalingadvisor.planner.v1alpha1.PodInfo.NodeSelectorEntry
+	7,  // 23: scalingadvisor.planner.v1alpha1.PodInfo.tolerations:type_name -> scalingadvisor.planner.v1alpha1.Toleration
+	4,  // 24: scalingadvisor.planner.v1alpha1.NodeInfo.metadata:type_name -> scalingadvisor.planner.v1alpha1.ObjectMeta
+	34, // 25: scalingadvisor.planner.v1alpha1.NodeInfo.capacity:type_name -> scalingadvisor.planner.v1alpha1.NodeInfo.CapacityEntry
+	35, // 26: scalingadvisor.planner.v1alpha1.NodeInfo.allocatable:type_name -> scalingadvisor.planner.v1alpha1.NodeInfo.AllocatableEntry
+	6,  // 27: scalingadvisor.planner.v1alpha1.NodeInfo.taints:type_name -> scalingadvisor.planner.v1alpha1.Taint
+	4,  // 28: scalingadvisor.planner.v1alpha1.PVInfo.metadata:type_name -> scalingadvisor.planner.v1alpha1.ObjectMeta
+	36, // 29: scalingadvisor.planner.v1alpha1.PVInfo.capacity:type_name -> scalingadvisor.planner.v1alpha1.PVInfo.CapacityEntry
+	13, // 30: scalingadvisor.planner.v1alpha1.PVInfo.claim_ref:type_name -> scalingadvisor.planner.v1alpha1.NamespacedName
+	4,  // 31: scalingadvisor.planner.v1alpha1.PVCInfo.metadata:type_name -> scalingadvisor.planner.v1alpha1.ObjectMeta
+	4,  // 32: scalingadvisor.planner.v1alpha1.ScalingConstraint.metadata:type_name -> scalingadvisor.planner.v1alpha1.ObjectMeta
+	17, // 33: scalingadvisor.planner.v1alpha1.ScalingConstraint.default_backoff_policy:type_name -> scalingadvisor.planner.v1alpha1.BackoffPolicy
+	18, // 34: scalingadvisor.planner.v1alpha1.ScalingConstraint.scale_in_policy:type_name -> scalingadvisor.planner.v1alpha1.ScaleInPolicy
+	15, // 35: scalingadvisor.planner.v1alpha1.ScalingConstraint.node_pools:type_name -> scalingadvisor.planner.v1alpha1.NodePool
+	37, // 36: scalingadvisor.planner.v1alpha1.NodePool.labels:type_name -> scalingadvisor.planner.v1alpha1.NodePool.LabelsEntry
+	38, // 37: scalingadvisor.planner.v1alpha1.NodePool.annotations:type_name -> scalingadvisor.planner.v1alpha1.NodePool.AnnotationsEntry
+	6,  // 38: scalingadvisor.planner.v1alpha1.NodePool.taints:type_name -> scalingadvisor.planner.v1alpha1.Taint
+	39, // 39: scalingadvisor.planner.v1alpha1.NodePool.quota:type_name -> scalingadvisor.planner.v1alpha1.NodePool.QuotaEntry
+	18, // 40: scalingadvisor.planner.v1alpha1.NodePool.scale_in_policy:type_name -> scalingadvisor.planner.v1alpha1.ScaleInPolicy
+	17, // 41: scalingadvisor.planner.v1alpha1.NodePool.backoff_policy:type_name -> scalingadvisor.planner.v1alpha1.BackoffPolicy
+	16, // 42: scalingadvisor.planner.v1alpha1.NodePool.node_templates:type_name -> scalingadvisor.planner.v1alpha1.NodeTemplate
+	40, // 43: scalingadvisor.planner.v1alpha1.NodeTemplate.capacity:type_name -> scalingadvisor.planner.v1alpha1.NodeTemplate.CapacityEntry
+	41, // 44: scalingadvisor.planner.v1alpha1.NodeTemplate.kube_reserved:type_name -> scalingadvisor.planner.v1alpha1.NodeTemplate.KubeReservedEntry
+	42, // 45: scalingadvisor.planner.v1alpha1.NodeTemplate.system_reserved:type_name -> scalingadvisor.planner.v1alpha1.NodeTemplate.SystemReservedEntry
+	45, // 46: scalingadvisor.planner.v1alpha1.BackoffPolicy.initial_backoff:type_name -> google.protobuf.Duration
+	45, // 47: scalingadvisor.planner.v1alpha1.BackoffPolicy.max_backoff:type_name -> google.protobuf.Duration
+	45, // 48: scalingadvisor.planner.v1alpha1.ScaleInPolicy.min_node_age:type_name -> google.protobuf.Duration
+	13, // 49: scalingadvisor.planner.v1alpha1.ScalingFeedbackSpec.constraint_ref:type_name -> scalingadvisor.planner.v1alpha1.NamespacedName
+	20, // 50: scalingadvisor.planner.v1alpha1.ScalingFeedbackSpec.scale_out_error_infos:type_name -> scalingadvisor.planner.v1alpha1.ScaleOutErrorInfo
+	44, // 51: scalingadvisor.planner.v1alpha1.ScaleOutErrorInfo.last_failure_time:type_name -> google.protobuf.Timestamp
+	0,  // 52: scalingadvisor.planner.v1alpha1.PlanResponse.request_ref:type_name -> scalingadvisor.planner.v1alpha1.RequestRef
+	43, // 53: scalingadvisor.planner.v1alpha1.PlanResponse.labels:type_name -> scalingadvisor.planner.v1alpha1.PlanResponse.LabelsEntry
+	23, // 54: scalingadvisor.planner.v1alpha1.PlanResponse.scale_out_plan:type_name -> scalingadvisor.planner.v1alpha1.ScaleOutPlan
+	27, // 55: scalingadvisor.planner.v1alpha1.PlanResponse.scale_in_plan:type_name -> scalingadvisor.planner.v1alpha1.ScaleInPlan
+	24, // 56: scalingadvisor.planner.v1alpha1.ScaleOutPlan.items:type_name -> scalingadvisor.planner.v1alpha1.ScaleOutItem
+	25, // 57: scalingadvisor.planner.v1alpha1.ScaleOutPlan.unsatisfied_pod_explanations:type_name -> scalingadvisor.planner.v1alpha1.UnsatisfiedPodExplanation
+	26, // 58: scalingadvisor.planner.v1alpha1.ScaleOutPlan.unsatisfied_pod_reasons:type_name -> scalingadvisor.planner.v1alpha1.UnsatisfiedPodReason
+	22, // 59: scalingadvisor.planner.v1alpha1.ScaleOutItem.placement:type_name -> scalingadvisor.planner.v1alpha1.NodePlacement
+	28, // 60: scalingadvisor.planner.v1alpha1.ScaleInPlan.items:type_name -> scalingadvisor.planner.v1alpha1.ScaleInItem
+	22, // 61: scalingadvisor.planner.v1alpha1.ScaleInItem.placement:type_name -> scalingadvisor.planner.v1alpha1.NodePlacement
+	1,  // 62: scalingadvisor.planner.v1alpha1.ScalingPlanner.Plan:input_type -> scalingadvisor.planner.v1alpha1.PlanRequest
+	21, // 63: scalingadvisor.planner.v1alpha1.ScalingPlanner.Plan:output_type -> scalingadvisor.planner.v1alpha1.PlanResponse
+	63, // [63:64] is the sub-list for method output_type
+	62, // [62:63] is the sub-list for method input_type
+	62, // [62:62] is the sub-list for extension type_name
+	62, // [62:62] is the sub-list for extension extendee
+	0,  // [0:62] is the sub-list for field type_name
+}
+
+func init() { file_planner_v1alpha1_planner_proto_init() }
+func file_planner_v1alpha1_planner_proto_init() {
+	if File_planner_v1alpha1_planner_proto != nil {
+		return
+	}
+	file_planner_v1alpha1_planner_proto_msgTypes[1].OneofWrappers = []any{}
+	file_planner_v1alpha1_planner_proto_msgTypes[5].OneofWrappers = []any{}
+	file_planner_v1alpha1_planner_proto_msgTypes[7].OneofWrappers = []any{}
+	file_planner_v1alpha1_planner_proto_msgTypes[15].OneofWrappers = []any{}
+	file_planner_v1alpha1_planner_proto_msgTypes[16].OneofWrappers = []any{}
+	file_planner_v1alpha1_planner_proto_msgTypes[18].OneofWrappers = []any{}
+	file_planner_v1alpha1_planner_proto_msgTypes[23].OneofWrappers = []any{}
+	file_planner_v1alpha1_planner_proto_msgTypes[24].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_planner_v1alpha1_planner_proto_rawDesc), len(file_planner_v1alpha1_planner_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   44,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_planner_v1alpha1_planner_proto_goTypes,
+		DependencyIndexes: file_planner_v1alpha1_planner_proto_depIdxs,
+		MessageInfos:      file_planner_v1alpha1_planner_proto_msgTypes,
+	}.Build()
+	File_planner_v1alpha1_planner_proto = out.File
+	file_planner_v1alpha1_planner_proto_goTypes = nil
+	file_planner_v1alpha1_planner_proto_depIdxs = nil
+}
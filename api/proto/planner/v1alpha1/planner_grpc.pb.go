@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: planner/v1alpha1/planner.proto
+
+package plannerv1alpha1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	ScalingPlanner_Plan_FullMethodName = "/scalingadvisor.planner.v1alpha1.ScalingPlanner/Plan"
+)
+
+// ScalingPlannerClient is the client API for ScalingPlanner service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ScalingPlanner generates scaling plans for a cluster. It mirrors the Go ScalingPlanner interface of the planner API.
+type ScalingPlannerClient interface {
+	// Plan generates scaling plans for the given request and streams them as responses. A single response is streamed
+	// in the all-at-once advice generation mode, and one response per scaling plan in the incremental mode. Plan
+	// generation is canceled if the stream is canceled by the client.
+	//
+	// An error that occurs before any response is streamed is returned as the status of the stream, with a code mapped
+	// from the sentinel error of the planner API. An error that occurs afterward is streamed as the error of the last
+	// response.
+	Plan(ctx context.Context, in *PlanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlanResponse], error)
+}
+
+type scalingPlannerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScalingPlannerClient(cc grpc.ClientConnInterface) ScalingPlannerClient {
+	return &scalingPlannerClient{cc}
+}
+
+func (c *scalingPlannerClient) Plan(ctx context.Context, in *PlanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[PlanResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &ScalingPlanner_ServiceDesc.Streams[0], ScalingPlanner_Plan_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[PlanRequest, PlanResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScalingPlanner_PlanClient = grpc.ServerStreamingClient[PlanResponse]
+
+// ScalingPlannerServer is the server API for ScalingPlanner service.
+// All implementations must embed UnimplementedScalingPlannerServer
+// for forward compatibility.
+//
+// ScalingPlanner generates scaling plans for a cluster. It mirrors the Go ScalingPlanner interface of the planner API.
+type ScalingPlannerServer interface {
+	// Plan generates scaling plans for the given request and streams them as responses. A single response is streamed
+	// in the all-at-once advice generation mode, and one response per scaling plan in the incremental mode. Plan
+	// generation is canceled if the stream is canceled by the client.
+	//
+	// An error that occurs before any response is streamed is returned as the status of the stream, with a code mapped
+	// from the sentinel error of the planner API. An error that occurs afterward is streamed as the error of the last
+	// response.
+	Plan(*PlanRequest, grpc.ServerStreamingServer[PlanResponse]) error
+	mustEmbedUnimplementedScalingPlannerServer()
+}
+
+// UnimplementedScalingPlannerServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedScalingPlannerServer struct{}
+
+func (UnimplementedScalingPlannerServer) Plan(*PlanRequest, grpc.ServerStreamingServer[PlanResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method Plan not implemented")
+}
+func (UnimplementedScalingPlannerServer) mustEmbedUnimplementedScalingPlannerServer() {}
+func (UnimplementedScalingPlannerServer) testEmbeddedByValue()                        {}
+
+// UnsafeScalingPlannerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScalingPlannerServer will
+// result in compilation errors.
+type UnsafeScalingPlannerServer interface {
+	mustEmbedUnimplementedScalingPlannerServer()
+}
+
+func RegisterScalingPlannerServer(s grpc.ServiceRegistrar, srv ScalingPlannerServer) {
+	// If the following call pancis, it indicates UnimplementedScalingPlannerServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ScalingPlanner_ServiceDesc, srv)
+}
+
+func _ScalingPlanner_Plan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ScalingPlannerServer).Plan(m, &grpc.GenericServerStream[PlanRequest, PlanResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type ScalingPlanner_PlanServer = grpc.ServerStreamingServer[PlanResponse]
+
+// ScalingPlanner_ServiceDesc is the grpc.ServiceDesc for ScalingPlanner service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScalingPlanner_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "scalingadvisor.planner.v1alpha1.ScalingPlanner",
+	HandlerType: (*ScalingPlannerServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Plan",
+			Handler:       _ScalingPlanner_Plan_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "planner/v1alpha1/planner.proto",
+}
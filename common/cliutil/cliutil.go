@@ -42,9 +42,10 @@ var (
 	ErrInvalidOpt = errors.New("invalid option value")
 )
 
-// MapServerConfigFlags adds the constants flags to the passed FlagSet.
-func MapServerConfigFlags(flagSet *pflag.FlagSet, opts *commontypes.ServerConfig) {
-	flagSet.StringVar(&opts.BindAddress, "bind-address", commonconstants.DefaultMinKAPIBindAddress, "bind address of the form <host>:<port>")
+// MapServerConfigFlags adds the constants flags to the passed FlagSet, where the bind address defaults to the given
+// defaultBindAddress.
+func MapServerConfigFlags(flagSet *pflag.FlagSet, opts *commontypes.ServerConfig, defaultBindAddress string) {
+	flagSet.StringVar(&opts.BindAddress, "bind-address", defaultBindAddress, "bind address of the form <host>:<port>")
 	flagSet.BoolVar(&opts.ProfilingEnabled, "profile", false, "enable pprof profiling")
 	flagSet.DurationVar(&opts.GracefulShutdownTimeout.Duration, "shutdown-timeout", commonconstants.DefaultGracefulShutdownTimeout, "graceful shutdown timeout")
 
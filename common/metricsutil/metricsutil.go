@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metricsutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Namespace is the Prometheus namespace of all metrics of the scaling advisor.
+	Namespace = "scaling_advisor"
+	// PlannerSubsystem is the Prometheus subsystem of the metrics of the scaling planner.
+	PlannerSubsystem = "planner"
+
+	// LabelKind is the label of planner metrics holding the plannerapi.PlanKind.
+	LabelKind = "kind"
+	// LabelReason is the label of the planner error metric holding the sentinel error of the recorded error.
+	LabelReason = "reason"
+
+	// ReasonUnknown is the reason of recorded errors that wrap none of the known sentinel errors.
+	ReasonUnknown = "Unknown"
+)
+
+// errorReasons maps sentinel errors to the reasons by which errors are recorded, in order of precedence. Sentinel
+// errors of causes precede those of the activities that wrap them.
+var errorReasons = []struct {
+	err    error
+	reason string
+}{
+	{plannerapi.ErrAdviceGenerationTimeout, "AdviceGenerationTimeout"},
+	{context.Canceled, "Canceled"},
+	{context.DeadlineExceeded, "DeadlineExceeded"},
+	{plannerapi.ErrNoUnscheduledPods, "NoUnscheduledPods"},
+	{plannerapi.ErrNoScaleOutPlan, "NoScaleOutPlan"},
+	{plannerapi.ErrNoScaleInPlan, "NoScaleInPlan"},
+	{plannerapi.ErrInvalidRequest, "InvalidRequest"},
+	{plannerapi.ErrInvalidScalingConstraint, "InvalidScalingConstraint"},
+	{plannerapi.ErrUnsupportedSimulatorStrategy, "UnsupportedSimulatorStrategy"},
+	{plannerapi.ErrCreateNodeScorer, "CreateNodeScorer"},
+	{plannerapi.ErrLaunchScheduler, "LaunchScheduler"},
+	{plannerapi.ErrBindClaimVolume, "BindClaimVolume"},
+	{plannerapi.ErrProvisionVolume, "ProvisionVolume"},
+	{plannerapi.ErrComputeNodeScore, "ComputeNodeScore"},
+	{plannerapi.ErrSelectNodeScore, "SelectNodeScore"},
+	{plannerapi.ErrPopulateRequestView, "PopulateRequestView"},
+	{plannerapi.ErrCreateSimulator, "CreateSimulator"},
+	{plannerapi.ErrCreateSimulation, "CreateSimulation"},
+	{plannerapi.ErrRunSimulation, "RunSimulation"},
+	{plannerapi.ErrRunSimulationGroup, "RunSimulationGroup"},
+}
+
+var (
+	_ plannerapi.MetricsRecorder = (*plannerMetricsRecorder)(nil)
+	_ plannerapi.MetricsRecorder = NopPlannerMetricsRecorder{}
+)
+
+// plannerMetricsRecorder records the metrics of the scaling planner into Prometheus collectors.
+type plannerMetricsRecorder struct {
+	planDuration            *prometheus.HistogramVec
+	simulationRuns          *prometheus.HistogramVec
+	groupPasses             prometheus.Histogram
+	unsatisfiedPods         prometheus.Histogram
+	schedulerLaunchDuration prometheus.Histogram
+	semaphoreWaitDuration   prometheus.Histogram
+	errors                  *prometheus.CounterVec
+}
+
+// NewPlannerMetricsRecorder creates a plannerapi.MetricsRecorder whose Prometheus collectors are registered with the
+// given registerer.
+func NewPlannerMetricsRecorder(registerer prometheus.Registerer) (plannerapi.MetricsRecorder, error) {
+	r := &plannerMetricsRecorder{
+		planDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: PlannerSubsystem,
+			Name:      "plan_generate_duration_seconds",
+			Help:      "Duration from the creation of a plan request until a scaling plan was generated for it.",
+			Buckets:   prometheus.ExponentialBuckets(0.05, 2, 12),
+		}, []string{LabelKind}),
+		simulationRuns: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: PlannerSubsystem,
+			Name:      "plan_simulation_runs",
+			Help:      "Number of simulation runs made to generate a scaling plan.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 12),
+		}, []string{LabelKind}),
+		groupPasses: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: PlannerSubsystem,
+			Name:      "simulation_group_passes",
+			Help:      "Number of passes executed for a scale-out simulation group.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 10),
+		}),
+		unsatisfiedPods: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: PlannerSubsystem,
+			Name:      "plan_unsatisfied_pods",
+			Help:      "Number of pods left unsatisfied by a scale-out plan.",
+			Buckets:   []float64{0, 1, 5, 10, 50, 100, 500, 1000},
+		}),
+		schedulerLaunchDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: PlannerSubsystem,
+			Name:      "scheduler_launch_duration_seconds",
+			Help:      "Duration taken to launch an embedded kube-scheduler, excluding the wait for a free slot.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 12),
+		}),
+		semaphoreWaitDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Subsystem: PlannerSubsystem,
+			Name:      "scheduler_semaphore_wait_duration_seconds",
+			Help:      "Duration waited for a free slot to launch an embedded kube-scheduler.",
+			Buckets:   prometheus.ExponentialBuckets(0.001, 2, 14),
+		}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Subsystem: PlannerSubsystem,
+			Name:      "errors_total",
+			Help:      "Number of plan generation errors by the sentinel error they wrap.",
+		}, []string{LabelReason}),
+	}
+	for _, c := range []prometheus.Collector{r.planDuration, r.simulationRuns, r.groupPasses, r.unsatisfiedPods,
+		r.schedulerLaunchDuration, r.semaphoreWaitDuration, r.errors} {
+		if err := registerer.Register(c); err != nil {
+			return nil, fmt.Errorf("cannot register planner metrics: %w", err)
+		}
+	}
+	return r, nil
+}
+
+func (r *plannerMetricsRecorder) RecordPlan(kind plannerapi.PlanKind, duration time.Duration, metrics plannerapi.Metrics, numUnsatisfiedPods int) {
+	r.planDuration.WithLabelValues(string(kind)).Observe(duration.Seconds())
+	r.simulationRuns.WithLabelValues(string(kind)).Observe(float64(metrics.SimulationRuns))
+	for _, passes := range metrics.GroupPasses {
+		r.groupPasses.Observe(float64(passes))
+	}
+	if kind == plannerapi.PlanKindScaleOut {
+		r.unsatisfiedPods.Observe(float64(numUnsatisfiedPods))
+	}
+}
+
+func (r *plannerMetricsRecorder) RecordError(err error) {
+	r.errors.WithLabelValues(GetErrorReason(err)).Inc()
+}
+
+func (r *plannerMetricsRecorder) RecordSchedulerLaunch(duration time.Duration) {
+	r.schedulerLaunchDuration.Observe(duration.Seconds())
+}
+
+func (r *plannerMetricsRecorder) RecordSemaphoreWait(duration time.Duration) {
+	r.semaphoreWaitDuration.Observe(duration.Seconds())
+}
+
+// GetErrorReason returns the reason by which the given err is recorded, which is derived from the first sentinel error
+// of the planner API it wraps, or ReasonUnknown if it wraps none.
+func GetErrorReason(err error) string {
+	for _, r := range errorReasons {
+		if errors.Is(err, r.err) {
+			return r.reason
+		}
+	}
+	return ReasonUnknown
+}
+
+// NopPlannerMetricsRecorder is a plannerapi.MetricsRecorder that discards all metrics.
+type NopPlannerMetricsRecorder struct{}
+
+// RecordPlan discards the metrics of a scaling plan.
+func (NopPlannerMetricsRecorder) RecordPlan(plannerapi.PlanKind, time.Duration, plannerapi.Metrics, int) {
+}
+
+// RecordError discards the error.
+func (NopPlannerMetricsRecorder) RecordError(error) {}
+
+// RecordSchedulerLaunch discards the launch duration.
+func (NopPlannerMetricsRecorder) RecordSchedulerLaunch(time.Duration) {}
+
+// RecordSemaphoreWait discards the wait duration.
+func (NopPlannerMetricsRecorder) RecordSemaphoreWait(time.Duration) {}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package metricsutil
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestPlannerMetricsRecorder(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	recorder, err := NewPlannerMetricsRecorder(registry)
+	if err != nil {
+		t.Fatalf("NewPlannerMetricsRecorder() error = %v", err)
+	}
+	recorder.RecordPlan(plannerapi.PlanKindScaleOut, 2*time.Second, plannerapi.Metrics{SimulationRuns: 6, GroupPasses: []int{2, 1}}, 3)
+	recorder.RecordPlan(plannerapi.PlanKindScaleIn, time.Second, plannerapi.Metrics{SimulationRuns: 2}, 0)
+	recorder.RecordError(plannerapi.AsGenError("r1", "", plannerapi.ErrNoScaleOutPlan))
+	recorder.RecordError(fmt.Errorf("%w: %w", plannerapi.ErrRunSimulation, plannerapi.ErrLaunchScheduler))
+	recorder.RecordSchedulerLaunch(10 * time.Millisecond)
+	recorder.RecordSemaphoreWait(time.Millisecond)
+
+	if got := testutil.CollectAndCount(registry); got != 10 {
+		t.Errorf("number of collected series = %d, want %d", got, 10)
+	}
+	want := `
+# HELP scaling_advisor_planner_errors_total Number of plan generation errors by the sentinel error they wrap.
+# TYPE scaling_advisor_planner_errors_total counter
+scaling_advisor_planner_errors_total{reason="LaunchScheduler"} 1
+scaling_advisor_planner_errors_total{reason="NoScaleOutPlan"} 1
+`
+	if err = testutil.GatherAndCompare(registry, strings.NewReader(want), "scaling_advisor_planner_errors_total"); err != nil {
+		t.Errorf("unexpected errors metric: %v", err)
+	}
+	if got := testutil.CollectAndCount(registry, "scaling_advisor_planner_plan_simulation_runs"); got != 2 {
+		t.Errorf("number of simulation runs series = %d, want %d", got, 2)
+	}
+
+	if _, err = NewPlannerMetricsRecorder(registry); err == nil {
+		t.Error("NewPlannerMetricsRecorder() error = nil for already registered metrics, want error")
+	}
+}
+
+func TestGetErrorReason(t *testing.T) {
+	tests := []struct {
+		err  error
+		want string
+	}{
+		{plannerapi.AsGenError("r1", "", fmt.Errorf("%w: %w", plannerapi.ErrAdviceGenerationTimeout, context.DeadlineExceeded)), "AdviceGenerationTimeout"},
+		{fmt.Errorf("%w: %w", plannerapi.ErrRunSimulationGroup, context.Canceled), "Canceled"},
+		{fmt.Errorf("%w: bad", plannerapi.ErrInvalidRequest), "InvalidRequest"},
+		{plannerapi.AsGenError("r1", "", fmt.Errorf("boom")), ReasonUnknown},
+	}
+	for _, tc := range tests {
+		if got := GetErrorReason(tc.err); got != tc.want {
+			t.Errorf("GetErrorReason(%v) = %q, want %q", tc.err, got, tc.want)
+		}
+	}
+}
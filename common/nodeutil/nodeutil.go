@@ -86,6 +86,7 @@ func AddNodeLabels(nodeLabels map[string]string, arch string, hostName string, p
 	nodeLabels[corev1.LabelHostname] = hostName
 	nodeLabels[commonconstants.LabelNodePoolName] = placement.PoolName
 	nodeLabels[commonconstants.LabelNodeTemplateName] = placement.TemplateName
+	nodeLabels[commonconstants.LabelCapacityType] = string(placement.CapacityType.OrDefault())
 }
 
 // NewCSINode returns a fresh CSINode object referring to the node with given name and uid and populated with the given CSISpec
@@ -160,6 +160,28 @@ func SubtractResources(a, b corev1.ResourceList) {
 	}
 }
 
+// AddResources adds the quantities in b to a. If a resource in b is not found in a, it is added to a.
+func AddResources(a, b corev1.ResourceList) {
+	for res, qty := range b {
+		v := a[res]
+		v.Add(qty)
+		a[res] = v
+	}
+}
+
+// ExceedsResources returns true if the quantity of any resource in limits is exceeded by the sum of the quantities of
+// the same resource in a and b.
+func ExceedsResources(a, b, limits corev1.ResourceList) bool {
+	for res, limit := range limits {
+		sum := a[res].DeepCopy()
+		sum.Add(b[res])
+		if sum.Cmp(limit) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 // PatchObject directly patches the given runtime object with the given patchBytes and using the given patch type.
 // TODO: Add unit test for this specific objutil method.
 func PatchObject(objPtr runtime.Object, name cache.ObjectName, patchType types.PatchType, patchBytes []byte) error {
@@ -14,6 +14,8 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1helpers "k8s.io/component-helpers/scheduling/corev1"
+	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 	"k8s.io/utils/ptr"
 )
 
@@ -201,3 +203,48 @@ func CountUnscheduledPods(pods []corev1.Pod) (count int) {
 func IsUnscheduledPod(pod *corev1.Pod) bool {
 	return pod.Spec.NodeName == ""
 }
+
+// IsNodeBoundPod determines if the given pod is bound to the lifecycle of its node, i.e. it is a DaemonSet pod or a
+// static (mirror) pod. Such pods are not re-placed onto other nodes when their node is removed.
+func IsNodeBoundPod(pod metav1.Object) bool {
+	if _, ok := pod.GetAnnotations()[corev1.MirrorPodAnnotationKey]; ok {
+		return true
+	}
+	for _, ref := range pod.GetOwnerReferences() {
+		if ref.Kind == "DaemonSet" && ref.Controller != nil && *ref.Controller {
+			return true
+		}
+	}
+	return false
+}
+
+// AsDaemonPod converts the given DaemonSet pod template to a corev1.Pod bound to the node with the given nodeName. The
+// pod is named after the DaemonSet and the node, and is controlled by the DaemonSet so that IsNodeBoundPod holds for it.
+func AsDaemonPod(template planner.PodInfo, nodeName string) *corev1.Pod {
+	pod := AsPod(template)
+	pod.ObjectMeta = metav1.ObjectMeta{
+		Name:        template.Name + "-" + nodeName,
+		Namespace:   template.Namespace,
+		Labels:      template.Labels,
+		Annotations: template.Annotations,
+		OwnerReferences: []metav1.OwnerReference{
+			{APIVersion: "apps/v1", Kind: "DaemonSet", Name: template.Name, UID: template.UID, Controller: ptr.To(true)},
+		},
+	}
+	pod.Spec.NodeName = nodeName
+	return pod
+}
+
+// CanRunDaemonPodOnNode checks whether the given daemon pod can run on the given node, i.e. whether the node matches
+// the node selector and required node affinity of the pod and the pod tolerates all NoSchedule and NoExecute taints of
+// the node.
+func CanRunDaemonPodOnNode(pod *corev1.Pod, node *corev1.Node) (bool, error) {
+	matches, err := nodeaffinity.GetRequiredNodeAffinity(pod).Match(node)
+	if err != nil || !matches {
+		return false, err
+	}
+	_, untolerated := corev1helpers.FindMatchingUntoleratedTaint(node.Spec.Taints, pod.Spec.Tolerations, func(t *corev1.Taint) bool {
+		return t.Effect == corev1.TaintEffectNoSchedule || t.Effect == corev1.TaintEffectNoExecute
+	})
+	return !untolerated, nil
+}
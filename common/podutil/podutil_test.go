@@ -8,10 +8,12 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gardener/scaling-advisor/api/planner"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 var transitionTime = metav1.NewTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
@@ -143,3 +145,94 @@ func TestUpdatePodCondition(t *testing.T) {
 		})
 	}
 }
+
+func TestIsNodeBoundPod(t *testing.T) {
+	tests := map[string]struct {
+		meta metav1.ObjectMeta
+		want bool
+	}{
+		"daemonset pod": {
+			meta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "ds", Controller: ptr.To(true)}}},
+			want: true,
+		},
+		"mirror pod": {
+			meta: metav1.ObjectMeta{Annotations: map[string]string{corev1.MirrorPodAnnotationKey: "abc"}},
+			want: true,
+		},
+		"replicaset pod": {
+			meta: metav1.ObjectMeta{OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: "rs", Controller: ptr.To(true)}}},
+			want: false,
+		},
+		"bare pod": {
+			meta: metav1.ObjectMeta{},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pod := &corev1.Pod{ObjectMeta: tc.meta}
+			if got := IsNodeBoundPod(pod); got != tc.want {
+				t.Errorf("IsNodeBoundPod() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCanRunDaemonPodOnNode(t *testing.T) {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: map[string]string{"pool": "a"}},
+		Spec: corev1.NodeSpec{Taints: []corev1.Taint{
+			{Key: "dedicated", Value: "gpu", Effect: corev1.TaintEffectNoSchedule},
+			{Key: "preferred", Effect: corev1.TaintEffectPreferNoSchedule},
+		}},
+	}
+	gpuToleration := corev1.Toleration{Key: "dedicated", Operator: corev1.TolerationOpEqual, Value: "gpu", Effect: corev1.TaintEffectNoSchedule}
+	tests := map[string]struct {
+		template planner.PodInfo
+		want     bool
+	}{
+		"tolerates taint": {
+			template: planner.PodInfo{Tolerations: []corev1.Toleration{gpuToleration}},
+			want:     true,
+		},
+		"untolerated taint": {
+			template: planner.PodInfo{},
+			want:     false,
+		},
+		"matching node selector": {
+			template: planner.PodInfo{NodeSelector: map[string]string{"pool": "a"}, Tolerations: []corev1.Toleration{gpuToleration}},
+			want:     true,
+		},
+		"mismatching node selector": {
+			template: planner.PodInfo{NodeSelector: map[string]string{"pool": "b"}, Tolerations: []corev1.Toleration{gpuToleration}},
+			want:     false,
+		},
+		"mismatching node affinity": {
+			template: planner.PodInfo{
+				Affinity: &corev1.Affinity{NodeAffinity: &corev1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+						MatchExpressions: []corev1.NodeSelectorRequirement{{Key: "pool", Operator: corev1.NodeSelectorOpNotIn, Values: []string{"a"}}},
+					}}},
+				}},
+				Tolerations: []corev1.Toleration{gpuToleration},
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			tc.template.Name = "ds"
+			pod := AsDaemonPod(tc.template, node.Name)
+			if pod.Name != "ds-n1" || pod.Spec.NodeName != node.Name || !IsNodeBoundPod(pod) {
+				t.Fatalf("AsDaemonPod() = pod %q on node %q, want node bound pod %q on node %q", pod.Name, pod.Spec.NodeName, "ds-n1", node.Name)
+			}
+			got, err := CanRunDaemonPodOnNode(pod, node)
+			if err != nil {
+				t.Fatalf("CanRunDaemonPodOnNode() error = %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("CanRunDaemonPodOnNode() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package traceutil
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.34.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer of the scaling advisor.
+const TracerName = "github.com/gardener/scaling-advisor"
+
+// Attribute keys of the spans of the scaling advisor.
+const (
+	// AttrRequestID is the ID of the planner request.
+	AttrRequestID = attribute.Key("sa.request.id")
+	// AttrCorrelationID is the correlation ID of the planner request.
+	AttrCorrelationID = attribute.Key("sa.request.correlation_id")
+	// AttrSimulatorStrategy is the simulator strategy of the planner request.
+	AttrSimulatorStrategy = attribute.Key("sa.request.simulator_strategy")
+	// AttrAdviceGenerationMode is the advice generation mode of the planner request.
+	AttrAdviceGenerationMode = attribute.Key("sa.request.advice_generation_mode")
+	// AttrSimulationGroup is the name of a simulation group.
+	AttrSimulationGroup = attribute.Key("sa.simulation.group")
+	// AttrPassNum is the number of a pass of a simulation group.
+	AttrPassNum = attribute.Key("sa.simulation.pass")
+	// AttrSimulation is the name of a simulation.
+	AttrSimulation = attribute.Key("sa.simulation.name")
+	// AttrRunNum is the run number of a simulation.
+	AttrRunNum = attribute.Key("sa.simulation.run")
+	// AttrPlacements are the node placements of a simulation formatted by FormatPlacement.
+	AttrPlacements = attribute.Key("sa.simulation.placements")
+	// AttrPodsScheduled is the number of pods scheduled by a simulation.
+	AttrPodsScheduled = attribute.Key("sa.simulation.pods_scheduled")
+	// AttrPodsUnscheduled is the number of pods left unscheduled by a simulation.
+	AttrPodsUnscheduled = attribute.Key("sa.simulation.pods_unscheduled")
+	// AttrPerformedWork is whether a simulation performed work.
+	AttrPerformedWork = attribute.Key("sa.simulation.performed_work")
+	// AttrScheduler is the name of an embedded kube-scheduler instance.
+	AttrScheduler = attribute.Key("sa.scheduler.name")
+)
+
+// StartSpan starts a span with the given name and attributes using the tracer of the scaling advisor from the global
+// TracerProvider. The span is a child of the span of the given ctx, if any.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the given err, if not nil, on the given span and sets its status to error before ending it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// FormatPlacement formats the given placement as PoolName/TemplateName/AvailabilityZone for span attributes.
+func FormatPlacement(placement sacorev1alpha1.NodePlacement) string {
+	return fmt.Sprintf("%s/%s/%s", placement.PoolName, placement.TemplateName, placement.AvailabilityZone)
+}
+
+// SetupTracing sets up the global TracerProvider to export the spans of the service with the given serviceName to the
+// OTLP endpoint and the file of the given TracingConfig, and the global propagator to propagate the W3C trace context
+// and baggage. The returned shutdown func flushes pending spans and releases the exporters. Nothing is set up and a
+// no-op shutdown func is returned if tracing is not enabled by the given config.
+func SetupTracing(ctx context.Context, cfg commontypes.TracingConfig, serviceName string) (shutdown func(context.Context) error, err error) {
+	shutdown = func(context.Context) error { return nil }
+	if !cfg.IsEnabled() {
+		return
+	}
+	var opts []sdktrace.TracerProviderOption
+	var file *os.File
+	var otlpExporter sdktrace.SpanExporter
+	if cfg.OTLPEndpoint != "" {
+		clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+		otlpExporter, err = otlptracegrpc.New(ctx, clientOpts...)
+		if err != nil {
+			return shutdown, fmt.Errorf("cannot create OTLP trace exporter for %q: %w", cfg.OTLPEndpoint, err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(otlpExporter))
+	}
+	if cfg.FilePath != "" {
+		var fileExporter sdktrace.SpanExporter
+		file, err = os.OpenFile(filepath.Clean(cfg.FilePath), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err == nil {
+			fileExporter, err = stdouttrace.New(stdouttrace.WithWriter(file))
+			if err != nil {
+				_ = file.Close()
+			}
+		}
+		if err != nil {
+			// the OTLP exporter holds a connection to the endpoint that must be released.
+			if otlpExporter != nil {
+				err = errors.Join(err, otlpExporter.Shutdown(ctx))
+			}
+			return shutdown, fmt.Errorf("cannot create file trace exporter for %q: %w", cfg.FilePath, err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(fileExporter))
+	}
+	samplingRatio := commonconstants.DefaultTracingSamplingRatio
+	if cfg.SamplingRatio != nil {
+		samplingRatio = *cfg.SamplingRatio
+	}
+	opts = append(opts,
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	provider := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	shutdown = func(ctx context.Context) error {
+		errs := []error{provider.Shutdown(ctx)}
+		if file != nil {
+			errs = append(errs, file.Close())
+		}
+		return errors.Join(errs...)
+	}
+	return
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package traceutil
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	"k8s.io/utils/ptr"
+)
+
+func TestSetupTracingWithFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "traces.json")
+	shutdown, err := SetupTracing(t.Context(), commontypes.TracingConfig{FilePath: filePath}, "test-service")
+	if err != nil {
+		t.Fatalf("SetupTracing() error = %v", err)
+	}
+	ctx, parent := StartSpan(t.Context(), "Plan", AttrRequestID.String("r1"))
+	_, child := StartSpan(ctx, "SimulationRun", AttrSimulation.String("sim-1"))
+	EndSpan(child, errors.New("boom"))
+	EndSpan(parent, nil)
+	if err = shutdown(t.Context()); err != nil {
+		t.Fatalf("shutdown() error = %v", err)
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		t.Fatalf("cannot read trace file: %v", err)
+	}
+	for _, want := range []string{`"Name":"Plan"`, `"Name":"SimulationRun"`, `"Value":"r1"`, `"Description":"boom"`, `"Value":"test-service"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("trace file does not contain %s:\n%s", want, data)
+		}
+	}
+	if parent.SpanContext().TraceID() != child.SpanContext().TraceID() {
+		t.Errorf("trace ID of child span = %v, want trace ID %v of parent span", child.SpanContext().TraceID(), parent.SpanContext().TraceID())
+	}
+}
+
+func TestSetupTracingSamplingRatio(t *testing.T) {
+	tests := map[string]struct {
+		samplingRatio *float64
+		wantSampled   bool
+	}{
+		"default":    {samplingRatio: nil, wantSampled: true},
+		"all traces": {samplingRatio: ptr.To(1.0), wantSampled: true},
+		"no traces":  {samplingRatio: ptr.To(0.0), wantSampled: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			cfg := commontypes.TracingConfig{FilePath: filepath.Join(t.TempDir(), "traces.json"), SamplingRatio: tc.samplingRatio}
+			shutdown, err := SetupTracing(t.Context(), cfg, "test-service")
+			if err != nil {
+				t.Fatalf("SetupTracing() error = %v", err)
+			}
+			_, span := StartSpan(t.Context(), "Plan")
+			EndSpan(span, nil)
+			if err = shutdown(t.Context()); err != nil {
+				t.Fatalf("shutdown() error = %v", err)
+			}
+			if got := span.SpanContext().IsSampled(); got != tc.wantSampled {
+				t.Errorf("IsSampled() = %v, want %v", got, tc.wantSampled)
+			}
+		})
+	}
+}
+
+func TestSetupTracingDisabled(t *testing.T) {
+	shutdown, err := SetupTracing(t.Context(), commontypes.TracingConfig{}, "test-service")
+	if err != nil {
+		t.Fatalf("SetupTracing() error = %v", err)
+	}
+	if err = shutdown(t.Context()); err != nil {
+		t.Errorf("shutdown() error = %v", err)
+	}
+}
+
+func TestSetupTracingShutsDownExportersOnError(t *testing.T) {
+	cfg := commontypes.TracingConfig{
+		OTLPEndpoint: "localhost:4317",
+		OTLPInsecure: true,
+		FilePath:     filepath.Join(t.TempDir(), "missing", "traces.json"),
+	}
+	if _, err := SetupTracing(t.Context(), cfg, "test-service"); err == nil {
+		t.Fatal("SetupTracing() error = nil, want error for trace file in missing directory")
+	}
+}
@@ -10,6 +10,8 @@ GOIMPORTS_REVISER       := $(TOOLS_BIN_DIR)/goimports-reviser
 GO_ADD_LICENSE          := $(TOOLS_BIN_DIR)/addlicense
 CRD_REF_DOCS            := $(TOOLS_BIN_DIR)/crd-ref-docs
 GOSEC                   := $(TOOLS_BIN_DIR)/gosec
+PROTOC_GEN_GO           := $(TOOLS_BIN_DIR)/protoc-gen-go
+PROTOC_GEN_GO_GRPC      := $(TOOLS_BIN_DIR)/protoc-gen-go-grpc
 
 # default tool versions
 GOLANGCI_LINT_VERSION     ?= v2.1.1
@@ -18,6 +20,8 @@ GO_ADD_LICENSE_VERSION    ?= v1.1.1
 CONTROLLER_GEN_VERSION    ?= $(call version_gomod,sigs.k8s.io/controller-tools)
 CRD_REF_DOCS_VERSION      ?= v0.2.0
 GOSEC_VERSION             ?= v2.22.8
+PROTOC_GEN_GO_VERSION     ?= v1.36.8
+PROTOC_GEN_GO_GRPC_VERSION ?= v1.5.1
 
 export PATH := $(abspath $(TOOLS_BIN_DIR)):$(PATH)
 
@@ -46,4 +50,10 @@ $(CRD_REF_DOCS):
 	GOBIN=$(abspath $(TOOLS_BIN_DIR)) go install github.com/elastic/crd-ref-docs@$(CRD_REF_DOCS_VERSION)
 
 $(GOSEC):
-	@GOSEC_VERSION=$(GOSEC_VERSION) bash $(TOOLS_DIR)/install-gosec.sh
\ No newline at end of file
+	@GOSEC_VERSION=$(GOSEC_VERSION) bash $(TOOLS_DIR)/install-gosec.sh
+
+$(PROTOC_GEN_GO):
+	GOBIN=$(abspath $(TOOLS_BIN_DIR)) go install google.golang.org/protobuf/cmd/protoc-gen-go@$(PROTOC_GEN_GO_VERSION)
+
+$(PROTOC_GEN_GO_GRPC):
+	GOBIN=$(abspath $(TOOLS_BIN_DIR)) go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@$(PROTOC_GEN_GO_GRPC_VERSION)
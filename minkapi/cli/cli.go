@@ -113,7 +113,7 @@ func setupFlagsToOpts() (*pflag.FlagSet, *Opts) {
 	}
 	// TODO: Change opts.KubeConfigPath to opts.KubeConfigGenDir later
 	flagSet.StringVarP(&opts.KubeConfigPath, clientcmd.RecommendedConfigPathFlag, "k", opts.KubeConfigPath, "path to master kubeconfig - fallback to KUBECONFIG env-var")
-	commoncli.MapServerConfigFlags(flagSet, &opts.ServerConfig)
+	commoncli.MapServerConfigFlags(flagSet, &opts.ServerConfig, commonconstants.DefaultMinKAPIBindAddress)
 	MapWatchConfigFlags(flagSet, &opts.WatchConfig)
 	flagSet.StringVarP(&opts.BasePrefix, "base-prefix", "b", minkapi.DefaultBasePrefix, "base path prefix for the base view of the minkapi core")
 	return flagSet, &opts
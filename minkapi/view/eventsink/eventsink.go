@@ -20,14 +20,16 @@ var _ minkapi.EventSink = (*InMemEventSink)(nil)
 
 // InMemEventSink is plain implementation of minkapi EventSink that holds events in a backing slice.
 type InMemEventSink struct {
-	events []eventsv1.Event
-	mu     sync.Mutex
+	notifyCh chan struct{}
+	events   []eventsv1.Event
+	mu       sync.Mutex
 }
 
 // New constructs a minkapi event-sink that sinks events to a backing in-memory slice of events.
 func New() minkapi.EventSink {
 	return &InMemEventSink{
-		events: make([]eventsv1.Event, 0, 100),
+		events:   make([]eventsv1.Event, 0, 100),
+		notifyCh: make(chan struct{}, 1),
 	}
 }
 
@@ -36,6 +38,7 @@ func (s *InMemEventSink) Create(_ context.Context, event *eventsv1.Event) (*even
 	s.mu.Lock()
 	defer s.mu.Unlock()
 	s.events = append(s.events, *event)
+	s.notify()
 	return event, nil
 }
 
@@ -46,6 +49,7 @@ func (s *InMemEventSink) Update(_ context.Context, event *eventsv1.Event) (*even
 	for i, e := range s.events {
 		if e.Name == event.Name && e.Namespace == event.Namespace {
 			s.events[i] = *event
+			s.notify()
 			return event, nil
 		}
 	}
@@ -73,6 +77,7 @@ func (s *InMemEventSink) Patch(_ context.Context, oldEvent *eventsv1.Event, patc
 				return nil, fmt.Errorf("failed to unmarshal patched event: %w", err)
 			}
 			s.events[i] = patchedEvent
+			s.notify()
 			return &s.events[i], nil
 		}
 	}
@@ -93,3 +98,16 @@ func (s *InMemEventSink) Reset() error {
 	s.events = nil
 	return nil
 }
+
+// Notify returns the channel on which a notification is sent whenever an event is created, updated or patched.
+func (s *InMemEventSink) Notify() <-chan struct{} {
+	return s.notifyCh
+}
+
+// notify sends a notification on the notify channel unless one is already pending.
+func (s *InMemEventSink) notify() {
+	select {
+	case s.notifyCh <- struct{}{}:
+	default:
+	}
+}
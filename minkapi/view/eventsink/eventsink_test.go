@@ -65,3 +65,27 @@ func TestCreateListReset(t *testing.T) {
 		}
 	})
 }
+
+func TestNotify(t *testing.T) {
+	sink := New()
+	select {
+	case <-sink.Notify():
+		t.Fatalf("got notification before any event was recorded")
+	default:
+	}
+	for _, name := range []string{"e1", "e2"} {
+		if _, err := sink.Create(t.Context(), &eventsv1.Event{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}); err != nil {
+			t.Fatalf("cannot create %s event: %v", name, err)
+		}
+	}
+	select {
+	case <-sink.Notify():
+	default:
+		t.Fatalf("got no notification after events were recorded")
+	}
+	select {
+	case <-sink.Notify():
+		t.Errorf("got second notification, want notifications of both events coalesced into one")
+	default:
+	}
+}
@@ -10,6 +10,8 @@ import (
 	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
 	configv1alpha1 "github.com/gardener/scaling-advisor/api/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/metricsutil"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sscheme "k8s.io/client-go/kubernetes/scheme"
@@ -17,6 +19,7 @@ import (
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	ctrlconfig "sigs.k8s.io/controller-runtime/pkg/config"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
 	ctrlmetricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 )
 
@@ -30,7 +33,12 @@ func CreateManagerAndRegisterControllers(log logr.Logger, saCfg *configv1alpha1.
 	if err != nil {
 		return nil, err
 	}
-	if err = registerControllers(mgr, saCfg.Controllers); err != nil {
+	// the planner metrics are exposed by the metrics server of the manager along with the controller-runtime metrics.
+	metricsRecorder, err := metricsutil.NewPlannerMetricsRecorder(ctrlmetrics.Registry)
+	if err != nil {
+		return nil, err
+	}
+	if err = registerControllers(mgr, saCfg.Controllers, metricsRecorder); err != nil {
 		return nil, err
 	}
 	return mgr, nil
@@ -89,7 +97,7 @@ func createScalingAdvisorScheme() (*runtime.Scheme, error) {
 	return scheme, nil
 }
 
-func registerControllers(mgr ctrl.Manager, controllersConfig configv1alpha1.ControllersConfig) error {
-	scalingConstraintsController := scalingconstraints.NewReconciler(mgr, controllersConfig.ScalingConstraints)
+func registerControllers(mgr ctrl.Manager, controllersConfig configv1alpha1.ControllersConfig, metricsRecorder plannerapi.MetricsRecorder) error {
+	scalingConstraintsController := scalingconstraints.NewReconciler(mgr, controllersConfig.ScalingConstraints, metricsRecorder)
 	return scalingConstraintsController.SetupWithManager(mgr)
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/gardener/scaling-advisor/api/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 	"github.com/go-logr/logr"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -17,17 +18,20 @@ import (
 
 // Reconciler is the operator controller type responsible for reconciling ClusterScalingConstraints to produce ScalingAdvice for a cluster.
 type Reconciler struct {
-	client client.Client
-	log    logr.Logger
-	config v1alpha1.ScalingConstraintsControllerConfig
+	client          client.Client
+	log             logr.Logger
+	metricsRecorder plannerapi.MetricsRecorder
+	config          v1alpha1.ScalingConstraintsControllerConfig
 }
 
-// NewReconciler creates a new instance of Reconciler with the provided manager and configuration.
-func NewReconciler(mgr ctrl.Manager, config v1alpha1.ScalingConstraintsControllerConfig) *Reconciler {
+// NewReconciler creates a new instance of Reconciler with the provided manager and configuration. The given
+// metricsRecorder records the metrics of the scaling plans generated for the ScalingAdvice.
+func NewReconciler(mgr ctrl.Manager, config v1alpha1.ScalingConstraintsControllerConfig, metricsRecorder plannerapi.MetricsRecorder) *Reconciler {
 	return &Reconciler{
-		config: config,
-		client: mgr.GetClient(),
-		log:    mgr.GetLogger().WithName(controllerName),
+		config:          config,
+		client:          mgr.GetClient(),
+		log:             mgr.GetLogger().WithName(controllerName),
+		metricsRecorder: metricsRecorder,
 	}
 }
 
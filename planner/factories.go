@@ -1,6 +1,7 @@
 package planner
 
 import (
+	"github.com/gardener/scaling-advisor/planner/scorer"
 	simulationfactory "github.com/gardener/scaling-advisor/planner/simulation/factory"
 	simulatorfactory "github.com/gardener/scaling-advisor/planner/simulator/factory"
 	"github.com/gardener/scaling-advisor/planner/weigher"
@@ -15,10 +16,11 @@ var (
 // NewFactories returns an instance of plannerapi.Factories populated with implementation of factory facades.
 func NewFactories() plannerapi.Factories {
 	return plannerapi.Factories{
-		Planner:         &defaultFactory{},
-		Simulator:       simulatorfactory.New(),
-		Simulation:      simulationfactory.New(),
-		ResourceWeigher: weigher.New(),
+		Planner:            &defaultFactory{},
+		Simulator:          simulatorfactory.New(),
+		Simulation:         simulationfactory.New(),
+		ResourceWeigher:    weigher.New(),
+		NodeScorerRegistry: scorer.NewRegistry(),
 	}
 }
 
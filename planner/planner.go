@@ -6,19 +6,26 @@ package planner
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"path"
 	"path/filepath"
+	"time"
 
-	"github.com/gardener/scaling-advisor/planner/scorer"
+	"github.com/gardener/scaling-advisor/planner/simulator/scaleout"
 
 	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/api/pricing"
 	"github.com/gardener/scaling-advisor/common/ioutil"
 	"github.com/gardener/scaling-advisor/common/logutil"
+	"github.com/gardener/scaling-advisor/common/metricsutil"
 	"github.com/gardener/scaling-advisor/common/objutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
 var (
@@ -35,6 +42,9 @@ func NewPlanner(args plannerapi.ScalingPlannerArgs) (plannerapi.ScalingPlanner,
 	if err := validateArgs(&args); err != nil {
 		return nil, err
 	}
+	if args.MetricsRecorder == nil {
+		args.MetricsRecorder = metricsutil.NopPlannerMetricsRecorder{}
+	}
 	return &defaultPlanner{
 		args: args,
 	}, nil
@@ -45,8 +55,15 @@ func (p *defaultPlanner) Plan(ctx context.Context, req plannerapi.Request) <-cha
 	responseCh := make(chan plannerapi.Response)
 	go func() {
 		defer close(responseCh)
-		err = p.doPlan(ctx, &req, responseCh)
+		planCtx, span := traceutil.StartSpan(ctx, "Plan",
+			traceutil.AttrRequestID.String(req.ID),
+			traceutil.AttrCorrelationID.String(req.CorrelationID),
+			traceutil.AttrSimulatorStrategy.String(string(req.SimulatorStrategy)),
+			traceutil.AttrAdviceGenerationMode.String(string(req.AdviceGenerationMode)))
+		err = p.doPlan(planCtx, &req, responseCh)
+		traceutil.EndSpan(span, err)
 		if err != nil {
+			p.args.MetricsRecorder.RecordError(err)
 			SendErrorResponse(responseCh, req.GetRef(), err)
 		}
 	}()
@@ -54,6 +71,9 @@ func (p *defaultPlanner) Plan(ctx context.Context, req plannerapi.Request) <-cha
 }
 
 func (p *defaultPlanner) doPlan(ctx context.Context, req *plannerapi.Request, responseCh chan plannerapi.Response) error {
+	// the deadline is measured from the start of planning and not from the CreationTime of the request, which is set by
+	// the client and hence subject to clock skew and the time the request was queued.
+	deadline := time.Now().Add(req.AdviceGenerationTimeout)
 	planCtx, logCloser, err := wrapPlanContext(ctx, p.args.TraceDir, req)
 	if err != nil {
 		return err
@@ -62,7 +82,45 @@ func (p *defaultPlanner) doPlan(ctx context.Context, req *plannerapi.Request, re
 	if err = validateRequest(req); err != nil {
 		return err
 	}
-	nodeScorer, err := scorer.GetNodeScorer(req.ScoringStrategy, p.args.PricingAccess, p.args.ResourceWeigher)
+	if err = validateSpotPrices(req, p.args.PricingAccess); err != nil {
+		return err
+	}
+	if req.SchedulerProfile != nil {
+		if err = p.args.SchedulerLauncher.LoadProfile(req.SchedulerProfile); err != nil {
+			return fmt.Errorf("%w: %w", plannerapi.ErrInvalidRequest, err)
+		}
+	}
+	if req.AdviceGenerationTimeout > 0 {
+		// simulators send the plan accumulated so far when the deadline is exceeded, see scaleout.IsAdviceGenerationTimeout.
+		var cancel context.CancelFunc
+		planCtx, cancel = context.WithDeadlineCause(planCtx, deadline, plannerapi.ErrAdviceGenerationTimeout)
+		defer cancel()
+	}
+	if len(req.Snapshot.GetUnscheduledPods()) == 0 {
+		sent, err := p.sendMinNodesResponseIfNeeded(planCtx, responseCh, req, nil)
+		if err != nil || sent {
+			return err
+		}
+		// scale-in is opt-in, without any ScaleInPolicy the scale-out simulator responds as for any other request.
+		if req.Constraint.Spec.IsScaleInEnabled() {
+			return p.planScaleIn(ctx, planCtx, req, responseCh)
+		}
+	}
+	return p.planScaleOut(ctx, planCtx, req, responseCh)
+}
+
+// planScaleOut generates scale-out plans for the unscheduled pods of the request snapshot using a ScaleOutSimulator and
+// sends them as responses on the given responseCh. Node pools and templates that remain below their MinNodes after the
+// simulated scale-out are brought up to it: for AllAtOnce advice generation the required items are merged into the
+// scale-out plan, for Incremental advice generation they are sent as a further plan after all simulated plans.
+func (p *defaultPlanner) planScaleOut(ctx, planCtx context.Context, req *plannerapi.Request, responseCh chan plannerapi.Response) error {
+	nodeScorer, err := p.args.NodeScorerRegistry.GetNodeScorer(req.ScoringStrategy, plannerapi.NodeScorerParams{
+		PricingAccess:                  p.args.PricingAccess,
+		ResourceWeigher:                p.args.ResourceWeigher,
+		CompositeWeights:               req.CompositeScoringWeights,
+		SpotInterruptionPenaltyPercent: p.args.SimulatorConfig.SpotInterruptionPenaltyPercent,
+		Seed:                           req.Seed,
+	})
 	if err != nil {
 		return fmt.Errorf("%w: %w", plannerapi.ErrCreateSimulator, err)
 	}
@@ -73,21 +131,52 @@ func (p *defaultPlanner) doPlan(ctx context.Context, req *plannerapi.Request, re
 		SchedulerLauncher: p.args.SchedulerLauncher,
 		StorageMetaAccess: p.args.StorageMetaAccess,
 		NodeScorer:        nodeScorer,
+		PricingAccess:     p.args.PricingAccess,
 		TraceDir:          p.args.TraceDir,
 	})
 	if err != nil {
 		return err
 	}
 	defer ioutil.CloseQuietly(scaleOutSimulator)
-	planResultCh := scaleOutSimulator.Simulate(planCtx, req, p.args.SimulationFactory)
+	var plannedItems []sacorev1alpha1.ScaleOutItem
+	simCtx, cancelSim := context.WithCancel(planCtx)
+	planResultCh := scaleOutSimulator.Simulate(simCtx, req, p.args.SimulationFactory)
+	defer func() {
+		// the ScaleOutSimulator stops once simCtx is done, its remaining results are discarded until it closes
+		// planResultCh so that it neither blocks on a send nor runs while it is closed.
+		cancelSim()
+		drain(planResultCh)
+	}()
 	for {
 		select {
 		case <-ctx.Done():
 			return ctx.Err()
 		case planResult, ok := <-planResultCh:
 			if !ok {
-				return nil // planResultCh closed by ScaleOutSimulator.Simulate
+				// planResultCh closed by ScaleOutSimulator.Simulate
+				if !req.AdviceGenerationMode.IsIncremental() {
+					return nil
+				}
+				_, err = p.sendMinNodesResponseIfNeeded(planCtx, responseCh, req, plannedItems)
+				return err
+			}
+			if planResult.Error != nil && errors.Is(planResult.Error, plannerapi.ErrNoScaleOutPlan) {
+				// a plan that only brings node pools up to their MinNodes supersedes the absence of a scale-out plan.
+				if sent, err := p.sendMinNodesResponseIfNeeded(planCtx, responseCh, req, plannedItems); err != nil || sent {
+					return err
+				}
 			}
+			if planResult.ScaleOutPlan != nil {
+				if req.AdviceGenerationMode.IsAllAtOnce() {
+					minNodesItems, err := scaleout.CreateMinNodesScaleOutItems(planCtx, req, planResult.ScaleOutPlan.Items, p.args.PricingAccess)
+					if err != nil {
+						return err
+					}
+					scaleout.MergeScaleOutItems(planResult.ScaleOutPlan, minNodesItems)
+				}
+				plannedItems = append(plannedItems, planResult.ScaleOutPlan.Items...)
+			}
+			p.recordPlanResult(req, plannerapi.PlanKindScaleOut, planResult.Error, planResult.Metrics, planResult.ScaleOutPlan)
 			response := plannerapi.Response{
 				RequestRef:   req.RequestRef,
 				Error:        planResult.Error,
@@ -101,6 +190,81 @@ func (p *defaultPlanner) doPlan(ctx context.Context, req *plannerapi.Request, re
 	}
 }
 
+// planScaleIn generates scale-in plans for the underutilized nodes of the request snapshot using a ScaleInSimulator and
+// sends them as responses on the given responseCh.
+func (p *defaultPlanner) planScaleIn(ctx, planCtx context.Context, req *plannerapi.Request, responseCh chan plannerapi.Response) error {
+	scaleInSimulator, err := p.args.SimulatorFactory.GetScaleInSimulator(plannerapi.SimulatorArgs{
+		Config:            p.args.SimulatorConfig,
+		Strategy:          req.SimulatorStrategy,
+		ViewAccess:        p.args.ViewAccess,
+		SchedulerLauncher: p.args.SchedulerLauncher,
+		StorageMetaAccess: p.args.StorageMetaAccess,
+		TraceDir:          p.args.TraceDir,
+	})
+	if err != nil {
+		return err
+	}
+	defer ioutil.CloseQuietly(scaleInSimulator)
+	planResultCh := scaleInSimulator.Simulate(planCtx, req, p.args.SimulationFactory)
+	for {
+		select {
+		case <-ctx.Done():
+			// the ScaleInSimulator stops once planCtx is done, its results are discarded until it closes planResultCh.
+			drain(planResultCh)
+			return ctx.Err()
+		case planResult, ok := <-planResultCh:
+			if !ok {
+				return nil // planResultCh closed by ScaleInSimulator.Simulate
+			}
+			p.recordPlanResult(req, plannerapi.PlanKindScaleIn, planResult.Error, planResult.Metrics, nil)
+			response := plannerapi.Response{
+				RequestRef:   req.RequestRef,
+				Error:        planResult.Error,
+				Labels:       planResult.Labels,
+				ScaleOutPlan: nil,
+				ScaleInPlan:  planResult.ScaleInPlan,
+				ID:           objutil.GenerateName("scaling-plan-"),
+			}
+			responseCh <- response
+		}
+	}
+}
+
+// sendMinNodesResponseIfNeeded sends a response with a ScaleOutPlan on the given responseCh that brings node pools and
+// templates below their MinNodes up to it, considering the nodes of the request snapshot and the given plannedItems.
+// No response is sent if all MinNodes are satisfied. Returns whether a response was sent.
+func (p *defaultPlanner) sendMinNodesResponseIfNeeded(ctx context.Context, responseCh chan<- plannerapi.Response, req *plannerapi.Request, plannedItems []sacorev1alpha1.ScaleOutItem) (sent bool, err error) {
+	minNodesItems, err := scaleout.CreateMinNodesScaleOutItems(ctx, req, plannedItems, p.args.PricingAccess)
+	if err != nil || len(minNodesItems) == 0 {
+		return false, err
+	}
+	scaleOutPlan := &sacorev1alpha1.ScaleOutPlan{}
+	scaleout.MergeScaleOutItems(scaleOutPlan, minNodesItems)
+	p.recordPlanResult(req, plannerapi.PlanKindScaleOut, nil, plannerapi.Metrics{}, scaleOutPlan)
+	responseCh <- plannerapi.Response{
+		RequestRef:   req.RequestRef,
+		Labels:       scaleout.CreatePlanLabels(req, 0),
+		ScaleOutPlan: scaleOutPlan,
+		ID:           objutil.GenerateName("scaling-plan-"),
+	}
+	return true, nil
+}
+
+// recordPlanResult records the given planErr, or else the given metrics of a plan of the given kind generated for the
+// request, with the MetricsRecorder of the planner. The pods left unsatisfied by the given scaleOutPlan are recorded
+// along with the metrics.
+func (p *defaultPlanner) recordPlanResult(req *plannerapi.Request, kind plannerapi.PlanKind, planErr error, metrics plannerapi.Metrics, scaleOutPlan *sacorev1alpha1.ScaleOutPlan) {
+	if planErr != nil {
+		p.args.MetricsRecorder.RecordError(planErr)
+		return
+	}
+	var numUnsatisfiedPods int
+	if scaleOutPlan != nil {
+		numUnsatisfiedPods = len(scaleOutPlan.UnsatisfiedPodNames) + len(scaleOutPlan.QuotaUnsatisfiedPodNames)
+	}
+	p.args.MetricsRecorder.RecordPlan(kind, time.Since(req.CreationTime), metrics, numUnsatisfiedPods)
+}
+
 func validateRequest(req *plannerapi.Request) error {
 	if req.CreationTime.IsZero() {
 		return fmt.Errorf("%w: createdTime not set", plannerapi.ErrInvalidRequest)
@@ -108,6 +272,28 @@ func validateRequest(req *plannerapi.Request) error {
 	if !commontypes.SupportedAdviceGenerationModes.Has(req.AdviceGenerationMode) {
 		return fmt.Errorf("%w: unsupported advice generation mode %q", plannerapi.ErrInvalidRequest, req.AdviceGenerationMode)
 	}
+	if req.Constraint == nil {
+		return fmt.Errorf("%w: constraint not set", plannerapi.ErrInvalidRequest)
+	}
+	if errs := sacorev1alpha1.ValidateScalingConstraintSpec(&req.Constraint.Spec, field.NewPath("constraint", "spec")); len(errs) > 0 {
+		return fmt.Errorf("%w: %w", plannerapi.ErrInvalidRequest, errs.ToAggregate())
+	}
+	return nil
+}
+
+// validateSpotPrices checks that the given pricingAccess has a price for the spot node templates of the request
+// constraint, since the node scorers cannot score the nodes of spot node templates without spot price.
+func validateSpotPrices(req *plannerapi.Request, pricingAccess pricing.InstancePricingAccess) error {
+	for _, pool := range req.Constraint.Spec.NodePools {
+		for _, nt := range pool.NodeTemplates {
+			if !nt.CapacityType.IsSpot() {
+				continue
+			}
+			if _, err := pricingAccess.GetInfo(pool.Region, nt.InstanceType, nt.CapacityType); err != nil {
+				return fmt.Errorf("%w: no spot price for node template %q of node pool %q: %w", plannerapi.ErrInvalidRequest, nt.Name, pool.Name, err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -129,10 +315,20 @@ func wrapPlanContext(ctx context.Context, traceDir string, req *plannerapi.Reque
 	return
 }
 
+// drain receives and discards all remaining values of the given ch until it is closed, so that the simulator sending
+// on it is not blocked once the planner stops processing its results.
+func drain[T any](ch <-chan T) {
+	for range ch {
+	}
+}
+
 func validateArgs(args *plannerapi.ScalingPlannerArgs) error {
 	if args.ResourceWeigher == nil {
 		return fmt.Errorf("%w: resourceWeigher must be set", plannerapi.ErrCreatePlanner)
 	}
+	if args.NodeScorerRegistry == nil {
+		return fmt.Errorf("%w: nodeScorerRegistry must be set", plannerapi.ErrCreatePlanner)
+	}
 	if args.ViewAccess == nil {
 		return fmt.Errorf("%w: viewAccess must be set", plannerapi.ErrCreatePlanner)
 	}
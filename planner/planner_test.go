@@ -5,15 +5,29 @@
 package planner
 
 import (
+	"errors"
 	"math"
+	"slices"
+	"sync"
 	"testing"
+	"time"
 
+	"github.com/gardener/scaling-advisor/planner/scorer"
 	"github.com/gardener/scaling-advisor/planner/testutil"
 
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
 	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	pricingtestutil "github.com/gardener/scaling-advisor/pricing/testutil"
 	"github.com/gardener/scaling-advisor/samples"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestOnePoolUnitScaleOut(t *testing.T) {
@@ -318,3 +332,968 @@ func TestTwoPoolFullFitPodScaleOut(t *testing.T) {
 	}
 	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
 }
+
+// TestOnePoolHalfAndFullFitPodMultiNodeScaleOut tests scale out of one pool with the multi-node-single-sim strategy using
+// both HalfBerry and Berry pods that half-fit and full-fit into pool A's NodeTemplate.
+func TestOnePoolHalfAndFullFitPodMultiNodeScaleOut(t *testing.T) {
+	amount := 2
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: amount,
+			samples.ResourcePresetBerry:     amount,
+		},
+		SimulatorStrategy: commontypes.SimulatorStrategyMultiNodeSingleSim,
+		Factories:         NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	poolAPlacement := testData.NodePlacements[0]
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: poolAPlacement,
+				Delta:         int32(math.Round(float64(amount) * 1.5)),
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestTwoPoolFullFitPodMultiNodeScaleOut tests scale out of 2 pools with the multi-node-single-sim strategy, where any
+// unscheduled pod nearly fully fits into the node template of its pool.
+func TestTwoPoolFullFitPodMultiNodeScaleOut(t *testing.T) {
+	amount := 2
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset2P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: amount,
+			samples.ResourcePresetGrape: amount,
+		},
+		SimulatorStrategy: commontypes.SimulatorStrategyMultiNodeSingleSim,
+		Factories:         NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	poolAPlacement, poolBPlacement := testData.NodePlacements[0], testData.NodePlacements[1]
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: poolAPlacement,
+				Delta:         int32(amount),
+			},
+			{
+				NodePlacement: poolBPlacement,
+				Delta:         int32(amount),
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestOnePoolScaleOutWithinQuota tests scale out of one pool whose quota only permits 2 nodes for 3 Berry pods that
+// fully fit into pool A's NodeTemplate, leaving one pod unsatisfied because of quota.
+func TestOnePoolScaleOutWithinQuota(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 3,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	pool := &testData.Request.Constraint.Spec.NodePools[0]
+	nodeCPU := pool.NodeTemplates[0].Capacity[corev1.ResourceCPU]
+	quotaCPU := nodeCPU.DeepCopy()
+	quotaCPU.Add(nodeCPU)
+	pool.Quota = corev1.ResourceList{corev1.ResourceCPU: quotaCPU}
+
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok {
+		return
+	}
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: testData.NodePlacements[0],
+				Delta:         2,
+			},
+		},
+	}
+	gotPlan := response.ScaleOutPlan
+	if gotPlan == nil {
+		t.Fatalf("got nil ScaleOutPlan, want not nil ScaleOutPlan")
+		return
+	}
+	if len(gotPlan.QuotaUnsatisfiedPodNames) != 1 {
+		t.Errorf("got %d QuotaUnsatisfiedPodNames, want 1", len(gotPlan.QuotaUnsatisfiedPodNames))
+	}
+	if len(gotPlan.UnsatisfiedPodNames) != 0 {
+		t.Errorf("got %d UnsatisfiedPodNames, want 0", len(gotPlan.UnsatisfiedPodNames))
+	}
+	gotPlan.QuotaUnsatisfiedPodNames = nil
+	testutil.AssertExactScaleOutPlan(t, wantPlan, gotPlan)
+}
+
+// TestOnePoolScaleIn tests scale in of one pool with 2 existing nodes, each hosting a HalfBerry pod, where one node can
+// be removed since its pod can be re-placed on the other node.
+func TestOnePoolScaleIn(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	poolAPlacement := testData.NodePlacements[0]
+	nodeNames, ok := testutil.AddExistingNodesAndBindPods(t, &testData, poolAPlacement, 2, time.Hour)
+	if !ok {
+		return
+	}
+	testData.Request.Constraint.Spec.ScaleInPolicy = &sacorev1alpha1.ScaleInPolicy{}
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok {
+		return
+	}
+	if response.ScaleOutPlan != nil {
+		t.Errorf("want no ScaleOutPlan, got %v", response.ScaleOutPlan)
+	}
+	wantPlan := &sacorev1alpha1.ScaleInPlan{
+		Items: []sacorev1alpha1.ScaleInItem{
+			{
+				NodePlacement: poolAPlacement,
+				NodeName:      nodeNames[0],
+			},
+		},
+	}
+	if diff := cmp.Diff(wantPlan, response.ScaleInPlan); diff != "" {
+		t.Errorf("ScaleInPlan mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// TestOnePoolNoScaleInWithoutScaleInPolicy tests that no scale-in plan is generated for underutilized nodes when the
+// request constraint has no ScaleInPolicy.
+func TestOnePoolNoScaleInWithoutScaleInPolicy(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	if _, ok = testutil.AddExistingNodesAndBindPods(t, &testData, testData.NodePlacements[0], 2, time.Hour); !ok {
+		return
+	}
+	// without unscheduled pods the scale-out simulation fails, as it did before scale-in was introduced.
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrRunSimulation) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrRunSimulation, response.Error)
+	}
+	if response.ScaleInPlan != nil {
+		t.Errorf("want no ScaleInPlan, got %v", response.ScaleInPlan)
+	}
+}
+
+// TestOnePoolNoScaleInWithinMinNodeAge tests that no scale-in plan is generated for nodes younger than the MinNodeAge
+// of the default ScaleInPolicy.
+func TestOnePoolNoScaleInWithinMinNodeAge(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	if _, ok = testutil.AddExistingNodesAndBindPods(t, &testData, testData.NodePlacements[0], 2, time.Minute); !ok {
+		return
+	}
+	testData.Request.Constraint.Spec.ScaleInPolicy = &sacorev1alpha1.ScaleInPolicy{}
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrNoScaleInPlan) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrNoScaleInPlan, response.Error)
+	}
+}
+
+// TestOnePoolScaleOutWithinMaxNodes tests scale out of one pool whose maxNodes only permits 2 nodes for 3 Berry pods
+// that fully fit into pool A's NodeTemplate, leaving one pod unsatisfied because of the max node count.
+func TestOnePoolScaleOutWithinMaxNodes(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 3,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	maxNodes := int32(2)
+	testData.Request.Constraint.Spec.NodePools[0].MaxNodes = &maxNodes
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok {
+		return
+	}
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: testData.NodePlacements[0],
+				Delta:         2,
+			},
+		},
+	}
+	gotPlan := response.ScaleOutPlan
+	if gotPlan == nil {
+		t.Fatalf("got nil ScaleOutPlan, want not nil ScaleOutPlan")
+		return
+	}
+	// the pod is left unsatisfied because of the max node count and not because of quota.
+	if len(gotPlan.UnsatisfiedPodNames) != 1 {
+		t.Errorf("got %d UnsatisfiedPodNames, want 1", len(gotPlan.UnsatisfiedPodNames))
+	}
+	if len(gotPlan.QuotaUnsatisfiedPodNames) != 0 {
+		t.Errorf("got %d QuotaUnsatisfiedPodNames, want 0", len(gotPlan.QuotaUnsatisfiedPodNames))
+	}
+	gotPlan.UnsatisfiedPodNames = nil
+	gotPlan.UnsatisfiedPodExplanations = nil
+	gotPlan.UnsatisfiedPodReasons = nil
+	testutil.AssertExactScaleOutPlan(t, wantPlan, gotPlan)
+}
+
+// TestOnePoolScaleOutZoneBalanced tests that scale-out of pool A with zoneBalance preferred spreads nodes evenly across
+// its availability zones when the candidates of both zones score equally.
+func TestOnePoolScaleOutZoneBalanced(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		PoolZones:  [][]string{{"eu-west-1a", "eu-west-1b"}},
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 4,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	testData.Request.Constraint.Spec.NodePools[0].ZoneBalance = sacorev1alpha1.ZoneBalancePolicyPreferred
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok {
+		return
+	}
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: testData.NodePlacements[0],
+				Delta:         2,
+			},
+			{
+				NodePlacement: testData.NodePlacements[1],
+				Delta:         2,
+			},
+		},
+	}
+	testutil.AssertExactScaleOutPlan(t, wantPlan, response.ScaleOutPlan)
+}
+
+// TestOnePoolScaleOutZoneBalancedWithLookahead tests that the lookahead search ranks its candidates with the node
+// scorer and hence spreads the nodes of pool A with zoneBalance preferred evenly across its availability zones like
+// the greedy scale-out does.
+func TestOnePoolScaleOutZoneBalancedWithLookahead(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		PoolZones:  [][]string{{"eu-west-1a", "eu-west-1b"}},
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 4,
+		},
+		LookaheadBeamWidth: 2,
+		Factories:          NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	testData.Request.Constraint.Spec.NodePools[0].ZoneBalance = sacorev1alpha1.ZoneBalancePolicyPreferred
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: testData.NodePlacements[0],
+				Delta:         2,
+			},
+			{
+				NodePlacement: testData.NodePlacements[1],
+				Delta:         2,
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestOnePoolDeterministicScaleOut tests that scale-out of pool A across two availability zones whose candidates
+// score equally yields the same plan items for repeated requests with the same seed. The PodNames of the items are not
+// compared, since the order in which the kube-scheduler schedules pods of equal priority is not reproducible.
+func TestOnePoolDeterministicScaleOut(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		PoolZones:  [][]string{{"eu-west-1a", "eu-west-1b"}},
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 3,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	seed := int64(42)
+	testData.Request.Seed = &seed
+	var plans []*sacorev1alpha1.ScaleOutPlan
+	for _, suffix := range []string{"-A", "-B"} {
+		testData.Request.ID = t.Name() + suffix
+		response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+		if !ok {
+			return
+		}
+		if response.ScaleOutPlan == nil {
+			t.Fatalf("got nil ScaleOutPlan, want not nil ScaleOutPlan")
+		}
+		plans = append(plans, response.ScaleOutPlan)
+	}
+	if diff := cmp.Diff(plans[0], plans[1], cmpopts.IgnoreFields(sacorev1alpha1.ScaleOutItem{}, "PodNames")); diff != "" {
+		t.Errorf("ScaleOutPlan differs for the same seed (-first +second):\n%s", diff)
+	}
+}
+
+// TestOnePoolScaleOutWithSchedulerProfile tests that a scale-out plan is generated with the kube-scheduler configuration
+// selected by the scheduler profile of the request, and that a request with an unknown scheduler profile is rejected.
+func TestOnePoolScaleOutWithSchedulerProfile(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	testData.Request.SchedulerProfile = &plannerapi.SchedulerProfile{
+		Name:        "default-scheduler",
+		ConfigPatch: []byte(`{"profiles": [{"schedulerName": "bin-packing-scheduler", "percentageOfNodesToScore": 100}]}`),
+	}
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok {
+		return
+	}
+	if response.ScaleOutPlan == nil || len(response.ScaleOutPlan.Items) == 0 {
+		t.Fatalf("got ScaleOutPlan %v, want ScaleOutPlan with items", response.ScaleOutPlan)
+	}
+
+	testData.Request.SchedulerProfile = &plannerapi.SchedulerProfile{Name: "unknown-scheduler"}
+	response = <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrInvalidRequest) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrInvalidRequest, response.Error)
+	}
+}
+
+// TestOnePoolScaleOutWithInvalidConstraint tests that a request whose node pool has minNodes greater than maxNodes is
+// rejected.
+func TestOnePoolScaleOutWithInvalidConstraint(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	minNodes, maxNodes := int32(2), int32(1)
+	testData.Request.Constraint.Spec.NodePools[0].MinNodes = &minNodes
+	testData.Request.Constraint.Spec.NodePools[0].MaxNodes = &maxNodes
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrInvalidRequest) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrInvalidRequest, response.Error)
+	}
+}
+
+// TestOnePoolScaleOutToMinNodes tests that a scale-out plan bringing pool A up to its minNodes is generated even when
+// there are no unscheduled pods.
+func TestOnePoolScaleOutToMinNodes(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	poolAPlacement := testData.NodePlacements[0]
+	if _, ok = testutil.AddExistingNodesAndBindPods(t, &testData, poolAPlacement, 2, time.Hour); !ok {
+		return
+	}
+	minNodes := int32(3)
+	testData.Request.Constraint.Spec.NodePools[0].MinNodes = &minNodes
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement:   poolAPlacement,
+				CurrentReplicas: 2,
+				Delta:           1,
+			},
+		},
+	}
+	pricingAccess, err := pricingtestutil.GetInstancePricingAccessForTop20AWSInstanceTypes()
+	if err != nil {
+		t.Fatalf("failed to get instance pricing access: %v", err)
+		return
+	}
+	info, err := pricingAccess.GetInfo(poolAPlacement.Region, poolAPlacement.InstanceType, poolAPlacement.CapacityType)
+	if err != nil {
+		t.Fatalf("failed to get price of instance type %q: %v", poolAPlacement.InstanceType, err)
+		return
+	}
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok || !testutil.AssertExactScaleOutPlan(t, wantPlan, response.ScaleOutPlan) {
+		return
+	}
+	gotPlan := response.ScaleOutPlan
+	if math.Abs(ptr.Deref(gotPlan.Items[0].HourlyCostDelta, -1)-info.HourlyPrice) > 1e-9 || math.Abs(ptr.Deref(gotPlan.TotalHourlyCostDelta, -1)-info.HourlyPrice) > 1e-9 {
+		t.Errorf("got HourlyCostDelta %v and TotalHourlyCostDelta %v, want %v", gotPlan.Items[0].HourlyCostDelta, gotPlan.TotalHourlyCostDelta, info.HourlyPrice)
+	}
+}
+
+// TestOnePoolNoScaleInBelowMinNodes tests that no scale-in plan is generated when removing an underutilized node would
+// bring pool A below its minNodes.
+func TestOnePoolNoScaleInBelowMinNodes(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	if _, ok = testutil.AddExistingNodesAndBindPods(t, &testData, testData.NodePlacements[0], 2, time.Hour); !ok {
+		return
+	}
+	minNodes := int32(2)
+	testData.Request.Constraint.Spec.NodePools[0].MinNodes = &minNodes
+	testData.Request.Constraint.Spec.NodePools[0].ScaleInPolicy = &sacorev1alpha1.ScaleInPolicy{}
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrNoScaleInPlan) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrNoScaleInPlan, response.Error)
+	}
+}
+
+// TestTwoPoolScaleOutWithBackedOffPlacement tests that scale-out falls back to pool B when the placement of the higher
+// priority pool A is backed off because of a reported ResourceExhaustedError.
+func TestTwoPoolScaleOutWithBackedOffPlacement(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset2P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	poolAPlacement, poolBPlacement := testData.NodePlacements[0], testData.NodePlacements[1]
+	testData.Request.Feedback = &sacorev1alpha1.ScalingFeedbackSpec{
+		ScaleOutErrorInfos: []sacorev1alpha1.ScaleOutErrorInfo{
+			{
+				AvailabilityZone: poolAPlacement.AvailabilityZone,
+				InstanceType:     poolAPlacement.InstanceType,
+				ErrorType:        sacorev1alpha1.ScalingErrorTypeResourceExhausted,
+				FailCount:        1,
+				LastFailureTime:  metav1.NewTime(testData.Request.CreationTime.Add(-30 * time.Second)),
+			},
+		},
+	}
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: poolBPlacement,
+				Delta:         1,
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestTwoPoolScaleOutWithLookahead tests that the lookahead search avoids the greedy over-spend described in
+// docs/scorer-design.md: with both pools at the same priority, the least-waste scorer greedily picks the cheaper
+// m5.large of pool A three times, whereas a single m5.xlarge of pool B fits all pods at a lower total cost.
+func TestTwoPoolScaleOutWithLookahead(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset2P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 5,
+		},
+		NodeScoringStrategy: commontypes.NodeScoringStrategyLeastWaste,
+		LookaheadBeamWidth:  2,
+		Factories:           NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	pools := testData.Request.Constraint.Spec.NodePools
+	pools[1].Priority = pools[0].Priority
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: testData.NodePlacements[1],
+				Delta:         1,
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestOnePoolScaleOutWithLookaheadAndNoWinner tests that the lookahead search scales no node if the node scorer
+// selects no winning node score instead of failing.
+func TestOnePoolScaleOutWithLookaheadAndNoWinner(t *testing.T) {
+	const noWinner commontypes.NodeScoringStrategy = "no-winner"
+	factories := NewFactories()
+	if err := factories.NodeScorerRegistry.Register(noWinner, func(params plannerapi.NodeScorerParams) (plannerapi.NodeScorer, error) {
+		nodeScorer, err := scorer.NewLeastCost(params)
+		return noWinnerNodeScorer{nodeScorer}, err
+	}); err != nil {
+		t.Fatalf("cannot register node scorer: %v", err)
+	}
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset2P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		NodeScoringStrategy: noWinner,
+		LookaheadBeamWidth:  2,
+		Factories:           factories,
+	})
+	if !ok {
+		return
+	}
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrNoScaleOutPlan) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrNoScaleOutPlan, response.Error)
+	}
+}
+
+// TestOnePoolScaleOutAdviceGenerationTimeoutElapsed tests that an AdviceGenerationTimeout elapsing before any
+// simulation pass completes produces an ErrAdviceGenerationTimeout error response instead of a plan.
+func TestOnePoolScaleOutAdviceGenerationTimeoutElapsed(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	testData.Request.AdviceGenerationTimeout = time.Nanosecond
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrAdviceGenerationTimeout) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrAdviceGenerationTimeout, response.Error)
+	}
+	if response.ScaleOutPlan != nil {
+		t.Errorf("want no ScaleOutPlan, got %v", response.ScaleOutPlan)
+	}
+}
+
+// TestOnePoolScaleInAdviceGenerationTimeoutElapsed tests that an AdviceGenerationTimeout elapsing before any scale-in
+// simulation completes produces an ErrAdviceGenerationTimeout error response instead of no response at all.
+func TestOnePoolScaleInAdviceGenerationTimeoutElapsed(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	if _, ok = testutil.AddExistingNodesAndBindPods(t, &testData, testData.NodePlacements[0], 2, time.Hour); !ok {
+		return
+	}
+	testData.Request.Constraint.Spec.ScaleInPolicy = &sacorev1alpha1.ScaleInPolicy{}
+	testData.Request.AdviceGenerationTimeout = time.Nanosecond
+	response, ok := <-planner.Plan(testData.RunContext, testData.Request)
+	if !ok {
+		t.Fatalf("want error response, got none")
+	}
+	if !errors.Is(response.Error, plannerapi.ErrAdviceGenerationTimeout) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrAdviceGenerationTimeout, response.Error)
+	}
+	if response.ScaleInPlan != nil {
+		t.Errorf("want no ScaleInPlan, got %v", response.ScaleInPlan)
+	}
+}
+
+// TestOnePoolScaleOutAdviceGenerationTimeoutFromPlanStart tests that the AdviceGenerationTimeout is measured from the
+// start of planning, so that a request whose CreationTime lies further in the past than its AdviceGenerationTimeout,
+// for instance because of clock skew of the client, still gets a complete plan.
+func TestOnePoolScaleOutAdviceGenerationTimeoutFromPlanStart(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	testData.Request.CreationTime = time.Now().Add(-time.Hour)
+	testData.Request.AdviceGenerationTimeout = time.Minute
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if response.Error != nil {
+		t.Fatalf("want no error, got %v", response.Error)
+	}
+	if response.ScaleOutPlan == nil {
+		t.Fatalf("want ScaleOutPlan, got none")
+	}
+	if _, partial := response.Labels[commonconstants.LabelPartialPlan]; partial {
+		t.Errorf("want complete ScaleOutPlan, got partial plan")
+	}
+}
+
+// TestOnePoolScaleOutExplainsUnsatisfiedPods tests that a Grape pod which does not fit into pool A's NodeTemplate is
+// reported as unsatisfied along with the resources that the scaled node lacks.
+func TestOnePoolScaleOutExplainsUnsatisfiedPods(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+			samples.ResourcePresetGrape: 1,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok {
+		return
+	}
+	gotPlan := response.ScaleOutPlan
+	if gotPlan == nil {
+		t.Fatalf("got nil ScaleOutPlan, want not nil ScaleOutPlan")
+		return
+	}
+	if len(gotPlan.UnsatisfiedPodNames) != 1 {
+		t.Fatalf("got %d UnsatisfiedPodNames, want 1", len(gotPlan.UnsatisfiedPodNames))
+		return
+	}
+	if len(gotPlan.UnsatisfiedPodExplanations) != 1 || gotPlan.UnsatisfiedPodExplanations[0].PodName != gotPlan.UnsatisfiedPodNames[0] {
+		t.Fatalf("got UnsatisfiedPodExplanations %v, want one explanation for pod %q", gotPlan.UnsatisfiedPodExplanations, gotPlan.UnsatisfiedPodNames[0])
+		return
+	}
+	if reasons := gotPlan.UnsatisfiedPodExplanations[0].Reasons; !slices.Contains(reasons, "Insufficient cpu") {
+		t.Errorf("got reasons %q, want %q among them", reasons, "Insufficient cpu")
+	}
+	if len(gotPlan.UnsatisfiedPodReasons) == 0 {
+		t.Errorf("got no UnsatisfiedPodReasons, want reasons aggregated from UnsatisfiedPodExplanations")
+	}
+}
+
+// TestOnePoolScaleOutCostAndPodAttribution tests that the scale-out item for 2 Berry pods that fully fit into pool A's
+// NodeTemplate carries the hourly cost of the 2 scaled nodes and the names of both pods.
+func TestOnePoolScaleOutCostAndPodAttribution(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	pricingAccess, err := pricingtestutil.GetInstancePricingAccessForTop20AWSInstanceTypes()
+	if err != nil {
+		t.Fatalf("failed to get instance pricing access: %v", err)
+		return
+	}
+	placement := testData.NodePlacements[0]
+	info, err := pricingAccess.GetInfo(placement.Region, placement.InstanceType, placement.CapacityType)
+	if err != nil {
+		t.Fatalf("failed to get price of instance type %q: %v", placement.InstanceType, err)
+		return
+	}
+	response, ok := testutil.ObtainPlannerResponse(t, planner, &testData)
+	if !ok {
+		return
+	}
+	gotPlan := response.ScaleOutPlan
+	if gotPlan == nil || len(gotPlan.Items) != 1 {
+		t.Fatalf("got ScaleOutPlan %v, want one item", gotPlan)
+		return
+	}
+	item := gotPlan.Items[0]
+	if want := 2 * info.HourlyPrice; math.Abs(ptr.Deref(item.HourlyCostDelta, -1)-want) > 1e-9 || math.Abs(ptr.Deref(gotPlan.TotalHourlyCostDelta, -1)-want) > 1e-9 {
+		t.Errorf("got HourlyCostDelta %v and TotalHourlyCostDelta %v, want %v", item.HourlyCostDelta, gotPlan.TotalHourlyCostDelta, want)
+	}
+	var wantPodNames []string
+	for _, p := range testData.Request.Snapshot.GetUnscheduledPods() {
+		wantPodNames = append(wantPodNames, commontypes.NamespacedName{Namespace: p.Namespace, Name: p.Name}.String())
+	}
+	slices.Sort(wantPodNames)
+	if diff := cmp.Diff(wantPodNames, item.PodNames); diff != "" {
+		t.Errorf("PodNames mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// TestOnePoolMixedCapacityTypeScaleOut tests scale out of one pool with an on-demand and a spot NodeTemplate of the
+// same instance type using 1 Berry pod, where the cheaper spot NodeTemplate must be chosen by the least-cost strategy.
+func TestOnePoolMixedCapacityTypeScaleOut(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	pool := &testData.Request.Constraint.Spec.NodePools[0]
+	spotTemplate := *pool.NodeTemplates[0].DeepCopy()
+	spotTemplate.Name += "-spot"
+	spotTemplate.CapacityType = commontypes.CapacityTypeSpot
+	pool.NodeTemplates = append(pool.NodeTemplates, spotTemplate)
+	spotPlacement := testData.NodePlacements[0]
+	spotPlacement.TemplateName = spotTemplate.Name
+	spotPlacement.CapacityType = commontypes.CapacityTypeSpot
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: spotPlacement,
+				Delta:         1,
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestOnePoolMixedCapacityTypeScaleOutWithLookaheadAndSpotPenalty tests the scale-out of
+// TestOnePoolMixedCapacityTypeScaleOut with lookahead and a spot interruption penalty which makes the spot NodeTemplate
+// more expensive than the on-demand one, where the lookahead search must choose the on-demand NodeTemplate by the
+// penalized total cost.
+func TestOnePoolMixedCapacityTypeScaleOutWithLookaheadAndSpotPenalty(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		LookaheadBeamWidth:             2,
+		SpotInterruptionPenaltyPercent: 1000,
+		Factories:                      NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	pool := &testData.Request.Constraint.Spec.NodePools[0]
+	spotTemplate := *pool.NodeTemplates[0].DeepCopy()
+	spotTemplate.Name += "-spot"
+	spotTemplate.CapacityType = commontypes.CapacityTypeSpot
+	pool.NodeTemplates = append(pool.NodeTemplates, spotTemplate)
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: testData.NodePlacements[0],
+				Delta:         1,
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestOnePoolScaleOutWithUnpricedSpotTemplate tests that a request with a spot NodeTemplate of an instance type without
+// spot price is rejected as invalid instead of failing the node scoring.
+func TestOnePoolScaleOutWithUnpricedSpotTemplate(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	pool := &testData.Request.Constraint.Spec.NodePools[0]
+	spotTemplate := *pool.NodeTemplates[0].DeepCopy()
+	spotTemplate.Name += "-spot"
+	spotTemplate.InstanceType = "m5.xlarge"
+	spotTemplate.CapacityType = commontypes.CapacityTypeSpot
+	pool.NodeTemplates = append(pool.NodeTemplates, spotTemplate)
+	response := <-planner.Plan(testData.RunContext, testData.Request)
+	if !errors.Is(response.Error, plannerapi.ErrInvalidRequest) {
+		t.Errorf("want error %v, got %v", plannerapi.ErrInvalidRequest, response.Error)
+	}
+}
+
+// TestTwoPoolFullFitPodScaleOutWithCompositeScoring tests the scale-out of TestTwoPoolFullFitPodScaleOut with the
+// composite node scoring strategy and weights given in the request.
+func TestTwoPoolFullFitPodScaleOutWithCompositeScoring(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset2P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+			samples.ResourcePresetGrape: 1,
+		},
+		NodeScoringStrategy: commontypes.NodeScoringStrategyComposite,
+		Factories:           NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	testData.Request.CompositeScoringWeights = &commontypes.CompositeScoringWeights{Cost: 1, Waste: 1}
+	poolAPlacement, poolBPlacement := testData.NodePlacements[0], testData.NodePlacements[1]
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: poolAPlacement,
+				Delta:         1,
+			},
+			{
+				NodePlacement: poolBPlacement,
+				Delta:         1,
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestOnePoolHalfFitPodScaleOutWithDaemonSetOverhead tests scale out of one pool using 2 HalfBerry pods that would
+// half-fit into pool A's NodeTemplate, where the daemon pod of a DaemonSet running on every node leaves room for only
+// one HalfBerry pod per node. A second DaemonSet whose node selector does not match pool A must not be placed.
+func TestOnePoolHalfFitPodScaleOutWithDaemonSetOverhead(t *testing.T) {
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetHalfBerry: 2,
+		},
+		Factories: NewFactories(),
+	})
+	if !ok {
+		return
+	}
+	testData.Request.Snapshot.DaemonSetPods = []plannerapi.PodInfo{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "node-exporter"},
+			AggregatedRequests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("1"),
+				corev1.ResourceMemory: resource.MustParse("1Gi"),
+			},
+		},
+		{
+			ObjectMeta:   metav1.ObjectMeta{Namespace: metav1.NamespaceSystem, Name: "gpu-driver"},
+			NodeSelector: map[string]string{"gpu": "true"},
+			AggregatedRequests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			},
+		},
+	}
+	wantPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{
+				NodePlacement: testData.NodePlacements[0],
+				Delta:         2,
+			},
+		},
+	}
+	testutil.ObtainAndAssertScaleOutPlan(t, planner, &testData, wantPlan)
+}
+
+// TestOnePoolUnitScaleOutRecordsMetrics tests that the metrics of a scale-out plan and of the embedded kube-scheduler
+// launches made to generate it are recorded with the MetricsRecorder of the planner.
+func TestOnePoolUnitScaleOutRecordsMetrics(t *testing.T) {
+	recorder := &fakeMetricsRecorder{}
+	planner, testData, ok := testutil.CreateTestPlannerAndTestData(t, testutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		Factories:       NewFactories(),
+		MetricsRecorder: recorder,
+	})
+	if !ok {
+		return
+	}
+	if _, ok = testutil.ObtainPlannerResponse(t, planner, &testData); !ok {
+		return
+	}
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.plans) != 1 {
+		t.Fatalf("number of recorded plans = %d, want 1", len(recorder.plans))
+	}
+	if got := recorder.plans[0]; got.SimulationRuns == 0 || len(got.GroupPasses) == 0 {
+		t.Errorf("recorded plan metrics = %+v, want simulation runs and group passes", got)
+	}
+	if recorder.numLaunches == 0 || recorder.numSemaphoreWaits < recorder.numLaunches {
+		t.Errorf("recorded %d scheduler launches and %d semaphore waits, want at least one launch and a wait for each", recorder.numLaunches, recorder.numSemaphoreWaits)
+	}
+	if len(recorder.errs) > 0 {
+		t.Errorf("recorded errors = %v, want none", recorder.errs)
+	}
+}
+
+type fakeMetricsRecorder struct {
+	plans             []plannerapi.Metrics
+	errs              []error
+	numLaunches       int
+	numSemaphoreWaits int
+	mu                sync.Mutex
+}
+
+func (f *fakeMetricsRecorder) RecordPlan(_ plannerapi.PlanKind, _ time.Duration, metrics plannerapi.Metrics, _ int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.plans = append(f.plans, metrics)
+}
+
+func (f *fakeMetricsRecorder) RecordError(err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.errs = append(f.errs, err)
+}
+
+func (f *fakeMetricsRecorder) RecordSchedulerLaunch(time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.numLaunches++
+}
+
+func (f *fakeMetricsRecorder) RecordSemaphoreWait(time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.numSemaphoreWaits++
+}
+
+// noWinnerNodeScorer is a NodeScorer that computes node scores with the given NodeScorer but never selects a winner.
+type noWinnerNodeScorer struct {
+	plannerapi.NodeScorer
+}
+
+func (noWinnerNodeScorer) Select([]plannerapi.NodeScore) (*plannerapi.NodeScore, error) {
+	return nil, nil
+}
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/gardener/scaling-advisor/api/planner"
+	jsonpatch "gopkg.in/evanphx/json-patch.v4"
+	configv1 "k8s.io/kube-scheduler/config/v1"
+	schedulerapiconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+// maxCachedProfiles is the maximum number of distinct scheduler profiles whose kube-scheduler configurations are cached
+// by a profileCache. The least recently used profile is evicted once it is exceeded.
+const maxCachedProfiles = 16
+
+// configPatchProfilesField is the only field of the KubeSchedulerConfiguration that the config patch of a scheduler
+// profile may patch.
+const configPatchProfilesField = "profiles"
+
+// profileEntry is the entry of a profileCache for a distinct scheduler profile.
+type profileEntry struct {
+	// config is the kube-scheduler configuration of the profile.
+	config *schedulerapiconfig.KubeSchedulerConfiguration
+	// lastUsed is the value of the use counter of the profileCache when the entry was last used.
+	lastUsed uint64
+}
+
+// profileCache caches the kube-scheduler configuration per distinct scheduler profile. The configuration of a profile
+// is derived from the versioned base configuration of the launcher.
+type profileCache struct {
+	baseConfig    *configv1.KubeSchedulerConfiguration
+	defaultConfig *schedulerapiconfig.KubeSchedulerConfiguration
+	entries       map[string]*profileEntry
+	uses          uint64
+	mu            sync.Mutex
+}
+
+func newProfileCache(configBytes []byte) (*profileCache, error) {
+	config, err := parseSchedulerConfig(configBytes)
+	if err != nil {
+		return nil, err
+	}
+	var baseConfig configv1.KubeSchedulerConfiguration
+	if err = yaml.Unmarshal(configBytes, &baseConfig); err != nil {
+		return nil, fmt.Errorf("%w: %w", planner.ErrParseSchedulerConfig, err)
+	}
+	return &profileCache{
+		baseConfig:    &baseConfig,
+		defaultConfig: config,
+		entries:       make(map[string]*profileEntry),
+	}, nil
+}
+
+// getConfig returns the kube-scheduler configuration for the given profile, deriving and caching it if the profile is
+// not cached yet. The base configuration is returned for a nil profile. The returned configuration must not be modified.
+func (c *profileCache) getConfig(profile *planner.SchedulerProfile) (*schedulerapiconfig.KubeSchedulerConfiguration, error) {
+	if profile == nil || (profile.Name == "" && len(profile.ConfigPatch) == 0) {
+		return c.defaultConfig, nil
+	}
+	key, err := getProfileKey(profile)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.uses++
+	if entry, ok := c.entries[key]; ok {
+		entry.lastUsed = c.uses
+		return entry.config, nil
+	}
+	config, err := deriveSchedulerConfig(c.baseConfig, profile)
+	if err != nil {
+		return nil, err
+	}
+	if len(c.entries) >= maxCachedProfiles {
+		c.evictLeastRecentlyUsed()
+	}
+	c.entries[key] = &profileEntry{config: config, lastUsed: c.uses}
+	return config, nil
+}
+
+// evictLeastRecentlyUsed evicts the least recently used entry.
+func (c *profileCache) evictLeastRecentlyUsed() {
+	var lruKey string
+	var lruEntry *profileEntry
+	for key, entry := range c.entries {
+		if lruEntry == nil || entry.lastUsed < lruEntry.lastUsed {
+			lruKey, lruEntry = key, entry
+		}
+	}
+	delete(c.entries, lruKey)
+}
+
+// size returns the number of cached profiles.
+func (c *profileCache) size() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// getProfileKey returns the key of the given profile in a profileCache, which is made up of its name and its compacted
+// config patch.
+func getProfileKey(profile *planner.SchedulerProfile) (string, error) {
+	var patch bytes.Buffer
+	if len(profile.ConfigPatch) > 0 {
+		if err := json.Compact(&patch, profile.ConfigPatch); err != nil {
+			return "", fmt.Errorf("%w: invalid config patch of scheduler profile %q: %w", planner.ErrParseSchedulerConfig, profile.Name, err)
+		}
+	}
+	return profile.Name + "\x00" + patch.String(), nil
+}
+
+// deriveSchedulerConfig derives the kube-scheduler configuration of the given profile from the given baseConfig. The
+// profile of the baseConfig whose scheduler name is the name of the given profile replaces all profiles, keeping their
+// scheduler names, before the config patch of the given profile is applied.
+func deriveSchedulerConfig(baseConfig *configv1.KubeSchedulerConfiguration, profile *planner.SchedulerProfile) (*schedulerapiconfig.KubeSchedulerConfiguration, error) {
+	if err := validateConfigPatch(profile); err != nil {
+		return nil, err
+	}
+	config := baseConfig.DeepCopy()
+	if profile.Name != "" {
+		index := slices.IndexFunc(config.Profiles, func(p configv1.KubeSchedulerProfile) bool {
+			return ptr.Deref(p.SchedulerName, "") == profile.Name
+		})
+		if index < 0 {
+			return nil, fmt.Errorf("%w: unknown scheduler profile %q", planner.ErrLoadSchedulerConfig, profile.Name)
+		}
+		selected := config.Profiles[index]
+		for i := range config.Profiles {
+			schedulerName := config.Profiles[i].SchedulerName
+			config.Profiles[i] = *selected.DeepCopy()
+			config.Profiles[i].SchedulerName = schedulerName
+		}
+	}
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", planner.ErrParseSchedulerConfig, err)
+	}
+	if len(profile.ConfigPatch) > 0 {
+		if configBytes, err = jsonpatch.MergePatch(configBytes, profile.ConfigPatch); err != nil {
+			return nil, fmt.Errorf("%w: cannot apply config patch of scheduler profile %q: %w", planner.ErrParseSchedulerConfig, profile.Name, err)
+		}
+	}
+	return parseSchedulerConfig(configBytes)
+}
+
+// validateConfigPatch checks that the config patch of the given profile only patches the profiles of the
+// KubeSchedulerConfiguration. The config patch is supplied with the request, hence the other fields, like the extenders
+// that the kube-scheduler sends HTTP requests to or the percentage of nodes to score, are kept as configured.
+func validateConfigPatch(profile *planner.SchedulerProfile) error {
+	if len(profile.ConfigPatch) == 0 {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(profile.ConfigPatch, &fields); err != nil {
+		return fmt.Errorf("%w: invalid config patch of scheduler profile %q: %w", planner.ErrParseSchedulerConfig, profile.Name, err)
+	}
+	for name := range fields {
+		if name != configPatchProfilesField {
+			return fmt.Errorf("%w: config patch of scheduler profile %q must only patch %q, not %q", planner.ErrLoadSchedulerConfig, profile.Name, configPatchProfilesField, name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/samples"
+	schedulerapiconfig "k8s.io/kubernetes/pkg/scheduler/apis/config"
+)
+
+func TestProfileCache(t *testing.T) {
+	configBytes, err := samples.LoadBinPackingSchedulerConfig()
+	if err != nil {
+		t.Fatalf("failed to load scheduler config: %v", err)
+	}
+	cache, err := newProfileCache(configBytes)
+	if err != nil {
+		t.Fatalf("newProfileCache() error = %v", err)
+	}
+
+	config, err := cache.getConfig(nil)
+	if err != nil || config != cache.defaultConfig {
+		t.Errorf("getConfig(nil) = %p, %v, want default configuration", config, err)
+	}
+
+	t.Run("selected profile", func(t *testing.T) {
+		config, err := cache.getConfig(&planner.SchedulerProfile{Name: "default-scheduler"})
+		if err != nil {
+			t.Fatalf("getConfig() error = %v", err)
+		}
+		for _, p := range config.Profiles {
+			if got := getScoringStrategyType(p); got != schedulerapiconfig.LeastAllocated {
+				t.Errorf("scoring strategy of profile %q = %q, want %q of selected profile", p.SchedulerName, got, schedulerapiconfig.LeastAllocated)
+			}
+		}
+		if names := []string{config.Profiles[0].SchedulerName, config.Profiles[1].SchedulerName}; names[0] != "default-scheduler" || names[1] != "bin-packing-scheduler" {
+			t.Errorf("scheduler names = %v, want scheduler names of configuration kept", names)
+		}
+		again, err := cache.getConfig(&planner.SchedulerProfile{Name: "default-scheduler"})
+		if err != nil || again != config {
+			t.Errorf("getConfig() for same profile returned different configuration, want cached configuration")
+		}
+	})
+
+	t.Run("config patch", func(t *testing.T) {
+		profile := &planner.SchedulerProfile{
+			ConfigPatch: []byte(`{"profiles": [{"schedulerName": "bin-packing-scheduler", "percentageOfNodesToScore": 50, "pluginConfig": [{"name": "NodeResourcesFit", "args": {"scoringStrategy": {"type": "LeastAllocated"}}}]}]}`),
+		}
+		config, err := cache.getConfig(profile)
+		if err != nil {
+			t.Fatalf("getConfig() error = %v", err)
+		}
+		if len(config.Profiles) != 1 || getScoringStrategyType(config.Profiles[0]) != schedulerapiconfig.LeastAllocated {
+			t.Errorf("profiles = %+v, want single profile with LeastAllocated scoring strategy", config.Profiles)
+		}
+		if p := config.Profiles[0].PercentageOfNodesToScore; p == nil || *p != 50 {
+			t.Errorf("percentageOfNodesToScore of profile = %v, want %d", p, 50)
+		}
+		reformatted := &planner.SchedulerProfile{ConfigPatch: []byte(" " + string(profile.ConfigPatch) + "\n")}
+		if again, err := cache.getConfig(reformatted); err != nil || again != config {
+			t.Errorf("getConfig() for reformatted config patch returned different configuration, want cached configuration")
+		}
+	})
+
+	t.Run("invalid profiles", func(t *testing.T) {
+		if _, err := cache.getConfig(&planner.SchedulerProfile{Name: "unknown"}); !errors.Is(err, planner.ErrLoadSchedulerConfig) {
+			t.Errorf("getConfig() error = %v for unknown profile, want %v", err, planner.ErrLoadSchedulerConfig)
+		}
+		if _, err := cache.getConfig(&planner.SchedulerProfile{ConfigPatch: []byte(`{"profiles":`)}); !errors.Is(err, planner.ErrParseSchedulerConfig) {
+			t.Errorf("getConfig() error = %v for malformed config patch, want %v", err, planner.ErrParseSchedulerConfig)
+		}
+		for _, patch := range []string{
+			`{"extenders": [{"urlPrefix": "http://169.254.169.254", "filterVerb": "filter"}]}`,
+			`{"percentageOfNodesToScore": 50}`,
+			`{"podInitialBackoffSeconds": 0, "profiles": []}`,
+		} {
+			if _, err := cache.getConfig(&planner.SchedulerProfile{ConfigPatch: []byte(patch)}); !errors.Is(err, planner.ErrLoadSchedulerConfig) {
+				t.Errorf("getConfig() error = %v for config patch %s, want %v", err, patch, planner.ErrLoadSchedulerConfig)
+			}
+		}
+	})
+
+	t.Run("eviction", func(t *testing.T) {
+		for i := range maxCachedProfiles + 1 {
+			if _, err := cache.getConfig(&planner.SchedulerProfile{ConfigPatch: fmt.Appendf(nil, `{"profiles": [{"schedulerName": "bin-packing-scheduler", "percentageOfNodesToScore": %d}]}`, i+1)}); err != nil {
+				t.Fatalf("getConfig() error = %v", err)
+			}
+		}
+		if got := cache.size(); got != maxCachedProfiles {
+			t.Errorf("size = %d, want %d", got, maxCachedProfiles)
+		}
+	})
+}
+
+func getScoringStrategyType(profile schedulerapiconfig.KubeSchedulerProfile) schedulerapiconfig.ScoringStrategyType {
+	for _, pc := range profile.PluginConfig {
+		if args, ok := pc.Args.(*schedulerapiconfig.NodeResourcesFitArgs); ok && args.ScoringStrategy != nil {
+			return args.ScoringStrategy.Type
+		}
+	}
+	return ""
+}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/gardener/scaling-advisor/api/planner"
 	"github.com/gardener/scaling-advisor/common/logutil"
+	"github.com/gardener/scaling-advisor/common/metricsutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
 	"github.com/go-logr/logr"
 	"golang.org/x/sync/semaphore"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,8 +32,9 @@ import (
 var _ planner.SchedulerLauncher = (*schedulerLauncher)(nil)
 
 type schedulerLauncher struct {
-	schedulerConfig *schedulerapiconfig.KubeSchedulerConfiguration
+	profiles        *profileCache
 	semaphore       *semaphore.Weighted
+	metricsRecorder planner.MetricsRecorder
 }
 
 var _ planner.SchedulerHandle = (*schedulerHandle)(nil)
@@ -48,13 +51,13 @@ type schedulerHandle struct {
 // It reads the scheduler configuration from the provided file path and validates it.
 // Returns an error if the configuration file cannot be read or parsed.
 // Then delegates to NewLauncherFromConfig
-func NewLauncher(schedulerConfigPath string, maxParallel int) (planner.SchedulerLauncher, error) {
+func NewLauncher(schedulerConfigPath string, maxParallel int, metricsRecorder planner.MetricsRecorder) (planner.SchedulerLauncher, error) {
 	// Initialize the scheduler with the provided configuration
 	configBytes, err := os.ReadFile(filepath.Clean(schedulerConfigPath))
 	if err != nil {
 		return nil, fmt.Errorf("%w: %w", planner.ErrLoadSchedulerConfig, err)
 	}
-	return NewLauncherFromConfig(configBytes, maxParallel)
+	return NewLauncherFromConfig(configBytes, maxParallel, metricsRecorder)
 }
 
 // NewLauncherFromConfig initializes and returns a SchedulerLauncher using the scheduler config bytes (YAML) and a maximum parallelism limit.
@@ -62,14 +65,21 @@ func NewLauncher(schedulerConfigPath string, maxParallel int) (planner.Scheduler
 // parsed.
 // maxParallel represents the maximum number of parallel embedded scheduler instances that are launchable via SchedulerLauncher.Launch.
 // Once crossed, further calls to SchedulerLauncher.Launch will block until previously obtained SchedulerHandle's are stopped.
-func NewLauncherFromConfig(configBytes []byte, maxParallel int) (planner.SchedulerLauncher, error) {
-	scheduledConfig, err := parseSchedulerConfig(configBytes)
+// The kube-scheduler configurations of the scheduler profiles given in the SchedulerLaunchParams are derived from the
+// scheduler configuration and cached.
+// The wait for the semaphore and the duration of launches are recorded with the given metricsRecorder, if not nil.
+func NewLauncherFromConfig(configBytes []byte, maxParallel int, metricsRecorder planner.MetricsRecorder) (planner.SchedulerLauncher, error) {
+	profiles, err := newProfileCache(configBytes)
 	if err != nil {
 		return nil, err
 	}
+	if metricsRecorder == nil {
+		metricsRecorder = metricsutil.NopPlannerMetricsRecorder{}
+	}
 	return &schedulerLauncher{
-		schedulerConfig: scheduledConfig,
+		profiles:        profiles,
 		semaphore:       semaphore.NewWeighted(int64(maxParallel)),
+		metricsRecorder: metricsRecorder,
 	}, nil
 }
 
@@ -96,17 +106,35 @@ func parseSchedulerConfig(configBytes []byte) (config *schedulerapiconfig.KubeSc
 	return config, nil
 }
 
-func (s *schedulerLauncher) Launch(ctx context.Context, params *planner.SchedulerLaunchParams) (planner.SchedulerHandle, error) {
+func (s *schedulerLauncher) LoadProfile(profile *planner.SchedulerProfile) error {
+	_, err := s.profiles.getConfig(profile)
+	return err
+}
+
+func (s *schedulerLauncher) Launch(ctx context.Context, params *planner.SchedulerLaunchParams) (_ planner.SchedulerHandle, err error) {
+	ctx, span := traceutil.StartSpan(ctx, "LaunchScheduler")
+	defer func() {
+		traceutil.EndSpan(span, err)
+	}()
 	log := logr.FromContextOrDiscard(ctx)
-	if err := s.semaphore.Acquire(ctx, 1); err != nil {
+	config, err := s.profiles.getConfig(params.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", planner.ErrLaunchScheduler, err)
+	}
+	waitStart := time.Now()
+	if err = s.semaphore.Acquire(ctx, 1); err != nil {
 		return nil, err
 	}
+	launchStart := time.Now()
+	s.metricsRecorder.RecordSemaphoreWait(launchStart.Sub(waitStart))
 
 	schedulerCtx, cancelFn := context.WithCancel(ctx)
-	handle, err := s.createSchedulerHandle(schedulerCtx, cancelFn, params)
+	handle, err := s.createSchedulerHandle(schedulerCtx, cancelFn, config, params)
 	if err != nil {
 		return nil, err
 	}
+	s.metricsRecorder.RecordSchedulerLaunch(time.Since(launchStart))
+	span.SetAttributes(traceutil.AttrScheduler.String(handle.name))
 
 	go func() {
 		log.V(5).Info("Begin run scheduler", "name", handle.name)
@@ -117,7 +145,7 @@ func (s *schedulerLauncher) Launch(ctx context.Context, params *planner.Schedule
 	return handle, nil
 }
 
-func (s *schedulerLauncher) createSchedulerHandle(ctx context.Context, cancelFn context.CancelFunc, params *planner.SchedulerLaunchParams) (handle *schedulerHandle, err error) {
+func (s *schedulerLauncher) createSchedulerHandle(ctx context.Context, cancelFn context.CancelFunc, config *schedulerapiconfig.KubeSchedulerConfiguration, params *planner.SchedulerLaunchParams) (handle *schedulerHandle, err error) {
 	defer func() {
 		if err != nil {
 			cancelFn()
@@ -155,11 +183,11 @@ func (s *schedulerLauncher) createSchedulerHandle(ctx context.Context, cancelFn
 		params.InformerFactory,
 		params.DynInformerFactory,
 		recorderFactory,
-		scheduler.WithProfiles(s.schedulerConfig.Profiles...),
-		scheduler.WithPercentageOfNodesToScore(s.schedulerConfig.PercentageOfNodesToScore),
-		scheduler.WithPodInitialBackoffSeconds(s.schedulerConfig.PodInitialBackoffSeconds),
-		scheduler.WithPodMaxBackoffSeconds(s.schedulerConfig.PodMaxBackoffSeconds),
-		scheduler.WithExtenders(s.schedulerConfig.Extenders...))
+		scheduler.WithProfiles(config.Profiles...),
+		scheduler.WithPercentageOfNodesToScore(config.PercentageOfNodesToScore),
+		scheduler.WithPodInitialBackoffSeconds(config.PodInitialBackoffSeconds),
+		scheduler.WithPodMaxBackoffSeconds(config.PodMaxBackoffSeconds),
+		scheduler.WithExtenders(config.Extenders...))
 	if err != nil {
 		return
 	}
@@ -194,3 +222,12 @@ func (s *schedulerHandle) Close() error {
 func (s *schedulerHandle) GetParams() planner.SchedulerLaunchParams {
 	return *s.params
 }
+
+func (s *schedulerHandle) IsIdle() bool {
+	queue := s.scheduler.SchedulingQueue
+	if len(queue.PodsInActiveQ()) > 0 || len(queue.PodsInBackoffQ()) > 0 || len(queue.InFlightPods()) > 0 {
+		return false
+	}
+	// pods in their binding cycle are no longer in flight but remain assumed until their binding is observed.
+	return s.scheduler.Cache.Dump().AssumedPods.Len() == 0
+}
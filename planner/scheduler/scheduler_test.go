@@ -19,7 +19,14 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/klog/v2"
+	"k8s.io/kubernetes/pkg/scheduler"
+	internalcache "k8s.io/kubernetes/pkg/scheduler/backend/cache"
+	internalqueue "k8s.io/kubernetes/pkg/scheduler/backend/queue"
+	"k8s.io/kubernetes/pkg/scheduler/framework/plugins/queuesort"
+	"k8s.io/kubernetes/pkg/scheduler/metrics"
+	testingclock "k8s.io/utils/clock/testing"
 )
 
 type suiteState struct {
@@ -132,7 +139,7 @@ func initSuite(ctx context.Context) (suite suiteState, err error) {
 		return
 	}
 
-	launcher, err := NewLauncher(configPath, 1)
+	launcher, err := NewLauncher(configPath, 1, nil)
 	if err != nil {
 		return
 	}
@@ -172,3 +179,89 @@ func shutdownSuite(state *suiteState) {
 		klog.Errorf("failed to shutdown minkapi api: %v, exitCode: %d", state.app, exitCode)
 	}
 }
+
+func TestSchedulerHandleIsIdle(t *testing.T) {
+	metrics.Register()
+	tests := map[string]struct {
+		// setup moves the given pod into the state of the test case within the given queue and cache.
+		setup func(t *testing.T, queue *internalqueue.PriorityQueue, cache internalcache.Cache, pod *corev1.Pod)
+		want  bool
+	}{
+		"no pods": {
+			setup: func(*testing.T, *internalqueue.PriorityQueue, internalcache.Cache, *corev1.Pod) {},
+			want:  true,
+		},
+		"pod in active queue": {
+			setup: func(_ *testing.T, queue *internalqueue.PriorityQueue, _ internalcache.Cache, pod *corev1.Pod) {
+				queue.Add(log, pod)
+			},
+			want: false,
+		},
+		"pod in flight": {
+			setup: func(t *testing.T, queue *internalqueue.PriorityQueue, _ internalcache.Cache, pod *corev1.Pod) {
+				queue.Add(log, pod)
+				if _, err := queue.Pop(log); err != nil {
+					t.Fatalf("Pop() error = %v", err)
+				}
+			},
+			want: false,
+		},
+		"pod in backoff queue": {
+			setup: func(t *testing.T, queue *internalqueue.PriorityQueue, _ internalcache.Cache, pod *corev1.Pod) {
+				queue.Add(log, pod)
+				podInfo, err := queue.Pop(log)
+				if err != nil {
+					t.Fatalf("Pop() error = %v", err)
+				}
+				// a pod failing without rejecting plugins, such as in its binding cycle, is retried after its backoff.
+				if err = queue.AddUnschedulableIfNotPresent(log, podInfo, queue.SchedulingCycle()); err != nil {
+					t.Fatalf("AddUnschedulableIfNotPresent() error = %v", err)
+				}
+				if got := len(queue.PodsInBackoffQ()); got != 1 {
+					t.Fatalf("len(PodsInBackoffQ()) = %d, want 1", got)
+				}
+			},
+			want: false,
+		},
+		"pod in unschedulable pods": {
+			setup: func(t *testing.T, queue *internalqueue.PriorityQueue, _ internalcache.Cache, pod *corev1.Pod) {
+				queue.Add(log, pod)
+				podInfo, err := queue.Pop(log)
+				if err != nil {
+					t.Fatalf("Pop() error = %v", err)
+				}
+				podInfo.UnschedulablePlugins = sets.New("NodeResourcesFit")
+				if err = queue.AddUnschedulableIfNotPresent(log, podInfo, queue.SchedulingCycle()); err != nil {
+					t.Fatalf("AddUnschedulableIfNotPresent() error = %v", err)
+				}
+				if got := len(queue.UnschedulablePods()); got != 1 {
+					t.Fatalf("len(UnschedulablePods()) = %d, want 1", got)
+				}
+			},
+			want: true,
+		},
+		"assumed pod": {
+			setup: func(t *testing.T, _ *internalqueue.PriorityQueue, cache internalcache.Cache, pod *corev1.Pod) {
+				pod.Spec.NodeName = "node-a"
+				if err := cache.AssumePod(log, pod); err != nil {
+					t.Fatalf("AssumePod() error = %v", err)
+				}
+			},
+			want: false,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(t.Context())
+			defer cancel()
+			queue := internalqueue.NewTestQueue(ctx, (&queuesort.PrioritySort{}).Less, internalqueue.WithClock(testingclock.NewFakeClock(time.Now())))
+			cache := internalcache.New(ctx, time.Hour, nil)
+			pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "pod-a", Namespace: "default", UID: "pod-a"}}
+			tc.setup(t, queue, cache, pod)
+			handle := &schedulerHandle{ctx: ctx, scheduler: &scheduler.Scheduler{SchedulingQueue: queue, Cache: cache}}
+			if got := handle.IsIdle(); got != tc.want {
+				t.Errorf("IsIdle() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
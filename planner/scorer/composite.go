@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scorer
+
+import (
+	"fmt"
+	"math"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	pricingapi "github.com/gardener/scaling-advisor/api/pricing"
+)
+
+var _ plannerapi.NodeScorer = (*Composite)(nil)
+
+// Composite contains information required by the composite node scoring strategy.
+type Composite struct {
+	pricingAccess   pricingapi.InstancePricingAccess
+	resourceWeigher plannerapi.ResourceWeigher
+	weights         commontypes.CompositeScoringWeights
+	tieBreaker      *tieBreaker
+	// spotInterruptionPenaltyPercent is the percentage by which the hourly price of spot capacity is increased.
+	spotInterruptionPenaltyPercent float64
+}
+
+// NewComposite creates a NodeScorer for the composite node scoring strategy with the CompositeWeights of the given
+// params, which default to commontypes.DefaultCompositeScoringWeights.
+func NewComposite(params plannerapi.NodeScorerParams) (plannerapi.NodeScorer, error) {
+	weights := commontypes.DefaultCompositeScoringWeights
+	if params.CompositeWeights != nil {
+		weights = *params.CompositeWeights
+	}
+	if err := weights.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", plannerapi.ErrCreateNodeScorer, err)
+	}
+	return &Composite{
+		pricingAccess:                  params.PricingAccess,
+		resourceWeigher:                params.ResourceWeigher,
+		weights:                        weights,
+		tieBreaker:                     newTieBreaker(params.Seed),
+		spotInterruptionPenaltyPercent: params.SpotInterruptionPenaltyPercent,
+	}, nil
+}
+
+// Compute returns the NodeScore for the composite strategy. It computes the CompositeScoreCriteria of the scaled node:
+// the effective hourly price (see LeastCost.Compute) per normalized resource unit (NRU) scheduled, and the NRU wasted
+// (see LeastWaste.Compute). The Value of the NodeScore is the same as for the least-cost strategy.
+func (c Composite) Compute(args plannerapi.NodeScorerArgs) (score plannerapi.NodeScore, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("%w: composite node scoring failed for simulation %q: %v", plannerapi.ErrComputeNodeScore, args.ID, err)
+		}
+	}()
+	weights, err := c.resourceWeigher.GetWeights(args.ScaledNodePlacement.InstanceType)
+	if err != nil {
+		return
+	}
+	scheduledUnits := getNormalizedResourceUnits(getAggregatedScheduledPodsResources(args.ScaledNodePodAssignment, args.OtherNodePodAssignments), weights)
+	wastedUnits := getNormalizedResourceUnits(getWastedResources(args), weights)
+	hourlyPrice, err := GetEffectiveHourlyPrice(c.pricingAccess, args.ScaledNodePlacement, c.spotInterruptionPenaltyPercent)
+	if err != nil {
+		return
+	}
+	costPerUnit := math.Inf(1)
+	if scheduledUnits > 0 {
+		costPerUnit = hourlyPrice / scheduledUnits
+	}
+	score = plannerapi.NodeScore{
+		Name:               args.ID,
+		Placement:          args.ScaledNodePlacement,
+		Value:              int(math.Round(scheduledUnits * 100 / hourlyPrice)),
+		ScaledNodeResource: args.ScaledNodePodAssignment.NodeResources,
+		UnscheduledPods:    args.LeftOverUnscheduledPods,
+		ScheduledPods:      getScheduledPodNames(args.ScaledNodePodAssignment),
+		CompositeCriteria: &plannerapi.CompositeScoreCriteria{
+			CostPerUnit: costPerUnit,
+			WastedUnits: wastedUnits,
+		},
+	}
+	return
+}
+
+// Select returns the node score with the lowest weighted sum of its criteria, each normalized to [0, 1] across the given
+// nodeScores: the cost per NRU scheduled, the NRU wasted, the number of pods left unscheduled as a proxy for the number
+// of further nodes required, and the PoolZoneNodeCount. The tie is broken as described in selectWinner.
+func (c Composite) Select(nodeScores []plannerapi.NodeScore) (*plannerapi.NodeScore, error) {
+	if len(nodeScores) == 0 {
+		return nil, plannerapi.ErrNoWinningNodeScore
+	}
+	if len(nodeScores) == 1 {
+		return &nodeScores[0], nil
+	}
+	costs := make([]float64, 0, len(nodeScores))
+	wastes := make([]float64, 0, len(nodeScores))
+	unscheduledPods := make([]float64, 0, len(nodeScores))
+	zoneSpreads := make([]float64, 0, len(nodeScores))
+	for _, candidate := range nodeScores {
+		if candidate.CompositeCriteria == nil {
+			return nil, fmt.Errorf("%w: node score %q has no composite criteria", plannerapi.ErrSelectNodeScore, candidate.Name)
+		}
+		costs = append(costs, candidate.CompositeCriteria.CostPerUnit)
+		wastes = append(wastes, candidate.CompositeCriteria.WastedUnits)
+		unscheduledPods = append(unscheduledPods, float64(len(candidate.UnscheduledPods)))
+		zoneSpreads = append(zoneSpreads, float64(candidate.PoolZoneNodeCount))
+	}
+	combined := make([]float64, len(nodeScores))
+	for _, criterion := range []struct {
+		values []float64
+		weight float64
+	}{
+		{costs, c.weights.Cost},
+		{wastes, c.weights.Waste},
+		{unscheduledPods, c.weights.UnscheduledPods},
+		{zoneSpreads, c.weights.ZoneSpread},
+	} {
+		if criterion.weight == 0 {
+			continue
+		}
+		for i, v := range normalizeMinMax(criterion.values) {
+			combined[i] += criterion.weight * v
+		}
+	}
+	return selectWinner(nodeScores, getIndices(nodeScores), combined, false, c.tieBreaker), nil
+}
+
+// normalizeMinMax returns the given finite values scaled to [0, 1] by their minimum and maximum, where all values are 0
+// if they are equal. Infinite values are scaled to 1.
+func normalizeMinMax(values []float64) []float64 {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, v := range values {
+		if math.IsInf(v, 0) {
+			continue
+		}
+		lo = min(lo, v)
+		hi = max(hi, v)
+	}
+	normalized := make([]float64, len(values))
+	for i, v := range values {
+		switch {
+		case math.IsInf(v, 0):
+			normalized[i] = 1
+		case hi > lo:
+			normalized[i] = (v - lo) / (hi - lo)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,63 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scorer
+
+import (
+	"fmt"
+	"sync"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+)
+
+var _ plannerapi.NodeScorerRegistry = (*registry)(nil)
+
+type registry struct {
+	createFuncs map[commontypes.NodeScoringStrategy]plannerapi.CreateNodeScorerFunc
+	mu          sync.RWMutex
+}
+
+// NewRegistry returns a NodeScorerRegistry with the built-in least-cost, least-waste and composite node scoring
+// strategies registered. Further strategies can be registered by name using Register.
+func NewRegistry() plannerapi.NodeScorerRegistry {
+	return &registry{
+		createFuncs: map[commontypes.NodeScoringStrategy]plannerapi.CreateNodeScorerFunc{
+			commontypes.NodeScoringStrategyLeastCost:  NewLeastCost,
+			commontypes.NodeScoringStrategyLeastWaste: NewLeastWaste,
+			commontypes.NodeScoringStrategyComposite:  NewComposite,
+		},
+	}
+}
+
+// Register registers the given createFunc for the given scoringStrategy.
+func (r *registry) Register(scoringStrategy commontypes.NodeScoringStrategy, createFunc plannerapi.CreateNodeScorerFunc) error {
+	if scoringStrategy == "" {
+		return fmt.Errorf("%w: scoring strategy must be specified", plannerapi.ErrRegisterNodeScorer)
+	}
+	if createFunc == nil {
+		return fmt.Errorf("%w: create func for %q must be specified", plannerapi.ErrRegisterNodeScorer, scoringStrategy)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.createFuncs[scoringStrategy]; ok {
+		return fmt.Errorf("%w: %q is already registered", plannerapi.ErrRegisterNodeScorer, scoringStrategy)
+	}
+	r.createFuncs[scoringStrategy] = createFunc
+	return nil
+}
+
+// GetNodeScorer creates the NodeScorer registered for the given scoringStrategy using the given params.
+func (r *registry) GetNodeScorer(scoringStrategy commontypes.NodeScoringStrategy, params plannerapi.NodeScorerParams) (plannerapi.NodeScorer, error) {
+	if scoringStrategy == "" {
+		return nil, fmt.Errorf("%w: scoring strategy must be specified", plannerapi.ErrCreateNodeScorer)
+	}
+	r.mu.RLock()
+	createFunc, ok := r.createFuncs[scoringStrategy]
+	r.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported %q", plannerapi.ErrCreateNodeScorer, scoringStrategy)
+	}
+	return createFunc(params)
+}
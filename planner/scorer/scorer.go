@@ -9,35 +9,36 @@ import (
 	"maps"
 	"math"
 	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
 
 	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 	pricingapi "github.com/gardener/scaling-advisor/api/pricing"
 	corev1 "k8s.io/api/core/v1"
 )
 
-var _ plannerapi.GetNodeScorer = GetNodeScorer
-
-// GetNodeScorer returns the NodeScorer based on the NodeScoringStrategy
-func GetNodeScorer(scoringStrategy commontypes.NodeScoringStrategy, instancePricingAccess pricingapi.InstancePricingAccess, resourceWeigher plannerapi.ResourceWeigher) (plannerapi.NodeScorer, error) {
-	switch scoringStrategy {
-	case "":
-		return nil, fmt.Errorf("%w: scoring strategy must be specified", plannerapi.ErrCreateNodeScorer)
-	case commontypes.NodeScoringStrategyLeastCost:
-		return &LeastCost{pricingAccess: instancePricingAccess, resourceWeigher: resourceWeigher}, nil
-	case commontypes.NodeScoringStrategyLeastWaste:
-		return &LeastWaste{pricingAccess: instancePricingAccess, resourceWeigher: resourceWeigher}, nil
-	default:
-		return nil, fmt.Errorf("%w: unsupported %q", plannerapi.ErrCreateNodeScorer, scoringStrategy)
-	}
-}
-
 var _ plannerapi.NodeScorer = (*LeastCost)(nil)
 
 // LeastCost contains information required by the least-cost node scoring strategy
 type LeastCost struct {
 	pricingAccess   pricingapi.InstancePricingAccess
 	resourceWeigher plannerapi.ResourceWeigher
+	tieBreaker      *tieBreaker
+	// spotInterruptionPenaltyPercent is the percentage by which the hourly price of spot capacity is increased.
+	spotInterruptionPenaltyPercent float64
+}
+
+// NewLeastCost creates a NodeScorer for the least-cost node scoring strategy.
+func NewLeastCost(params plannerapi.NodeScorerParams) (plannerapi.NodeScorer, error) {
+	return &LeastCost{
+		pricingAccess:                  params.PricingAccess,
+		resourceWeigher:                params.ResourceWeigher,
+		tieBreaker:                     newTieBreaker(params.Seed),
+		spotInterruptionPenaltyPercent: params.SpotInterruptionPenaltyPercent,
+	}, nil
 }
 
 // Compute uses the least-cost strategy to generate a score representing the number of normalized resource units (NRU) scheduled per unit cost.
@@ -45,6 +46,8 @@ type LeastCost struct {
 // resource requests.
 // Resource quantities of different resource types are reduced to a representation in terms of NRU
 // based on pre-configured weights.
+// The unit cost is the hourly price of the capacity type of the scaled node, which is increased by the configured
+// interruption penalty for spot capacity.
 func (l LeastCost) Compute(args plannerapi.NodeScorerArgs) (score plannerapi.NodeScore, err error) {
 	defer func() {
 		if err != nil {
@@ -59,24 +62,28 @@ func (l LeastCost) Compute(args plannerapi.NodeScorerArgs) (score plannerapi.Nod
 		return
 	}
 	totalNormalizedResourceUnits := getNormalizedResourceUnits(aggregatedPodsResources, weights)
-	info, err := l.pricingAccess.GetInfo(args.ScaledNodePlacement.Region, args.ScaledNodePlacement.InstanceType)
+	hourlyPrice, err := l.getEffectiveHourlyPrice(args.ScaledNodePlacement)
 	if err != nil {
 		return
 	}
 	score = plannerapi.NodeScore{
 		Name:               args.ID,
 		Placement:          args.ScaledNodePlacement,
-		Value:              int(math.Round(totalNormalizedResourceUnits * 100 / info.HourlyPrice)),
+		Value:              int(math.Round(totalNormalizedResourceUnits * 100 / hourlyPrice)),
 		ScaledNodeResource: args.ScaledNodePodAssignment.NodeResources,
 		UnscheduledPods:    args.LeftOverUnscheduledPods,
+		ScheduledPods:      getScheduledPodNames(args.ScaledNodePodAssignment),
 	}
 	return
 }
 
-// Select returns the index of the node score for the node with the highest allocatable resources.
+// Select returns the index of the node score for the node with the highest allocatable resources excluding the
+// overhead of its daemon pods.
 // This has been done to bias the scorer to pick larger instance types when all other parameters are the same.
 // Larger instance types --> less fragmentation
-// if multiple node scores have instance types with the same allocatable, an index is picked at random from them
+// if multiple node scores have instance types with the same allocatable, only the ones with the lowest effective hourly
+// price (see Compute) are considered, which prefers spot capacity over on-demand capacity of the same instance type
+// unless outweighed by the interruption penalty. The remaining tie is broken as described in selectWinner.
 func (l LeastCost) Select(nodeScores []plannerapi.NodeScore) (*plannerapi.NodeScore, error) {
 	if len(nodeScores) == 0 {
 		return nil, plannerapi.ErrNoWinningNodeScore
@@ -84,25 +91,39 @@ func (l LeastCost) Select(nodeScores []plannerapi.NodeScore) (*plannerapi.NodeSc
 	if len(nodeScores) == 1 {
 		return &nodeScores[0], nil
 	}
-	var winnerIndices []int
-	maxNormalizedAlloc := 0.0
-	for index, candidate := range nodeScores {
+	normalizedAllocs := make([]float64, 0, len(nodeScores))
+	prices := make([]float64, 0, len(nodeScores))
+	for _, candidate := range nodeScores {
 		weights, err := l.resourceWeigher.GetWeights(candidate.Placement.InstanceType)
 		if err != nil {
 			return nil, err
 		}
-		normalizedAlloc := getNormalizedResourceUnits(candidate.ScaledNodeResource.Allocatable, weights)
-		if maxNormalizedAlloc == normalizedAlloc {
-			winnerIndices = append(winnerIndices, index)
-		} else if maxNormalizedAlloc < normalizedAlloc {
-			winnerIndices = winnerIndices[:0]
-			winnerIndices = append(winnerIndices, index)
-			maxNormalizedAlloc = normalizedAlloc
+		normalizedAllocs = append(normalizedAllocs, getNormalizedResourceUnits(getAvailableResources(candidate.ScaledNodeResource), weights))
+		price, err := l.getEffectiveHourlyPrice(candidate.Placement)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, price)
+	}
+	candidates := make([]int, 0, len(nodeScores))
+	for i := range nodeScores {
+		costlier := false
+		for j := range nodeScores {
+			if normalizedAllocs[j] == normalizedAllocs[i] && prices[j] < prices[i] {
+				costlier = true
+				break
+			}
+		}
+		if !costlier {
+			candidates = append(candidates, i)
 		}
 	}
-	//pick one winner at random from winnerIndices
-	randIndex := rand.IntN(len(winnerIndices)) // #nosec G404 -- cryptographic randomness not required here. It randomly picks one of the node scores with the same least price.
-	return &nodeScores[winnerIndices[randIndex]], nil
+	return selectWinner(nodeScores, candidates, normalizedAllocs, true, l.tieBreaker), nil
+}
+
+// getEffectiveHourlyPrice returns the effective hourly price of the given placement, see GetEffectiveHourlyPrice.
+func (l LeastCost) getEffectiveHourlyPrice(placement sacorev1alpha1.NodePlacement) (float64, error) {
+	return GetEffectiveHourlyPrice(l.pricingAccess, placement, l.spotInterruptionPenaltyPercent)
 }
 
 var _ plannerapi.NodeScorer = (*LeastWaste)(nil)
@@ -111,6 +132,19 @@ var _ plannerapi.NodeScorer = (*LeastWaste)(nil)
 type LeastWaste struct {
 	pricingAccess   pricingapi.InstancePricingAccess
 	resourceWeigher plannerapi.ResourceWeigher
+	tieBreaker      *tieBreaker
+	// spotInterruptionPenaltyPercent is the percentage by which the hourly price of spot capacity is increased.
+	spotInterruptionPenaltyPercent float64
+}
+
+// NewLeastWaste creates a NodeScorer for the least-waste node scoring strategy.
+func NewLeastWaste(params plannerapi.NodeScorerParams) (plannerapi.NodeScorer, error) {
+	return &LeastWaste{
+		pricingAccess:                  params.PricingAccess,
+		resourceWeigher:                params.ResourceWeigher,
+		tieBreaker:                     newTieBreaker(params.Seed),
+		spotInterruptionPenaltyPercent: params.SpotInterruptionPenaltyPercent,
+	}, nil
 }
 
 // Compute returns the NodeScore for the least-waste strategy. Instead of calculating absolute wastage across the cluster,
@@ -118,7 +152,8 @@ type LeastWaste struct {
 // Delta wastage can be calculated by summing the wastage on the scaled candidate node
 // and the "negative" waste created as a result of unscheduled pods being scheduled on to existing nodes.
 // Existing nodes include simulated winner nodes from previous runs.
-// Waste = Alloc(ScaledNode) - TotalResourceRequests(Pods scheduled due to scale up)
+// Waste = Alloc(ScaledNode) - Overhead(ScaledNode) - TotalResourceRequests(Pods scheduled due to scale up)
+// where Overhead(ScaledNode) are the resource requests of the daemon pods of the scaled node, which are not waste.
 // Example:
 // SN* - simulated node
 // N* - existing node
@@ -145,19 +180,7 @@ func (l LeastWaste) Compute(args plannerapi.NodeScorerArgs) (nodeScore plannerap
 			err = fmt.Errorf("%w: least-waste node scoring failed for simulation %q: %v", plannerapi.ErrComputeNodeScore, args.ID, err)
 		}
 	}()
-	var wastage = make(corev1.ResourceList)
-	//start with allocatable of scaled candidate node
-	maps.Copy(wastage, args.ScaledNodePodAssignment.NodeResources.Allocatable)
-	//subtract resource requests of pods scheduled on scaled node and existing nodes to find delta
-	aggregatedPodResources := getAggregatedScheduledPodsResources(args.ScaledNodePodAssignment, args.OtherNodePodAssignments)
-	for resourceName, request := range aggregatedPodResources {
-		if waste, found := wastage[resourceName]; !found {
-			continue
-		} else {
-			waste.Sub(request)
-			wastage[resourceName] = waste
-		}
-	}
+	wastage := getWastedResources(args)
 	//calculate single score from wastage using weights
 	weights, err := l.resourceWeigher.GetWeights(args.ScaledNodePlacement.InstanceType)
 	if err != nil {
@@ -168,14 +191,16 @@ func (l LeastWaste) Compute(args plannerapi.NodeScorerArgs) (nodeScore plannerap
 		Name:               args.ID,
 		Placement:          args.ScaledNodePlacement,
 		UnscheduledPods:    args.LeftOverUnscheduledPods,
+		ScheduledPods:      getScheduledPodNames(args.ScaledNodePodAssignment),
 		Value:              int(totalNormalizedResourceUnits * 100),
 		ScaledNodeResource: args.ScaledNodePodAssignment.NodeResources,
 	}
 	return
 }
 
-// Select returns the index of the node score for the node with the lowest price.
-// If multiple node scores have instance types with the same price, an index is picked at random from them
+// Select returns the index of the node score for the node with the lowest effective hourly price, i.e. the hourly
+// price increased by the spot interruption penalty for spot capacity.
+// If multiple node scores have instance types with the same price, the tie is broken as described in selectWinner.
 func (l LeastWaste) Select(nodeScores []plannerapi.NodeScore) (*plannerapi.NodeScore, error) {
 	if len(nodeScores) == 0 {
 		return nil, plannerapi.ErrNoWinningNodeScore
@@ -183,25 +208,148 @@ func (l LeastWaste) Select(nodeScores []plannerapi.NodeScore) (*plannerapi.NodeS
 	if len(nodeScores) == 1 {
 		return &nodeScores[0], nil
 	}
-	var winnerIndices []int
-	leastPrice := math.MaxFloat64
-	for index, candidate := range nodeScores {
-		info, err := l.pricingAccess.GetInfo(candidate.Placement.Region, candidate.Placement.InstanceType)
+	prices := make([]float64, 0, len(nodeScores))
+	for _, candidate := range nodeScores {
+		price, err := GetEffectiveHourlyPrice(l.pricingAccess, candidate.Placement, l.spotInterruptionPenaltyPercent)
 		if err != nil {
 			return nil, err
 		}
-		price := info.HourlyPrice
-		if leastPrice == price {
-			winnerIndices = append(winnerIndices, index)
-		} else if leastPrice > price {
-			winnerIndices = winnerIndices[:0]
-			winnerIndices = append(winnerIndices, index)
-			leastPrice = price
+		prices = append(prices, price)
+	}
+	return selectWinner(nodeScores, getIndices(nodeScores), prices, false, l.tieBreaker), nil
+}
+
+// selectWinner selects the winning node score from the nodeScores at the given candidate indices where criteria[i] is
+// the selection criterion of nodeScores[i] and a higher criterion is better if maximize is true, else a lower criterion
+// is better.
+//
+// Node scores of node pools with ZoneBalancePolicyStrict whose PoolZoneNodeCount is greater than the least
+// PoolZoneNodeCount of the node scores of the same pool are not considered. The node scores with the best criterion
+// are tied. For node pools with a balanced ZoneBalancePolicy, node scores whose criterion is within
+// ZoneBalanceTolerancePercent of the best criterion of the same pool are tied as well, and only the tied node scores
+// of such a pool with the least PoolZoneNodeCount are retained. A winner is picked from the tied node scores by the
+// given tieBreaker.
+func selectWinner(nodeScores []plannerapi.NodeScore, candidates []int, criteria []float64, maximize bool, tieBreaker *tieBreaker) *plannerapi.NodeScore {
+	isBetter := func(a, b float64) bool {
+		if maximize {
+			return a > b
+		}
+		return a < b
+	}
+	candidates = filterLeastPoolZoneNodeCount(nodeScores, candidates, func(ns plannerapi.NodeScore) bool {
+		return ns.ZoneBalance == sacorev1alpha1.ZoneBalancePolicyStrict
+	})
+	best := criteria[candidates[0]]
+	bestByPool := make(map[string]float64)
+	for _, i := range candidates {
+		if isBetter(criteria[i], best) {
+			best = criteria[i]
+		}
+		if poolBest, ok := bestByPool[nodeScores[i].Placement.PoolName]; !ok || isBetter(criteria[i], poolBest) {
+			bestByPool[nodeScores[i].Placement.PoolName] = criteria[i]
+		}
+	}
+	var ties []int
+	for _, i := range candidates {
+		ns := nodeScores[i]
+		poolBest := bestByPool[ns.Placement.PoolName]
+		if criteria[i] == best ||
+			(ns.ZoneBalance.IsBalanced() && poolBest == best && math.Abs(criteria[i]-best) <= math.Abs(best)*plannerapi.ZoneBalanceTolerancePercent/100) {
+			ties = append(ties, i)
 		}
 	}
-	//pick one winner at random from winnerIndices
-	randIndex := rand.IntN(len(winnerIndices)) // #nosec G404 -- cryptographic randomness not required here. It randomly picks one of the node scores with the same least price.
-	return &nodeScores[randIndex], nil
+	ties = filterLeastPoolZoneNodeCount(nodeScores, ties, func(ns plannerapi.NodeScore) bool {
+		return ns.ZoneBalance.IsBalanced()
+	})
+	return &nodeScores[tieBreaker.pick(nodeScores, ties)]
+}
+
+// tieBreaker picks one of tied node scores at random. If it is seeded, it picks reproducibly for the same tied node
+// scores regardless of their order.
+type tieBreaker struct {
+	rng *rand.Rand
+	mu  sync.Mutex
+}
+
+// newTieBreaker returns a tieBreaker seeded with the given seed, or an unseeded tieBreaker if seed is nil.
+func newTieBreaker(seed *int64) *tieBreaker {
+	if seed == nil {
+		return &tieBreaker{}
+	}
+	return &tieBreaker{rng: rand.New(rand.NewPCG(uint64(*seed), 0))} // #nosec G115 G404 -- the seed is only used for reproducible tie-breaking.
+}
+
+// pick returns one of the given indices of tied nodeScores.
+func (t *tieBreaker) pick(nodeScores []plannerapi.NodeScore, ties []int) int {
+	if t == nil || t.rng == nil {
+		return ties[rand.IntN(len(ties))] // #nosec G404 -- cryptographic randomness not required here. It randomly picks one of the tied node scores.
+	}
+	slices.SortFunc(ties, func(a, b int) int {
+		return strings.Compare(nodeScores[a].Name, nodeScores[b].Name)
+	})
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return ties[t.rng.IntN(len(ties))]
+}
+
+// getIndices returns the indices of the given nodeScores.
+func getIndices(nodeScores []plannerapi.NodeScore) []int {
+	indices := make([]int, len(nodeScores))
+	for i := range nodeScores {
+		indices[i] = i
+	}
+	return indices
+}
+
+// filterLeastPoolZoneNodeCount filters the given indices of nodeScores by removing the indices of node scores matching
+// balanced whose PoolZoneNodeCount is greater than the least PoolZoneNodeCount of the matching node scores of the same
+// node pool.
+func filterLeastPoolZoneNodeCount(nodeScores []plannerapi.NodeScore, indices []int, balanced func(ns plannerapi.NodeScore) bool) []int {
+	leastCountByPool := make(map[string]int)
+	for _, i := range indices {
+		ns := nodeScores[i]
+		if !balanced(ns) {
+			continue
+		}
+		if count, ok := leastCountByPool[ns.Placement.PoolName]; !ok || ns.PoolZoneNodeCount < count {
+			leastCountByPool[ns.Placement.PoolName] = ns.PoolZoneNodeCount
+		}
+	}
+	return slices.DeleteFunc(indices, func(i int) bool {
+		ns := nodeScores[i]
+		return balanced(ns) && ns.PoolZoneNodeCount > leastCountByPool[ns.Placement.PoolName]
+	})
+}
+
+// GetEffectiveHourlyPrice returns the hourly price of the capacity type of the given placement, increased by the
+// spotInterruptionPenaltyPercent for spot capacity.
+func GetEffectiveHourlyPrice(pricingAccess pricingapi.InstancePricingAccess, placement sacorev1alpha1.NodePlacement, spotInterruptionPenaltyPercent float64) (float64, error) {
+	info, err := pricingAccess.GetInfo(placement.Region, placement.InstanceType, placement.CapacityType)
+	if err != nil {
+		return 0, err
+	}
+	if placement.CapacityType.IsSpot() {
+		return info.HourlyPrice * (1 + spotInterruptionPenaltyPercent/100), nil
+	}
+	return info.HourlyPrice, nil
+}
+
+// getWastedResources returns the delta wastage of the scaled node of the given args as described in LeastWaste.Compute.
+func getWastedResources(args plannerapi.NodeScorerArgs) corev1.ResourceList {
+	var wastage = make(corev1.ResourceList)
+	//start with allocatable of scaled candidate node excluding the fixed overhead of its daemon pods
+	maps.Copy(wastage, getAvailableResources(args.ScaledNodePodAssignment.NodeResources))
+	//subtract resource requests of pods scheduled on scaled node and existing nodes to find delta
+	aggregatedPodResources := getAggregatedScheduledPodsResources(args.ScaledNodePodAssignment, args.OtherNodePodAssignments)
+	for resourceName, request := range aggregatedPodResources {
+		if waste, found := wastage[resourceName]; !found {
+			continue
+		} else {
+			waste.Sub(request)
+			wastage[resourceName] = waste
+		}
+	}
+	return wastage
 }
 
 // getNormalizedResourceUnits returns the aggregated sum of the resources in terms of normalized resource units
@@ -236,6 +384,34 @@ func getAggregatedScheduledPodsResources(scaledNodeAssignments *plannerapi.NodeP
 	return scheduledResources
 }
 
+// getAvailableResources returns the allocatable resources of the given node excluding the Overhead of its daemon pods.
+func getAvailableResources(nodeResources plannerapi.NodeResourceInfo) corev1.ResourceList {
+	if len(nodeResources.Overhead) == 0 {
+		return nodeResources.Allocatable
+	}
+	available := make(corev1.ResourceList, len(nodeResources.Allocatable))
+	for resourceName, allocatable := range nodeResources.Allocatable {
+		allocatable = allocatable.DeepCopy()
+		if overhead, ok := nodeResources.Overhead[resourceName]; ok {
+			allocatable.Sub(overhead)
+		}
+		available[resourceName] = allocatable
+	}
+	return available
+}
+
+// getScheduledPodNames returns the names of the pods scheduled on the scaled node of the given scaledNodeAssignment.
+func getScheduledPodNames(scaledNodeAssignment *plannerapi.NodePodAssignment) []commontypes.NamespacedName {
+	if scaledNodeAssignment == nil {
+		return nil
+	}
+	podNames := make([]commontypes.NamespacedName, 0, len(scaledNodeAssignment.ScheduledPods))
+	for _, pod := range scaledNodeAssignment.ScheduledPods {
+		podNames = append(podNames, pod.NamespacedName)
+	}
+	return podNames
+}
+
 // addPodRequests adds the pod's requests to aggregateResources resource-wise
 func addPodRequests(podRequest, aggregateResources corev1.ResourceList) {
 	for resourceName, request := range podRequest {
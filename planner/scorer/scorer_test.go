@@ -7,6 +7,7 @@ package scorer
 import (
 	"errors"
 	"reflect"
+	"slices"
 	"testing"
 
 	commontypes "github.com/gardener/scaling-advisor/api/common/types"
@@ -40,6 +41,15 @@ func TestLeastWasteScoringStrategy(t *testing.T) {
 		NodeResources: createNodeResourceInfo("simNode1", "instance-a-2", "2", "4"),
 		ScheduledPods: []plannerapi.PodResourceInfo{podWithStorage},
 	}
+	//test case where daemon pods of the scaled node are fixed overhead
+	assignmentWithOverhead := plannerapi.NodePodAssignment{
+		NodeResources: createNodeResourceInfo("simNode1", "instance-a-1", "2", "4"),
+		ScheduledPods: assignment.ScheduledPods,
+	}
+	assignmentWithOverhead.NodeResources.Overhead = corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse("1"),
+		corev1.ResourceMemory: resource.MustParse("2"),
+	}
 	tests := map[string]struct {
 		input         plannerapi.NodeScorerArgs
 		access        pricingapi.InstancePricingAccess
@@ -61,6 +71,7 @@ func TestLeastWasteScoringStrategy(t *testing.T) {
 				Name:               "testing",
 				Placement:          sacorev1alpha1.NodePlacement{},
 				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{assignment.ScheduledPods[0].NamespacedName},
 				Value:              700,
 				ScaledNodeResource: assignment.NodeResources,
 			},
@@ -82,6 +93,7 @@ func TestLeastWasteScoringStrategy(t *testing.T) {
 				Name:               "testing",
 				Placement:          sacorev1alpha1.NodePlacement{},
 				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{assignment.ScheduledPods[0].NamespacedName},
 				Value:              0,
 				ScaledNodeResource: assignment.NodeResources,
 			},
@@ -100,10 +112,30 @@ func TestLeastWasteScoringStrategy(t *testing.T) {
 				Name:               "testing",
 				Placement:          sacorev1alpha1.NodePlacement{},
 				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{podWithStorage.NamespacedName},
 				Value:              0,
 				ScaledNodeResource: assignmentWithStorage.NodeResources,
 			},
 		},
+		"daemon pod overhead on scaled node": {
+			input: plannerapi.NodeScorerArgs{
+				ID:                      "testing",
+				ScaledNodePlacement:     sacorev1alpha1.NodePlacement{},
+				ScaledNodePodAssignment: &assignmentWithOverhead,
+				OtherNodePodAssignments: nil,
+				LeftOverUnscheduledPods: nil},
+			access:      access,
+			weigher:     &testWeigher{},
+			expectedErr: nil,
+			expectedScore: plannerapi.NodeScore{
+				Name:               "testing",
+				Placement:          sacorev1alpha1.NodePlacement{},
+				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{assignment.ScheduledPods[0].NamespacedName},
+				Value:              0,
+				ScaledNodeResource: assignmentWithOverhead.NodeResources,
+			},
+		},
 		"weights function returns an error": {
 			input: plannerapi.NodeScorerArgs{
 				ID:                      "testing",
@@ -131,7 +163,7 @@ func TestLeastWasteScoringStrategy(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			scorer, err := GetNodeScorer(commontypes.NodeScoringStrategyLeastWaste, tc.access, tc.weigher)
+			scorer, err := NewRegistry().GetNodeScorer(commontypes.NodeScoringStrategyLeastWaste, plannerapi.NodeScorerParams{PricingAccess: tc.access, ResourceWeigher: tc.weigher})
 			if err != nil {
 				t.Fatal(err)
 				return
@@ -169,11 +201,12 @@ func TestLeastCostScoringStrategy(t *testing.T) {
 		ScheduledPods: []plannerapi.PodResourceInfo{podWithStorage},
 	}
 	tests := map[string]struct {
-		input         plannerapi.NodeScorerArgs
-		access        pricingapi.InstancePricingAccess
-		weigher       plannerapi.ResourceWeigher
-		expectedErr   error
-		expectedScore plannerapi.NodeScore
+		input                          plannerapi.NodeScorerArgs
+		access                         pricingapi.InstancePricingAccess
+		weigher                        plannerapi.ResourceWeigher
+		spotInterruptionPenaltyPercent float64
+		expectedErr                    error
+		expectedScore                  plannerapi.NodeScore
 	}{
 		"pod scheduled on scaled node only": {
 			input: plannerapi.NodeScorerArgs{
@@ -189,6 +222,7 @@ func TestLeastCostScoringStrategy(t *testing.T) {
 				Name:               "testing",
 				Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2"},
 				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{assignment.ScheduledPods[0].NamespacedName},
 				Value:              350,
 				ScaledNodeResource: assignment.NodeResources,
 			},
@@ -210,10 +244,50 @@ func TestLeastCostScoringStrategy(t *testing.T) {
 				Name:               "testing",
 				Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2"},
 				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{assignment.ScheduledPods[0].NamespacedName},
 				Value:              700,
 				ScaledNodeResource: assignment.NodeResources,
 			},
 		},
+		"spot capacity": {
+			input: plannerapi.NodeScorerArgs{
+				ID:                      "testing",
+				ScaledNodePlacement:     sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+				ScaledNodePodAssignment: &assignment,
+				OtherNodePodAssignments: nil,
+				LeftOverUnscheduledPods: nil},
+			access:      access,
+			weigher:     &testWeigher{},
+			expectedErr: nil,
+			expectedScore: plannerapi.NodeScore{
+				Name:               "testing",
+				Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{assignment.ScheduledPods[0].NamespacedName},
+				Value:              700,
+				ScaledNodeResource: assignment.NodeResources,
+			},
+		},
+		"spot capacity with interruption penalty": {
+			input: plannerapi.NodeScorerArgs{
+				ID:                      "testing",
+				ScaledNodePlacement:     sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+				ScaledNodePodAssignment: &assignment,
+				OtherNodePodAssignments: nil,
+				LeftOverUnscheduledPods: nil},
+			access:                         access,
+			weigher:                        &testWeigher{},
+			spotInterruptionPenaltyPercent: 50,
+			expectedErr:                    nil,
+			expectedScore: plannerapi.NodeScore{
+				Name:               "testing",
+				Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{assignment.ScheduledPods[0].NamespacedName},
+				Value:              467,
+				ScaledNodeResource: assignment.NodeResources,
+			},
+		},
 		"weights undefined for resource type": {
 			input: plannerapi.NodeScorerArgs{
 				ID:                      "testing",
@@ -228,6 +302,7 @@ func TestLeastCostScoringStrategy(t *testing.T) {
 				Name:               "testing",
 				Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2"},
 				UnscheduledPods:    nil,
+				ScheduledPods:      []commontypes.NamespacedName{podWithStorage.NamespacedName},
 				Value:              350,
 				ScaledNodeResource: assignmentWithStorage.NodeResources,
 			},
@@ -258,7 +333,7 @@ func TestLeastCostScoringStrategy(t *testing.T) {
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
-			scorer, err := GetNodeScorer(commontypes.NodeScoringStrategyLeastCost, tc.access, tc.weigher)
+			scorer, err := NewRegistry().GetNodeScorer(commontypes.NodeScoringStrategyLeastCost, plannerapi.NodeScorerParams{PricingAccess: tc.access, ResourceWeigher: tc.weigher, SpotInterruptionPenaltyPercent: tc.spotInterruptionPenaltyPercent})
 			if err != nil {
 				t.Fatal(err)
 			}
@@ -281,7 +356,7 @@ func TestSelectMaxAllocatable(t *testing.T) {
 		t.Fatal(err)
 		return
 	}
-	scorer, err := GetNodeScorer(commontypes.NodeScoringStrategyLeastCost, access, &testWeigher{})
+	scorer, err := NewRegistry().GetNodeScorer(commontypes.NodeScoringStrategyLeastCost, plannerapi.NodeScorerParams{PricingAccess: access, ResourceWeigher: &testWeigher{}})
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -362,6 +437,31 @@ func TestSelectMaxAllocatable(t *testing.T) {
 				},
 			},
 		},
+		"identical allocatables with spot capacity": {
+			input: []plannerapi.NodeScore{
+				{
+					Name:               "testing1",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2"},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode1", "instance-a-2", "2", "4")},
+				{
+					Name:               "testing2",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode2", "instance-a-2", "2", "4"),
+				},
+			},
+			expectedErr: nil,
+			expectedIn: []plannerapi.NodeScore{{
+				Name:               "testing2",
+				Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+				UnscheduledPods:    nil,
+				Value:              1,
+				ScaledNodeResource: createNodeResourceInfo("simNode2", "instance-a-2", "2", "4"),
+			}},
+		},
 		"undefined weights for resource type": {
 			input: []plannerapi.NodeScore{
 				{
@@ -418,14 +518,12 @@ func TestSelectMinPrice(t *testing.T) {
 		t.Fatal(err)
 		return
 	}
-	scorer, err := GetNodeScorer(commontypes.NodeScoringStrategyLeastCost, access, &testWeigher{})
-	if err != nil {
-		t.Fatal(err)
-	}
 	tests := map[string]struct {
-		input       []plannerapi.NodeScore
-		expectedErr error
-		expectedIn  []plannerapi.NodeScore
+		input                          []plannerapi.NodeScore
+		strategy                       commontypes.NodeScoringStrategy
+		spotInterruptionPenaltyPercent float64
+		expectedErr                    error
+		expectedIn                     []plannerapi.NodeScore
 	}{
 		"single node score": {
 			input:       []plannerapi.NodeScore{{Name: "testing", Placement: sacorev1alpha1.NodePlacement{}, UnscheduledPods: nil, Value: 1, ScaledNodeResource: createNodeResourceInfo("simNode1", "instance-a-1", "2", "4")}},
@@ -495,9 +593,68 @@ func TestSelectMinPrice(t *testing.T) {
 				},
 			},
 		},
+		"least-waste spot cheaper than on-demand": {
+			input: []plannerapi.NodeScore{
+				{
+					Name:               "spot",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode1", "instance-a-2", "1", "2")},
+				{
+					Name:               "on-demand",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2"},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode2", "instance-a-2", "1", "2")},
+			},
+			strategy:    commontypes.NodeScoringStrategyLeastWaste,
+			expectedErr: nil,
+			expectedIn: []plannerapi.NodeScore{
+				{
+					Name:               "spot",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode1", "instance-a-2", "1", "2")}},
+		},
+		"least-waste spot costlier than on-demand with interruption penalty": {
+			input: []plannerapi.NodeScore{
+				{
+					Name:               "spot",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2", CapacityType: commontypes.CapacityTypeSpot},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode1", "instance-a-2", "1", "2")},
+				{
+					Name:               "on-demand",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2"},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode2", "instance-a-2", "1", "2")},
+			},
+			strategy:                       commontypes.NodeScoringStrategyLeastWaste,
+			spotInterruptionPenaltyPercent: 150,
+			expectedErr:                    nil,
+			expectedIn: []plannerapi.NodeScore{
+				{
+					Name:               "on-demand",
+					Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-2"},
+					UnscheduledPods:    nil,
+					Value:              1,
+					ScaledNodeResource: createNodeResourceInfo("simNode2", "instance-a-2", "1", "2")}},
+		},
 	}
 	for name, tc := range tests {
 		t.Run(name, func(t *testing.T) {
+			strategy := tc.strategy
+			if strategy == "" {
+				strategy = commontypes.NodeScoringStrategyLeastCost
+			}
+			scorer, err := NewRegistry().GetNodeScorer(strategy, plannerapi.NodeScorerParams{PricingAccess: access, ResourceWeigher: &testWeigher{}, SpotInterruptionPenaltyPercent: tc.spotInterruptionPenaltyPercent})
+			if err != nil {
+				t.Fatal(err)
+			}
 			winningNodeScore, err := scorer.Select(tc.input)
 			errDiff := cmp.Diff(tc.expectedErr, err, cmpopts.EquateErrors())
 			found := false
@@ -521,6 +678,118 @@ func TestSelectMinPrice(t *testing.T) {
 	}
 }
 
+func TestSelectZoneBalanced(t *testing.T) {
+	access, err := pricingtestutil.GetInstancePricingAccessWithFakeData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	scorer, err := NewRegistry().GetNodeScorer(commontypes.NodeScoringStrategyLeastCost, plannerapi.NodeScorerParams{PricingAccess: access, ResourceWeigher: &testWeigher{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	nodeScore := func(name, zone string, policy sacorev1alpha1.ZoneBalancePolicy, poolZoneNodeCount int, cpu, memory string) plannerapi.NodeScore {
+		return plannerapi.NodeScore{
+			Name:               name,
+			Placement:          sacorev1alpha1.NodePlacement{PoolName: "p", Region: "s", InstanceType: "instance-a-1", AvailabilityZone: zone},
+			Value:              1,
+			ScaledNodeResource: createNodeResourceInfo(name, "instance-a-1", cpu, memory),
+			ZoneBalance:        policy,
+			PoolZoneNodeCount:  poolZoneNodeCount,
+		}
+	}
+	tests := map[string]struct {
+		input        []plannerapi.NodeScore
+		expectedName string
+	}{
+		"preferred picks least populated zone on tie": {
+			input: []plannerapi.NodeScore{
+				nodeScore("testing1", "a", sacorev1alpha1.ZoneBalancePolicyPreferred, 2, "2", "4"),
+				nodeScore("testing2", "b", sacorev1alpha1.ZoneBalancePolicyPreferred, 1, "2", "4"),
+			},
+			expectedName: "testing2",
+		},
+		"preferred picks least populated zone within tolerance": {
+			input: []plannerapi.NodeScore{
+				nodeScore("testing1", "a", sacorev1alpha1.ZoneBalancePolicyPreferred, 1, "4", "8"),
+				nodeScore("testing2", "b", sacorev1alpha1.ZoneBalancePolicyPreferred, 0, "4", "7"),
+			},
+			expectedName: "testing2",
+		},
+		"preferred keeps best score beyond tolerance": {
+			input: []plannerapi.NodeScore{
+				nodeScore("testing1", "a", sacorev1alpha1.ZoneBalancePolicyPreferred, 1, "4", "8"),
+				nodeScore("testing2", "b", sacorev1alpha1.ZoneBalancePolicyPreferred, 0, "2", "4"),
+			},
+			expectedName: "testing1",
+		},
+		"strict restricts to least populated zone": {
+			input: []plannerapi.NodeScore{
+				nodeScore("testing1", "a", sacorev1alpha1.ZoneBalancePolicyStrict, 1, "4", "8"),
+				nodeScore("testing2", "b", sacorev1alpha1.ZoneBalancePolicyStrict, 0, "2", "4"),
+			},
+			expectedName: "testing2",
+		},
+		"none ignores zone counts": {
+			input: []plannerapi.NodeScore{
+				nodeScore("testing1", "a", sacorev1alpha1.ZoneBalancePolicyNone, 1, "4", "8"),
+				nodeScore("testing2", "b", sacorev1alpha1.ZoneBalancePolicyNone, 0, "4", "7"),
+			},
+			expectedName: "testing1",
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			winningNodeScore, err := scorer.Select(tc.input)
+			if err != nil {
+				t.Fatalf("Select failed with error: %v", err)
+			}
+			if winningNodeScore.Name != tc.expectedName {
+				t.Fatalf("Expected winning node score %q, got: %q", tc.expectedName, winningNodeScore.Name)
+			}
+		})
+	}
+}
+
+func TestSelectSeeded(t *testing.T) {
+	access, err := pricingtestutil.GetInstancePricingAccessWithFakeData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nodeScores []plannerapi.NodeScore
+	for _, name := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+		nodeScores = append(nodeScores, plannerapi.NodeScore{
+			Name:               name,
+			Placement:          sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-1", AvailabilityZone: name},
+			ScaledNodeResource: createNodeResourceInfo(name, "instance-a-1", "2", "4"),
+		})
+	}
+	reversed := slices.Clone(nodeScores)
+	slices.Reverse(reversed)
+	for _, strategy := range []commontypes.NodeScoringStrategy{commontypes.NodeScoringStrategyLeastCost, commontypes.NodeScoringStrategyLeastWaste} {
+		t.Run(string(strategy), func(t *testing.T) {
+			selectWithSeed := func(seed int64, input []plannerapi.NodeScore) []string {
+				scorer, err := NewRegistry().GetNodeScorer(strategy, plannerapi.NodeScorerParams{PricingAccess: access, ResourceWeigher: &testWeigher{}, Seed: &seed})
+				if err != nil {
+					t.Fatal(err)
+				}
+				var names []string
+				for range 5 {
+					winner, err := scorer.Select(input)
+					if err != nil {
+						t.Fatalf("Select failed with error: %v", err)
+					}
+					names = append(names, winner.Name)
+				}
+				return names
+			}
+			want := selectWithSeed(7, nodeScores)
+			if got := selectWithSeed(7, reversed); !slices.Equal(got, want) {
+				t.Errorf("Expected winners %v for the same seed and reversed node scores, got: %v", want, got)
+			}
+		})
+	}
+}
+
 func TestGetNodeScorer(t *testing.T) {
 	tests := map[string]struct {
 		expectedError error
@@ -537,6 +806,16 @@ func TestGetNodeScorer(t *testing.T) {
 			expectedType:  "*scorer.LeastWaste",
 			expectedError: nil,
 		},
+		"composite strategy": {
+			input:         commontypes.NodeScoringStrategyComposite,
+			expectedType:  "*scorer.Composite",
+			expectedError: nil,
+		},
+		"empty strategy": {
+			input:         "",
+			expectedType:  "",
+			expectedError: plannerapi.ErrCreateNodeScorer,
+		},
 		"invalid strategy": {
 			input:         "invalid",
 			expectedType:  "",
@@ -549,7 +828,7 @@ func TestGetNodeScorer(t *testing.T) {
 			if err != nil {
 				t.Fatalf("GetInstancePricingAccessWithFakeData failed with error: %v", err)
 			}
-			got, err := GetNodeScorer(tc.input, access, &testWeigher{})
+			got, err := NewRegistry().GetNodeScorer(tc.input, plannerapi.NodeScorerParams{PricingAccess: access, ResourceWeigher: &testWeigher{}})
 			if tc.expectedError == nil {
 				if err != nil {
 					t.Fatalf("Expected error to be nil but got %v", err)
@@ -575,6 +854,129 @@ func TestGetNodeScorer(t *testing.T) {
 	}
 }
 
+func TestRegisterNodeScorer(t *testing.T) {
+	const custom commontypes.NodeScoringStrategy = "custom"
+	registry := NewRegistry()
+	createFunc := func(params plannerapi.NodeScorerParams) (plannerapi.NodeScorer, error) {
+		return NewLeastWaste(params)
+	}
+	if err := registry.Register(custom, createFunc); err != nil {
+		t.Fatalf("Register failed with error: %v", err)
+	}
+	got, err := registry.GetNodeScorer(custom, plannerapi.NodeScorerParams{ResourceWeigher: &testWeigher{}})
+	if err != nil {
+		t.Fatalf("GetNodeScorer failed with error: %v", err)
+	}
+	if gotType := reflect.TypeOf(got).String(); gotType != "*scorer.LeastWaste" {
+		t.Fatalf("Expected type *scorer.LeastWaste but got %s", gotType)
+	}
+	for name, strategy := range map[string]commontypes.NodeScoringStrategy{
+		"already registered custom strategy":   custom,
+		"already registered built-in strategy": commontypes.NodeScoringStrategyLeastCost,
+		"empty strategy":                       "",
+	} {
+		if err = registry.Register(strategy, createFunc); !errors.Is(err, plannerapi.ErrRegisterNodeScorer) {
+			t.Errorf("%s: Expected error to wrap %v but got %v", name, plannerapi.ErrRegisterNodeScorer, err)
+		}
+	}
+	if err = registry.Register("other", nil); !errors.Is(err, plannerapi.ErrRegisterNodeScorer) {
+		t.Errorf("nil create func: Expected error to wrap %v but got %v", plannerapi.ErrRegisterNodeScorer, err)
+	}
+}
+
+func TestCompositeScoringStrategy(t *testing.T) {
+	access, err := pricingtestutil.GetInstancePricingAccessWithFakeData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	scorer, err := NewRegistry().GetNodeScorer(commontypes.NodeScoringStrategyComposite, plannerapi.NodeScorerParams{PricingAccess: access, ResourceWeigher: &testWeigher{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assignment := plannerapi.NodePodAssignment{
+		NodeResources: createNodeResourceInfo("simNode1", "instance-a-1", "2", "4"),
+		ScheduledPods: []plannerapi.PodResourceInfo{
+			createPodResourceInfo("simPodA", "1", "2"),
+		},
+	}
+	got, err := scorer.Compute(plannerapi.NodeScorerArgs{
+		ID:                      "testing",
+		ScaledNodePlacement:     sacorev1alpha1.NodePlacement{Region: "s", InstanceType: "instance-a-1"},
+		ScaledNodePodAssignment: &assignment,
+	})
+	if err != nil {
+		t.Fatalf("Compute failed with error: %v", err)
+	}
+	expectedCriteria := &plannerapi.CompositeScoreCriteria{CostPerUnit: 1.0 / 7, WastedUnits: 7}
+	if diff := cmp.Diff(expectedCriteria, got.CompositeCriteria); diff != "" {
+		t.Fatalf("Difference: %s", diff)
+	}
+	if got.Value != 700 {
+		t.Fatalf("Expected value 700, got: %d", got.Value)
+	}
+}
+
+func TestSelectComposite(t *testing.T) {
+	nodeScore := func(name string, costPerUnit, wastedUnits float64, unscheduledPods, poolZoneNodeCount int) plannerapi.NodeScore {
+		return plannerapi.NodeScore{
+			Name:              name,
+			Placement:         sacorev1alpha1.NodePlacement{PoolName: "p", Region: "s", InstanceType: "instance-a-1"},
+			UnscheduledPods:   make([]commontypes.NamespacedName, unscheduledPods),
+			PoolZoneNodeCount: poolZoneNodeCount,
+			CompositeCriteria: &plannerapi.CompositeScoreCriteria{CostPerUnit: costPerUnit, WastedUnits: wastedUnits},
+		}
+	}
+	input := []plannerapi.NodeScore{
+		nodeScore("cheap", 0.1, 10, 2, 2),
+		nodeScore("lean", 0.2, 0, 2, 2),
+		nodeScore("large", 0.3, 10, 0, 2),
+		nodeScore("spread", 0.3, 10, 2, 0),
+	}
+	tests := map[string]struct {
+		weights      *commontypes.CompositeScoringWeights
+		expectedName string
+	}{
+		"cost weight only":             {weights: &commontypes.CompositeScoringWeights{Cost: 1}, expectedName: "cheap"},
+		"waste weight only":            {weights: &commontypes.CompositeScoringWeights{Waste: 1}, expectedName: "lean"},
+		"unscheduled pods weight only": {weights: &commontypes.CompositeScoringWeights{UnscheduledPods: 1}, expectedName: "large"},
+		"zone spread weight only":      {weights: &commontypes.CompositeScoringWeights{ZoneSpread: 1}, expectedName: "spread"},
+		"default weights":              {weights: nil, expectedName: "lean"},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			scorer, err := NewComposite(plannerapi.NodeScorerParams{ResourceWeigher: &testWeigher{}, CompositeWeights: tc.weights})
+			if err != nil {
+				t.Fatal(err)
+			}
+			winningNodeScore, err := scorer.Select(input)
+			if err != nil {
+				t.Fatalf("Select failed with error: %v", err)
+			}
+			if winningNodeScore.Name != tc.expectedName {
+				t.Fatalf("Expected winning node score %q, got: %q", tc.expectedName, winningNodeScore.Name)
+			}
+		})
+	}
+
+	scorer, err := NewComposite(plannerapi.NodeScorerParams{ResourceWeigher: &testWeigher{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	withoutCriteria := nodeScore("other", 0, 0, 0, 0)
+	withoutCriteria.CompositeCriteria = nil
+	if _, err = scorer.Select([]plannerapi.NodeScore{input[0], withoutCriteria}); !errors.Is(err, plannerapi.ErrSelectNodeScore) {
+		t.Fatalf("Expected error to wrap %v but got %v", plannerapi.ErrSelectNodeScore, err)
+	}
+	for name, weights := range map[string]commontypes.CompositeScoringWeights{
+		"zero weights":     {},
+		"negative weights": {Cost: 1, Waste: -1},
+	} {
+		if _, err = NewComposite(plannerapi.NodeScorerParams{CompositeWeights: &weights}); !errors.Is(err, plannerapi.ErrCreateNodeScorer) {
+			t.Errorf("%s: Expected error to wrap %v but got %v", name, plannerapi.ErrCreateNodeScorer, err)
+		}
+	}
+}
+
 // Helper function to create mock nodes
 func createNodeResourceInfo(name, instanceType string, cpu, memory string) plannerapi.NodeResourceInfo {
 	return plannerapi.NodeResourceInfo{
@@ -616,6 +1018,10 @@ type testInfoAccess struct {
 }
 
 // Helper function to create stub instance pricing access that returns an error
-func (m *testInfoAccess) GetInfo(_, _ string) (info pricingapi.InstancePriceInfo, err error) {
+func (m *testInfoAccess) GetInfo(_, _ string, _ commontypes.CapacityType) (info pricingapi.InstancePriceInfo, err error) {
 	return pricingapi.InstancePriceInfo{}, m.err
 }
+
+func (m *testInfoAccess) ListInfos() []pricingapi.InstancePriceInfo {
+	return nil
+}
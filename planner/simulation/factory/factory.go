@@ -6,6 +6,7 @@
 package factory
 
 import (
+	"github.com/gardener/scaling-advisor/planner/simulation/scalein"
 	"github.com/gardener/scaling-advisor/planner/simulation/scaleout"
 
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
@@ -23,3 +24,7 @@ type defaultFactory struct{}
 func (s *defaultFactory) NewScaleOut(args plannerapi.ScaleOutSimArgs) (plannerapi.ScaleOutSimulation, error) {
 	return scaleout.NewDefault(args)
 }
+
+func (s *defaultFactory) NewScaleIn(args plannerapi.ScaleInSimArgs) (plannerapi.ScaleInSimulation, error) {
+	return scalein.NewDefault(args)
+}
@@ -0,0 +1,346 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scalein provides implementation types for the [plannerapi.ScaleInSimulation]
+package scalein
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	"github.com/gardener/scaling-advisor/api/minkapi/typeinfo"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/ioutil"
+	"github.com/gardener/scaling-advisor/common/objutil"
+	"github.com/gardener/scaling-advisor/common/podutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+)
+
+var _ plannerapi.ScaleInSimulation = (*defaultSimulation)(nil)
+
+// defaultSimulation is the default implementation of a ScaleInSimulation.
+type defaultSimulation struct {
+	args   *plannerapi.ScaleInSimArgs
+	result plannerapi.ScaleInSimResult
+	state  runState
+}
+
+// runState holds internal run state details of parent ScaleInSimulation.
+type runState struct {
+	err                       error
+	evictedPods               map[commontypes.NamespacedName]plannerapi.PodResourceInfo // map of evicted pod namespacedName to PodResourceInfo
+	leftoverEvictedPodNames   sets.Set[commontypes.NamespacedName]                      // set of evicted pod names that are not yet re-placed
+	status                    plannerapi.ActivityStatus
+	numUnchangedTrackAttempts int
+	numTrackAttempts          int
+}
+
+// NewDefault creates a new ScaleInSimulation instance with the specified name and using the given arguments after validation.
+func NewDefault(args plannerapi.ScaleInSimArgs) (plannerapi.ScaleInSimulation, error) {
+	if err := validateSimArgs(&args); err != nil {
+		return nil, fmt.Errorf("%w: %w", plannerapi.ErrCreateSimulation, err)
+	}
+	return &defaultSimulation{
+		args:  &args,
+		state: makeRunState(),
+	}, nil
+}
+
+func makeRunState() runState {
+	return runState{
+		status:                  plannerapi.ActivityStatusPending,
+		evictedPods:             make(map[commontypes.NamespacedName]plannerapi.PodResourceInfo),
+		leftoverEvictedPodNames: sets.New[commontypes.NamespacedName](),
+	}
+}
+
+func (s *defaultSimulation) Reset() error {
+	s.state = makeRunState()
+	return nil
+}
+
+func (s *defaultSimulation) Name() string {
+	return s.args.Name
+}
+
+func (s *defaultSimulation) Status() plannerapi.ActivityStatus {
+	return s.state.status
+}
+
+func (s *defaultSimulation) Result() (result plannerapi.ScaleInSimResult, err error) {
+	switch s.state.status {
+	case plannerapi.ActivityStatusPending:
+		err = fmt.Errorf("simulation %q is still pending", s.args.Name)
+		return
+	case plannerapi.ActivityStatusRunning:
+		err = fmt.Errorf("simulation %q is still running", s.args.Name)
+		return
+	case plannerapi.ActivityStatusFailure:
+		err = s.state.err
+		return
+	}
+	result = s.result
+	return
+}
+
+// Run cordons the candidate nodes within the given view, evicts all pods bound to them except pods bound to the
+// lifecycle of the node and launches the kube-scheduler to re-place the evicted pods on the remaining nodes. The
+// simulation tracks the evicted pods until all of them are re-placed or the kube-scheduler has stabilized.
+//
+// NOTE: Candidate nodes are cordoned instead of deleted since a sandbox view cannot mask objects of its delegate view.
+// A cordoned node does not accept any further pods which is equivalent to its removal for the kube-scheduler.
+func (s *defaultSimulation) Run(ctx context.Context, view minkapi.View) (err error) {
+	ctx, span := traceutil.StartSpan(ctx, "SimulationRun", traceutil.AttrSimulation.String(s.args.Name))
+	defer func() {
+		numLeftover := s.state.leftoverEvictedPodNames.Len()
+		span.SetAttributes(traceutil.AttrPodsScheduled.Int(len(s.state.evictedPods)-numLeftover), traceutil.AttrPodsUnscheduled.Int(numLeftover))
+		traceutil.EndSpan(span, err)
+	}()
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("%w: cannot run %q, runNum %d: %w", plannerapi.ErrRunSimulation, s.args.Name, s.args.RunCounter.Load(), err)
+			s.state.err = err
+			s.state.status = plannerapi.ActivityStatusFailure
+		}
+	}()
+	runNum := s.args.RunCounter.Add(1)
+	span.SetAttributes(traceutil.AttrRunNum.Int64(int64(runNum)))
+	log := logr.FromContextOrDiscard(ctx).WithValues("simulationName", s.args.Name, "runNum", runNum)
+	ctx = logr.NewContext(ctx, log)
+	s.state.status = plannerapi.ActivityStatusRunning
+
+	for _, nodeName := range s.args.CandidateNodeNames {
+		if err = s.cordonNode(ctx, view, nodeName); err != nil {
+			return
+		}
+	}
+	if err = s.evictPods(ctx, view); err != nil {
+		return
+	}
+	if len(s.state.evictedPods) > 0 {
+		var schedulerHandle plannerapi.SchedulerHandle
+		schedulerHandle, err = s.launchSchedulerForSimulation(ctx, view)
+		if err != nil {
+			return
+		}
+		defer ioutil.CloseQuietly(schedulerHandle)
+		if err = s.trackUntilStabilized(ctx, view, schedulerHandle); err != nil {
+			return
+		}
+	}
+	nodePodAssignments, err := s.getEvictedPodNodeAssignments(ctx, view)
+	if err != nil {
+		return
+	}
+	s.result = plannerapi.ScaleInSimResult{
+		Name:                    s.args.Name,
+		View:                    view,
+		RemovedNodeNames:        slices.Clone(s.args.CandidateNodeNames),
+		NodePodAssignments:      nodePodAssignments,
+		LeftoverUnscheduledPods: s.state.leftoverEvictedPodNames.UnsortedList(),
+	}
+	s.state.status = plannerapi.ActivityStatusSuccess
+	if len(s.result.LeftoverUnscheduledPods) > 0 {
+		log.V(3).Info("LeftoverUnscheduledPods after run", "leftoverUnscheduledPodCount", len(s.result.LeftoverUnscheduledPods))
+	}
+	return
+}
+
+func (s *defaultSimulation) cordonNode(ctx context.Context, view minkapi.View, nodeName string) error {
+	obj, err := view.GetObject(ctx, typeinfo.NodesDescriptor.GVK, cache.NewObjectName("", nodeName))
+	if err != nil {
+		return err
+	}
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return fmt.Errorf("object %T and name %q is not a Node", obj, nodeName)
+	}
+	node = node.DeepCopy()
+	node.Spec.Unschedulable = true
+	if err = view.UpdateObject(ctx, typeinfo.NodesDescriptor.GVK, node); err != nil {
+		return err
+	}
+	logr.FromContextOrDiscard(ctx).V(3).Info("cordoned candidate node", "nodeName", nodeName)
+	return nil
+}
+
+// evictPods unbinds all pods bound to the candidate nodes within the given view, except those bound to the lifecycle of
+// the node, and records them as evicted pods of this simulation run.
+func (s *defaultSimulation) evictPods(ctx context.Context, view minkapi.View) error {
+	log := logr.FromContextOrDiscard(ctx)
+	candidateNodeNames := sets.New(s.args.CandidateNodeNames...)
+	pods, err := view.ListPods(ctx, minkapi.MatchAllCriteria)
+	if err != nil {
+		return err
+	}
+	for _, p := range pods {
+		if !candidateNodeNames.Has(p.Spec.NodeName) || podutil.IsNodeBoundPod(&p) {
+			continue
+		}
+		evictedPod := p.DeepCopy()
+		evictedPod.Spec.NodeName = ""
+		evictedPod.Status = corev1.PodStatus{Phase: corev1.PodPending}
+		if err = view.UpdateObject(ctx, typeinfo.PodsDescriptor.GVK, evictedPod); err != nil {
+			return err
+		}
+		podNsName := objutil.NamespacedName(evictedPod)
+		s.state.evictedPods[podNsName] = podutil.PodResourceInfoFromCoreV1Pod(evictedPod)
+		s.state.leftoverEvictedPodNames.Insert(podNsName)
+		log.V(4).Info("evicted pod from candidate node", "podNamespacedName", podNsName, "nodeName", p.Spec.NodeName)
+	}
+	log.V(3).Info("evicted pods from candidate nodes", "numEvictedPods", len(s.state.evictedPods))
+	return nil
+}
+
+// trackUntilStabilized starts a loop which tracks the re-placement of evicted pods until one of the following
+// conditions is met:
+//  1. All the evicted pods are re-placed.
+//  2. The kube-scheduler of the given schedulerHandle is idle and no events were received within the last round.
+//  3. Events have stabilized. i.e., no more scheduling events within maxUnchangedTrackAttempts
+//  4. Context timeout.
+//  5. Any error
+//
+// Each round waits for a notification of the event sink of the view or at most TrackPollInterval.
+func (s *defaultSimulation) trackUntilStabilized(ctx context.Context, view minkapi.View, schedulerHandle plannerapi.SchedulerHandle) error {
+	log := logr.FromContextOrDiscard(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-view.GetEventSink().Notify():
+		case <-time.After(s.args.Config.TrackPollInterval):
+		}
+		s.state.numTrackAttempts++
+		evList := view.GetEventSink().List()
+		if len(evList) == 0 {
+			s.state.numUnchangedTrackAttempts++
+		} else {
+			s.state.numUnchangedTrackAttempts = 0
+			if err := view.GetEventSink().Reset(); err != nil {
+				return err
+			}
+		}
+		if err := s.updateLeftoverEvictedPodNames(ctx, view); err != nil {
+			return err
+		}
+		if len(s.state.leftoverEvictedPodNames) == 0 {
+			log.V(2).Info("ending simulation run since all evicted pods have been re-placed", "numTrackAttempts", s.state.numTrackAttempts)
+			return nil
+		}
+		if len(evList) == 0 && schedulerHandle.IsIdle() {
+			log.V(3).Info("simulation run stabilized - kube-scheduler is idle",
+				"numTrackAttempts", s.state.numTrackAttempts,
+				"leftoverEvictedPodCount", len(s.state.leftoverEvictedPodNames))
+			return nil
+		}
+		if s.state.numUnchangedTrackAttempts > s.args.Config.MaxUnchangedTrackAttempts {
+			log.V(3).Info("simulation run stabilized - no new kube-scheduler events observed",
+				"numTrackAttempts", s.state.numTrackAttempts,
+				"leftoverEvictedPodCount", len(s.state.leftoverEvictedPodNames))
+			return nil
+		}
+	}
+}
+
+func (s *defaultSimulation) updateLeftoverEvictedPodNames(ctx context.Context, view minkapi.View) error {
+	for podNsName := range s.state.leftoverEvictedPodNames {
+		pod, err := getPod(ctx, view, podNsName)
+		if err != nil {
+			return err
+		}
+		if !podutil.IsUnscheduledPod(pod) {
+			s.state.leftoverEvictedPodNames.Delete(podNsName)
+		}
+	}
+	return nil
+}
+
+// getEvictedPodNodeAssignments gets the slice of [plannerapi.NodePodAssignment] of evicted pods to the nodes they were
+// re-placed on.
+func (s *defaultSimulation) getEvictedPodNodeAssignments(ctx context.Context, view minkapi.View) ([]plannerapi.NodePodAssignment, error) {
+	podInfosByNodeName := make(map[string][]plannerapi.PodResourceInfo)
+	for podNsName, podInfo := range s.state.evictedPods {
+		if s.state.leftoverEvictedPodNames.Has(podNsName) {
+			continue
+		}
+		pod, err := getPod(ctx, view, podNsName)
+		if err != nil {
+			return nil, err
+		}
+		podInfosByNodeName[pod.Spec.NodeName] = append(podInfosByNodeName[pod.Spec.NodeName], podInfo)
+	}
+	if len(podInfosByNodeName) == 0 {
+		return nil, nil
+	}
+	nodes, err := view.ListNodes(ctx, slices.Sorted(maps.Keys(podInfosByNodeName))...)
+	if err != nil {
+		return nil, err
+	}
+	assignments := make([]plannerapi.NodePodAssignment, 0, len(nodes))
+	for _, node := range nodes {
+		assignments = append(assignments, plannerapi.NodePodAssignment{
+			NodeResources: plannerapi.NodeResourceInfo{
+				Name:         node.Name,
+				InstanceType: node.Labels[corev1.LabelInstanceTypeStable],
+				Capacity:     node.Status.Capacity,
+				Allocatable:  node.Status.Allocatable,
+			},
+			ScheduledPods: podInfosByNodeName[node.Name],
+		})
+	}
+	return assignments, nil
+}
+
+func (s *defaultSimulation) launchSchedulerForSimulation(ctx context.Context, simView minkapi.View) (plannerapi.SchedulerHandle, error) {
+	clientFacades, err := simView.GetClientFacades(ctx, commontypes.ClientAccessModeInMemory)
+	if err != nil {
+		return nil, err
+	}
+	schedLaunchParams := &plannerapi.SchedulerLaunchParams{
+		ClientFacades: clientFacades,
+		EventSink:     simView.GetEventSink(),
+		Profile:       s.args.SchedulerProfile,
+	}
+	return s.args.SchedulerLauncher.Launch(ctx, schedLaunchParams)
+}
+
+func getPod(ctx context.Context, view minkapi.View, podNsName commontypes.NamespacedName) (*corev1.Pod, error) {
+	obj, err := view.GetObject(ctx, typeinfo.PodsDescriptor.GVK, podNsName.AsObjectName())
+	if err != nil {
+		return nil, err
+	}
+	pod, ok := obj.(*corev1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("object %T and name %q is not a Pod", obj, podNsName)
+	}
+	return pod, nil
+}
+
+func validateSimArgs(args *plannerapi.ScaleInSimArgs) error {
+	if len(args.CandidateNodeNames) == 0 {
+		return fmt.Errorf("no candidate node names specified for simulation %q", args.Name)
+	}
+	if args.Config.TrackPollInterval <= 0 {
+		return fmt.Errorf("track poll interval must be positive duration for simulation %q", args.Name)
+	}
+	if args.Config.MaxUnchangedTrackAttempts <= 0 {
+		return fmt.Errorf("max unchanged track attempts must be positive for simulation %q", args.Name)
+	}
+	if args.SchedulerLauncher == nil {
+		return fmt.Errorf("scheduler launcher must not be nil for simulation %q", args.Name)
+	}
+	if args.RunCounter == nil {
+		return fmt.Errorf("run counter must not be nil for simulation %q", args.Name)
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scalein
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	"github.com/gardener/scaling-advisor/api/minkapi/typeinfo"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/nodeutil"
+	"github.com/gardener/scaling-advisor/common/podutil"
+	commontestutil "github.com/gardener/scaling-advisor/common/testutil"
+	"github.com/gardener/scaling-advisor/minkapi/view"
+	"github.com/gardener/scaling-advisor/planner/scheduler"
+	"github.com/gardener/scaling-advisor/samples"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/ptr"
+)
+
+func TestRun(t *testing.T) {
+	tests := map[string]struct {
+		nodes               []*corev1.Node
+		pods                []*corev1.Pod
+		expectedLeftover    []commontypes.NamespacedName
+		expectedAssignments map[string][]string
+		expectedNodeNames   map[string]string
+	}{
+		"evicted pod re-placed on remaining node": {
+			nodes: []*corev1.Node{newNode("candidate", "2"), newNode("remaining", "2")},
+			pods:  []*corev1.Pod{newPod("p1", "candidate", "1"), newPod("p2", "remaining", "500m")},
+			expectedAssignments: map[string][]string{
+				"remaining": {"p1"},
+			},
+			expectedNodeNames: map[string]string{"p1": "remaining", "p2": "remaining"},
+		},
+		"evicted pod not re-placed on remaining node": {
+			nodes:             []*corev1.Node{newNode("candidate", "2"), newNode("remaining", "2")},
+			pods:              []*corev1.Pod{newPod("p1", "candidate", "1500m"), newPod("p2", "remaining", "1")},
+			expectedLeftover:  []commontypes.NamespacedName{{Namespace: metav1.NamespaceDefault, Name: "p1"}},
+			expectedNodeNames: map[string]string{"p1": "", "p2": "remaining"},
+		},
+		"node bound pods not evicted": {
+			nodes: []*corev1.Node{newNode("candidate", "2"), newNode("remaining", "2")},
+			pods: []*corev1.Pod{
+				newPod("p1", "candidate", "500m"),
+				asDaemonSetPod(newPod("daemon", "candidate", "100m")),
+				asMirrorPod(newPod("mirror", "candidate", "100m")),
+			},
+			expectedAssignments: map[string][]string{
+				"remaining": {"p1"},
+			},
+			expectedNodeNames: map[string]string{"p1": "remaining", "daemon": "candidate", "mirror": "candidate"},
+		},
+		"only node bound pods": {
+			nodes:             []*corev1.Node{newNode("candidate", "2"), newNode("remaining", "2")},
+			pods:              []*corev1.Pod{asDaemonSetPod(newPod("daemon", "candidate", "100m"))},
+			expectedNodeNames: map[string]string{"daemon": "candidate"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := commontestutil.NewTestContext(t, 30*time.Second, 0)
+			simView := createView(ctx, t, tc.nodes, tc.pods)
+			sim, err := NewDefault(plannerapi.ScaleInSimArgs{
+				SchedulerLauncher:  createSchedulerLauncher(t),
+				RunCounter:         &atomic.Uint32{},
+				Name:               "test",
+				CandidateNodeNames: []string{"candidate"},
+				Config: plannerapi.SimulatorConfig{
+					TrackPollInterval:         plannerapi.DefaultTrackPollInterval,
+					MaxUnchangedTrackAttempts: plannerapi.DefaultMaxUnchangedTrackAttempts,
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to create simulation: %v", err)
+			}
+			if err = sim.Run(ctx, simView); err != nil {
+				t.Fatalf("failed to run simulation: %v", err)
+			}
+			result, err := sim.Result()
+			if err != nil {
+				t.Fatalf("failed to get simulation result: %v", err)
+			}
+			if diff := cmp.Diff([]string{"candidate"}, result.RemovedNodeNames); diff != "" {
+				t.Errorf("RemovedNodeNames mismatch (-want +got):\n%s", diff)
+			}
+			expectedLeftover := tc.expectedLeftover
+			if expectedLeftover == nil {
+				expectedLeftover = []commontypes.NamespacedName{}
+			}
+			if diff := cmp.Diff(expectedLeftover, result.LeftoverUnscheduledPods); diff != "" {
+				t.Errorf("LeftoverUnscheduledPods mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedAssignments, getAssignedPodNames(result.NodePodAssignments)); diff != "" {
+				t.Errorf("NodePodAssignments mismatch (-want +got):\n%s", diff)
+			}
+			obj, err := simView.GetObject(ctx, typeinfo.NodesDescriptor.GVK, cache.NewObjectName("", "candidate"))
+			if err != nil {
+				t.Fatalf("failed to get candidate node: %v", err)
+			}
+			if !obj.(*corev1.Node).Spec.Unschedulable {
+				t.Errorf("want candidate node to be cordoned")
+			}
+			pods, err := simView.ListPods(ctx, minkapi.MatchAllCriteria)
+			if err != nil {
+				t.Fatalf("failed to list pods: %v", err)
+			}
+			gotNodeNames := make(map[string]string, len(pods))
+			for _, p := range pods {
+				gotNodeNames[p.Name] = p.Spec.NodeName
+			}
+			if diff := cmp.Diff(tc.expectedNodeNames, gotNodeNames); diff != "" {
+				t.Errorf("pod node names mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateSimArgs(t *testing.T) {
+	validArgs := func() plannerapi.ScaleInSimArgs {
+		return plannerapi.ScaleInSimArgs{
+			SchedulerLauncher:  createSchedulerLauncher(t),
+			RunCounter:         &atomic.Uint32{},
+			Name:               "test",
+			CandidateNodeNames: []string{"candidate"},
+			Config: plannerapi.SimulatorConfig{
+				TrackPollInterval:         plannerapi.DefaultTrackPollInterval,
+				MaxUnchangedTrackAttempts: plannerapi.DefaultMaxUnchangedTrackAttempts,
+			},
+		}
+	}
+	tests := map[string]struct {
+		mutate    func(args *plannerapi.ScaleInSimArgs)
+		expectErr bool
+	}{
+		"valid args":                   {mutate: func(*plannerapi.ScaleInSimArgs) {}},
+		"no candidate node names":      {mutate: func(a *plannerapi.ScaleInSimArgs) { a.CandidateNodeNames = nil }, expectErr: true},
+		"no track poll interval":       {mutate: func(a *plannerapi.ScaleInSimArgs) { a.Config.TrackPollInterval = 0 }, expectErr: true},
+		"no max unchanged attempts":    {mutate: func(a *plannerapi.ScaleInSimArgs) { a.Config.MaxUnchangedTrackAttempts = 0 }, expectErr: true},
+		"no scheduler launcher":        {mutate: func(a *plannerapi.ScaleInSimArgs) { a.SchedulerLauncher = nil }, expectErr: true},
+		"no run counter":               {mutate: func(a *plannerapi.ScaleInSimArgs) { a.RunCounter = nil }, expectErr: true},
+		"negative track poll interval": {mutate: func(a *plannerapi.ScaleInSimArgs) { a.Config.TrackPollInterval = -time.Second }, expectErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			args := validArgs()
+			tc.mutate(&args)
+			if err := validateSimArgs(&args); (err != nil) != tc.expectErr {
+				t.Errorf("got error %v, want error %t", err, tc.expectErr)
+			}
+		})
+	}
+}
+
+func createView(ctx context.Context, t *testing.T, nodes []*corev1.Node, pods []*corev1.Pod) minkapi.View {
+	t.Helper()
+	viewAccess, err := view.NewAccess(ctx, &minkapi.ViewArgs{
+		Name:   minkapi.DefaultBasePrefix,
+		Scheme: typeinfo.SupportedScheme,
+		WatchConfig: minkapi.WatchConfig{
+			QueueSize: minkapi.DefaultWatchQueueSize,
+			Timeout:   minkapi.DefaultWatchTimeout,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create ViewAccess: %v", err)
+	}
+	simView, err := viewAccess.GetSandboxViewOverDelegate(ctx, "test", viewAccess.GetBaseView())
+	if err != nil {
+		t.Fatalf("failed to create sandbox view: %v", err)
+	}
+	t.Cleanup(func() { _ = simView.Close() })
+	for _, n := range nodes {
+		if _, err = simView.CreateObject(ctx, typeinfo.NodesDescriptor.GVK, n); err != nil {
+			t.Fatalf("failed to create node %q: %v", n.Name, err)
+		}
+	}
+	for _, p := range pods {
+		if _, err = simView.CreateObject(ctx, typeinfo.PodsDescriptor.GVK, p); err != nil {
+			t.Fatalf("failed to create pod %q: %v", p.Name, err)
+		}
+	}
+	return simView
+}
+
+func createSchedulerLauncher(t *testing.T) plannerapi.SchedulerLauncher {
+	t.Helper()
+	configBytes, err := samples.LoadBinPackingSchedulerConfig()
+	if err != nil {
+		t.Fatalf("failed to load scheduler config: %v", err)
+	}
+	launcher, err := scheduler.NewLauncherFromConfig(configBytes, 1, nil)
+	if err != nil {
+		t.Fatalf("failed to create scheduler launcher: %v", err)
+	}
+	return launcher
+}
+
+func getAssignedPodNames(assignments []plannerapi.NodePodAssignment) map[string][]string {
+	if len(assignments) == 0 {
+		return nil
+	}
+	podNames := make(map[string][]string, len(assignments))
+	for _, a := range assignments {
+		for _, p := range a.ScheduledPods {
+			podNames[a.NodeResources.Name] = append(podNames[a.NodeResources.Name], p.Name)
+		}
+		slices.Sort(podNames[a.NodeResources.Name])
+	}
+	return podNames
+}
+
+func newNode(name, cpu string) *corev1.Node {
+	capacity := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu), corev1.ResourceMemory: resource.MustParse("8Gi")}
+	return nodeutil.AsNode(plannerapi.NodeInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{corev1.LabelHostname: name, corev1.LabelInstanceTypeStable: "m5.large"},
+		},
+		InstanceType: "m5.large",
+		Capacity:     capacity,
+		Allocatable:  nodeutil.BuildAllocatable(capacity, nil, nil),
+		Conditions:   nodeutil.BuildReadyConditions(time.Now()),
+	})
+}
+
+func newPod(name, nodeName, cpu string) *corev1.Pod {
+	return podutil.AsPod(plannerapi.PodInfo{
+		ObjectMeta:         metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+		NodeName:           nodeName,
+		SchedulerName:      "bin-packing-scheduler",
+		AggregatedRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+	})
+}
+
+func asDaemonSetPod(pod *corev1.Pod) *corev1.Pod {
+	pod.OwnerReferences = []metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "ds", UID: "ds", Controller: ptr.To(true)}}
+	return pod
+}
+
+func asMirrorPod(pod *corev1.Pod) *corev1.Pod {
+	pod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "mirror"}
+	return pod
+}
@@ -28,6 +28,7 @@ import (
 	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
 )
 
 // RunState holds internal run state details of parent ScaleOutSimulation.
@@ -37,9 +38,18 @@ type RunState struct {
 	view                        minkapi.View
 	scaleOutNodes               map[string]*corev1.Node                                   // map of node names to scale-out nodes
 	scaleOutPlacements          map[sacorev1alpha1.NodePlacement]int32                    // map of NodePlacement's to counts
+	scaleOutNodePlacements      map[string]sacorev1alpha1.NodePlacement                   // map of scale-out node names to their NodePlacement
+	poolCapacities              map[string]corev1.ResourceList                            // map of node pool names to the cumulative capacity of their nodes
+	poolNodeCounts              map[string]int32                                          // map of node pool names to the number of their nodes
+	templateNodeCounts          map[string]int32                                          // map of node pool and template names (see templateKey) to the number of their nodes
+	quotaExhaustedPlacements    sets.Set[sacorev1alpha1.NodePlacement]                    // set of NodePlacement's for which a further node would exceed the pool quota
+	maxNodesExhaustedPlacements sets.Set[sacorev1alpha1.NodePlacement]                    // set of NodePlacement's for which a further node would exceed the max nodes of the pool or template
 	unscheduledPods             map[commontypes.NamespacedName]plannerapi.PodResourceInfo // map of unscheduled Pod namespacedName to PodResourceInfo
 	scheduledPodNamesByNodeName map[string]sets.Set[commontypes.NamespacedName]           // map of node names to a set of scheduled pod names
 	leftoverUnscheduledPodNames sets.Set[commontypes.NamespacedName]                      // represents a set of pod names scheduled during simulation run
+	podSchedulingFailures       map[commontypes.NamespacedName]podSchedulingFailure       // map of pod namespacedName to its last FailedScheduling event
+	daemonPodNamesByNodeName    map[string][]commontypes.NamespacedName                   // map of scale-out node names to the names of their daemon pods
+	daemonPodOverheads          map[string]corev1.ResourceList                            // map of scale-out node names to the aggregated requests of their daemon pods
 	status                      plannerapi.ActivityStatus
 	name                        string
 	traceDir                    string
@@ -58,9 +68,24 @@ func MakeRunState() RunState {
 		scheduledPodNamesByNodeName: make(map[string]sets.Set[commontypes.NamespacedName]),
 		scaleOutNodes:               make(map[string]*corev1.Node),
 		scaleOutPlacements:          make(map[sacorev1alpha1.NodePlacement]int32),
+		scaleOutNodePlacements:      make(map[string]sacorev1alpha1.NodePlacement),
+		poolCapacities:              make(map[string]corev1.ResourceList),
+		poolNodeCounts:              make(map[string]int32),
+		templateNodeCounts:          make(map[string]int32),
+		quotaExhaustedPlacements:    sets.New[sacorev1alpha1.NodePlacement](),
+		maxNodesExhaustedPlacements: sets.New[sacorev1alpha1.NodePlacement](),
+		podSchedulingFailures:       make(map[commontypes.NamespacedName]podSchedulingFailure),
+		daemonPodNamesByNodeName:    make(map[string][]commontypes.NamespacedName),
+		daemonPodOverheads:          make(map[string]corev1.ResourceList),
 	}
 }
 
+// podSchedulingFailure holds the message and time of a FailedScheduling event of a pod.
+type podSchedulingFailure struct {
+	eventTime time.Time
+	message   string
+}
+
 // Init initializes this RunState from the given params, changes the [RunState]'s [plannerapi.ActivityStatus] to
 // [plannerapi.ActivityStatusRunning] and returns the child run context or an error. The view is also interrogated for
 // initializing unscheduledPods. This method must be invoked before calling other
@@ -78,21 +103,42 @@ func (r *RunState) Init(parentCtx context.Context, name string, runNum uint32, v
 	}
 	r.unscheduledPods = unscheduledPods
 	r.leftoverUnscheduledPodNames = sets.New(slices.Collect(maps.Keys(unscheduledPods))...)
+	if err = r.initPoolUsage(); err != nil {
+		return r.ctx, fmt.Errorf("unable to compute node pool capacities from view %q: %w", view.GetName(), err)
+	}
 	return r.ctx, nil
 }
 
 // CreateSimulationNodes creates one or more scale-out simulation node(s) and associated CSI node(s)
-// according to the given [plannerapi.ScaleOutNodeTemplate](s).
-func (r *RunState) CreateSimulationNodes(storageMetaAccess plannerapi.StorageMetaAccess, nodeTemplates []plannerapi.ScaleOutNodeTemplate) error {
+// according to the given [plannerapi.ScaleOutNodeTemplate](s). A node is not created for a template if the
+// cumulative capacity of the nodes of its pool together with the template capacity would exceed the pool quota, or if
+// the node would exceed the maximum node count of its pool or template. The placement of such a template is recorded
+// as quota exhausted or max nodes exhausted respectively. A daemon pod is created on each node for each of the given daemonSetPods that can run on it.
+func (r *RunState) CreateSimulationNodes(storageMetaAccess plannerapi.StorageMetaAccess, daemonSetPods []plannerapi.PodInfo, nodeTemplates []plannerapi.ScaleOutNodeTemplate) error {
 	log := logr.FromContextOrDiscard(r.ctx)
 	numCreated := 0
 	for _, nodeTemplate := range nodeTemplates {
-		scaleOutSimNode, err := r.createNode(nodeTemplate)
+		if r.isExhausted(nodeTemplate.NodePlacement) {
+			continue
+		}
+		if objutil.ExceedsResources(r.poolCapacities[nodeTemplate.PoolName], nodeTemplate.Capacity, nodeTemplate.Quota) {
+			log.V(2).Info("skipping creation of ScaleOutSimNode since it would exceed pool quota",
+				"nodePlacement", nodeTemplate.NodePlacement, "quota", nodeTemplate.Quota, "poolCapacity", r.poolCapacities[nodeTemplate.PoolName])
+			r.quotaExhaustedPlacements.Insert(nodeTemplate.NodePlacement)
+			continue
+		}
+		if r.exceedsMaxNodes(nodeTemplate) {
+			log.V(2).Info("skipping creation of ScaleOutSimNode since it would exceed max nodes",
+				"nodePlacement", nodeTemplate.NodePlacement, "poolMaxNodes", nodeTemplate.PoolMaxNodes, "maxNodes", nodeTemplate.MaxNodes)
+			r.maxNodesExhaustedPlacements.Insert(nodeTemplate.NodePlacement)
+			continue
+		}
+		scaleOutSimNode, err := r.createNode(nodeTemplate, daemonSetPods)
 		if err != nil {
 			return err
 		}
 		numCreated++
-		if err = r.createCSINode(storageMetaAccess, scaleOutSimNode); err != nil {
+		if err = r.createCSINode(storageMetaAccess, scaleOutSimNode, nodeTemplate.MaxVolumes); err != nil {
 			return err
 		}
 	}
@@ -100,6 +146,62 @@ func (r *RunState) CreateSimulationNodes(storageMetaAccess plannerapi.StorageMet
 	return nil
 }
 
+// GetSaturatedNodeTemplates returns the subset of the given [plannerapi.ScaleOutNodeTemplate](s) for which every
+// scale-out node created so far has been assigned at least one pod by the kube-scheduler. A fresh scale-out node for
+// a saturated template gives the kube-scheduler a further placement option for leftover unscheduled pods. Templates
+// whose placement is quota exhausted or max nodes exhausted are never considered saturated.
+func (r *RunState) GetSaturatedNodeTemplates(nodeTemplates []plannerapi.ScaleOutNodeTemplate) []plannerapi.ScaleOutNodeTemplate {
+	unsaturatedPlacements := sets.New[sacorev1alpha1.NodePlacement]()
+	for name, placement := range r.scaleOutNodePlacements {
+		if r.scheduledPodNamesByNodeName[name].Len() == 0 {
+			unsaturatedPlacements.Insert(placement)
+		}
+	}
+	saturated := make([]plannerapi.ScaleOutNodeTemplate, 0, len(nodeTemplates))
+	for _, t := range nodeTemplates {
+		if !unsaturatedPlacements.Has(t.NodePlacement) && !r.isExhausted(t.NodePlacement) {
+			saturated = append(saturated, t)
+		}
+	}
+	return saturated
+}
+
+// DeleteUnusedSimulationNodes deletes the scale-out simulation node(s) and associated CSI node(s) and daemon pods that
+// have not been assigned any pod by the kube-scheduler and removes them from the tracked scale-out placements. It
+// returns the number of deleted nodes or an error.
+func (r *RunState) DeleteUnusedSimulationNodes() (numDeleted int, err error) {
+	log := logr.FromContextOrDiscard(r.ctx)
+	for name := range r.scaleOutNodes {
+		if r.scheduledPodNamesByNodeName[name].Len() > 0 {
+			continue
+		}
+		for _, podName := range r.daemonPodNamesByNodeName[name] {
+			if err = r.view.DeleteObject(r.ctx, typeinfo.PodsDescriptor.GVK, podName.AsObjectName()); err != nil {
+				return
+			}
+		}
+		objName := cache.NewObjectName("", name)
+		if err = r.view.DeleteObject(r.ctx, typeinfo.CSINodeDescriptor.GVK, objName); err != nil {
+			return
+		}
+		if err = r.view.DeleteObject(r.ctx, typeinfo.NodesDescriptor.GVK, objName); err != nil {
+			return
+		}
+		placement := r.scaleOutNodePlacements[name]
+		r.scaleOutPlacements[placement]--
+		if r.scaleOutPlacements[placement] <= 0 {
+			delete(r.scaleOutPlacements, placement)
+		}
+		delete(r.scaleOutNodePlacements, name)
+		delete(r.scaleOutNodes, name)
+		delete(r.daemonPodNamesByNodeName, name)
+		delete(r.daemonPodOverheads, name)
+		numDeleted++
+	}
+	log.V(2).Info("DeleteUnusedSimulationNodes deleted ScaleOutSimNode(s)", "numDeleted", numDeleted)
+	return
+}
+
 // Track is used to track the RunState of the simulation by recording the pod-node binding(s) if any made in this
 // [RunState]'s view by the `kube-scheduler`. It returns true if the RunState has not changed over many Track
 // attempts that exceed the given maxUnchangedTrackAttempts or an error.
@@ -152,6 +254,7 @@ func (r *RunState) Track(maxUnchangedTrackAttempts int) (stabilized bool, err er
 				log.V(4).Info("FailedScheduling event", "index", idx, "id", ev.UID,
 					"ReportingController", ev.ReportingController, "ReportingInstance", ev.ReportingInstance,
 					"Action", ev.Action, "Reason", ev.Reason, "Regarding", ev.Regarding, "Note", ev.Note)
+				r.recordPodSchedulingFailure(ev, eventTime.Time)
 			}
 			continue
 		}
@@ -176,8 +279,14 @@ func (r *RunState) GetScaleOutItems() []sacorev1alpha1.ScaleOutItem {
 	return scaleOutItems
 }
 
-func (r *RunState) createNode(nodeTemplate plannerapi.ScaleOutNodeTemplate) (*corev1.Node, error) {
+// createNode creates a scale-out simulation node for the given nodeTemplate along with its daemon pods for the given
+// daemonSetPods. The daemon pods are created before the node so that the kube-scheduler accounts for them as soon as it
+// observes the node.
+func (r *RunState) createNode(nodeTemplate plannerapi.ScaleOutNodeTemplate, daemonSetPods []plannerapi.PodInfo) (*corev1.Node, error) {
 	node := r.buildScaleOutSimNode(nodeTemplate)
+	if err := r.createDaemonPods(node, daemonSetPods); err != nil {
+		return nil, err
+	}
 	simNodeRuntimeObj, err := r.view.CreateObject(r.ctx, typeinfo.NodesDescriptor.GVK, node)
 	if err != nil {
 		return nil, err
@@ -198,16 +307,100 @@ func (r *RunState) createNode(nodeTemplate plannerapi.ScaleOutNodeTemplate) (*co
 	}
 	r.scaleOutNodes[node.Name] = node
 	r.scaleOutPlacements[nodeTemplate.NodePlacement]++
+	r.scaleOutNodePlacements[node.Name] = nodeTemplate.NodePlacement
+	r.addPoolUsage(nodeTemplate.PoolName, nodeTemplate.TemplateName, node.Status.Capacity)
 	return node, nil
 }
 
-func (r *RunState) createCSINode(storageMetaAccess plannerapi.StorageMetaAccess, scaleOutSimNode *corev1.Node) error {
+// createDaemonPods creates a daemon pod bound to the given scale-out node for each of the given daemonSetPods that can
+// run on the node and records the names and the aggregated requests of the created daemon pods.
+func (r *RunState) createDaemonPods(node *corev1.Node, daemonSetPods []plannerapi.PodInfo) error {
+	log := logr.FromContextOrDiscard(r.ctx)
+	overhead := make(corev1.ResourceList)
+	for _, template := range daemonSetPods {
+		pod := podutil.AsDaemonPod(template, node.Name)
+		canRun, err := podutil.CanRunDaemonPodOnNode(pod, node)
+		if err != nil {
+			return fmt.Errorf("cannot check whether daemon pod of DaemonSet %q can run on ScaleOutSimNode %q: %w", template.Name, node.Name, err)
+		}
+		if !canRun {
+			continue
+		}
+		if _, err = r.view.CreateObject(r.ctx, typeinfo.PodsDescriptor.GVK, pod); err != nil {
+			return fmt.Errorf("cannot create daemon pod of DaemonSet %q for ScaleOutSimNode %q: %w", template.Name, node.Name, err)
+		}
+		r.daemonPodNamesByNodeName[node.Name] = append(r.daemonPodNamesByNodeName[node.Name], objutil.NamespacedName(pod))
+		objutil.AddResources(overhead, template.AggregatedRequests)
+		objutil.AddResources(overhead, template.Overhead)
+	}
+	if len(r.daemonPodNamesByNodeName[node.Name]) > 0 {
+		r.daemonPodOverheads[node.Name] = overhead
+		log.V(3).Info("created daemon pods for ScaleOutSimNode", "scaleOutSimNodeName", node.Name,
+			"numDaemonPods", len(r.daemonPodNamesByNodeName[node.Name]), "overhead", overhead)
+	}
+	return nil
+}
+
+// initPoolUsage initializes the cumulative capacity and node counts of each node pool and node template from the nodes
+// present in the view. These include existing nodes of the cluster snapshot and scale-out nodes of previous winning
+// simulation runs.
+func (r *RunState) initPoolUsage() error {
+	nodes, err := r.view.ListNodes(r.ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		poolName, ok := n.Labels[commonconstants.LabelNodePoolName]
+		if !ok {
+			continue
+		}
+		r.addPoolUsage(poolName, n.Labels[commonconstants.LabelNodeTemplateName], n.Status.Capacity)
+	}
+	return nil
+}
+
+func (r *RunState) addPoolUsage(poolName, templateName string, capacity corev1.ResourceList) {
+	poolCapacity, ok := r.poolCapacities[poolName]
+	if !ok {
+		poolCapacity = make(corev1.ResourceList, len(capacity))
+		r.poolCapacities[poolName] = poolCapacity
+	}
+	objutil.AddResources(poolCapacity, capacity)
+	r.poolNodeCounts[poolName]++
+	r.templateNodeCounts[templateKey(poolName, templateName)]++
+}
+
+// exceedsMaxNodes checks whether a further node for the given nodeTemplate would exceed the maximum node count of its
+// pool or template.
+func (r *RunState) exceedsMaxNodes(nodeTemplate plannerapi.ScaleOutNodeTemplate) bool {
+	if nodeTemplate.PoolMaxNodes != nil && r.poolNodeCounts[nodeTemplate.PoolName] >= *nodeTemplate.PoolMaxNodes {
+		return true
+	}
+	return nodeTemplate.MaxNodes != nil && r.templateNodeCounts[templateKey(nodeTemplate.PoolName, nodeTemplate.TemplateName)] >= *nodeTemplate.MaxNodes
+}
+
+// isExhausted checks whether no further node can be created for the given placement since it is quota exhausted or max
+// nodes exhausted.
+func (r *RunState) isExhausted(placement sacorev1alpha1.NodePlacement) bool {
+	return r.quotaExhaustedPlacements.Has(placement) || r.maxNodesExhaustedPlacements.Has(placement)
+}
+
+func templateKey(poolName, templateName string) string {
+	return poolName + "/" + templateName
+}
+
+// createCSINode creates the CSINode for the given scaleOutSimNode from the CSINodeSpec of the given storageMetaAccess
+// for its instance type. The number of allocatable volumes of its drivers is overridden by maxVolumes if positive.
+func (r *RunState) createCSINode(storageMetaAccess plannerapi.StorageMetaAccess, scaleOutSimNode *corev1.Node, maxVolumes int32) error {
 	csiNodeSpec, err := storageMetaAccess.GetFallbackCSINodeSpec(scaleOutSimNode.Labels[corev1.LabelInstanceTypeStable])
 	if err != nil {
 		return err
 	}
 	for i := range csiNodeSpec.Drivers {
 		csiNodeSpec.Drivers[i].NodeID = scaleOutSimNode.Name
+		if maxVolumes > 0 {
+			csiNodeSpec.Drivers[i].Allocatable = &storagev1.VolumeNodeResources{Count: &maxVolumes}
+		}
 	}
 	csiNode := nodeutil.NewCSINode(scaleOutSimNode.Name, scaleOutSimNode.UID, csiNodeSpec)
 	runtimeObj, err := r.view.CreateObject(r.ctx, typeinfo.CSINodeDescriptor.GVK, csiNode)
@@ -260,6 +453,28 @@ func (r *RunState) getScheduledPodInfosForNode(nodeName string) []plannerapi.Pod
 	return scheduledPodInfos
 }
 
+// recordPodSchedulingFailure records the note of the given FailedScheduling event for the regarded pod unless a later
+// event has already been recorded for it.
+func (r *RunState) recordPodSchedulingFailure(ev eventsv1.Event, eventTime time.Time) {
+	podNsName := objutil.NamespacedNameFromEventRegarding(ev)
+	if last, ok := r.podSchedulingFailures[podNsName]; ok && last.eventTime.After(eventTime) {
+		return
+	}
+	r.podSchedulingFailures[podNsName] = podSchedulingFailure{eventTime: eventTime, message: ev.Note}
+}
+
+// getLeftoverPodSchedulingFailures returns the messages of the last FailedScheduling events of the leftover
+// unscheduled pods.
+func (r *RunState) getLeftoverPodSchedulingFailures() map[commontypes.NamespacedName]string {
+	failures := make(map[commontypes.NamespacedName]string)
+	for podNsName, failure := range r.podSchedulingFailures {
+		if r.leftoverUnscheduledPodNames.Has(podNsName) {
+			failures[podNsName] = failure.message
+		}
+	}
+	return failures
+}
+
 func (r *RunState) handleScheduledPodEvent(ev eventsv1.Event) error {
 	log := logr.FromContextOrDiscard(r.ctx)
 	podNsName := objutil.NamespacedNameFromEventRegarding(ev)
@@ -307,6 +522,7 @@ func (r *RunState) getScaleOutNodeAssignments() (scaleOutAssignments []plannerap
 		scheduledPodInfos := r.getScheduledPodInfosForNode(name)
 		if len(scheduledPodInfos) > 0 {
 			nodeResources := getNodeResourceInfo(node)
+			nodeResources.Overhead = r.daemonPodOverheads[name]
 			scaleOutAssignments = append(scaleOutAssignments, plannerapi.NodePodAssignment{
 				NodeResources: nodeResources,
 				ScheduledPods: scheduledPodInfos,
@@ -15,6 +15,7 @@ import (
 	"github.com/gardener/scaling-advisor/api/minkapi"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 	"github.com/gardener/scaling-advisor/common/ioutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
 	"github.com/gardener/scaling-advisor/common/viewutil"
 	"github.com/gardener/scaling-advisor/common/volutil"
 	"github.com/go-logr/logr"
@@ -51,6 +52,10 @@ func (s *defaultSimulation) PriorityKey() commontypes.PriorityKey {
 	return s.args.NodeTemplates[0].PriorityKey
 }
 
+func (s *defaultSimulation) NodeTemplates() []plannerapi.ScaleOutNodeTemplate {
+	return s.args.NodeTemplates
+}
+
 func (s *defaultSimulation) Name() string {
 	return s.args.Name
 }
@@ -76,6 +81,17 @@ func (s *defaultSimulation) Result() (result plannerapi.ScaleOutSimResult, err e
 }
 
 func (s *defaultSimulation) Run(ctx context.Context, view minkapi.View) (err error) {
+	placements := make([]string, 0, len(s.args.NodeTemplates))
+	for _, nt := range s.args.NodeTemplates {
+		placements = append(placements, traceutil.FormatPlacement(nt.NodePlacement))
+	}
+	ctx, span := traceutil.StartSpan(ctx, "SimulationRun", traceutil.AttrSimulation.String(s.args.Name), traceutil.AttrPlacements.StringSlice(placements))
+	defer func() {
+		span.SetAttributes(traceutil.AttrRunNum.Int64(int64(s.state.runNum)),
+			traceutil.AttrPodsScheduled.Int(s.state.numScheduledPods),
+			traceutil.AttrPodsUnscheduled.Int(len(s.state.leftoverUnscheduledPodNames)))
+		traceutil.EndSpan(span, err)
+	}()
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("%w: cannot run %q, runNum %d: %w", plannerapi.ErrRunSimulation, s.args.Name, s.runNum(), err)
@@ -88,7 +104,13 @@ func (s *defaultSimulation) Run(ctx context.Context, view minkapi.View) (err err
 		return
 	}
 
-	if err = s.state.CreateSimulationNodes(s.args.StorageMetaAccess, s.args.NodeTemplates); err != nil {
+	if err = s.state.CreateSimulationNodes(s.args.StorageMetaAccess, s.args.DaemonSetPods, s.args.NodeTemplates); err != nil {
+		return
+	}
+	if len(s.state.scaleOutNodes) == 0 {
+		// no scale-out node could be created without exceeding pool quotas; no need to launch the kube-scheduler.
+		s.result = s.buildResult(view, nil)
+		s.state.status = plannerapi.ActivityStatusSuccess
 		return
 	}
 
@@ -98,24 +120,26 @@ func (s *defaultSimulation) Run(ctx context.Context, view minkapi.View) (err err
 	}
 	defer ioutil.CloseQuietly(schedulerHandle)
 
-	err = s.workAndTrackUntilStabilized(ctx, view)
+	err = s.workAndTrackUntilStabilized(ctx, view, schedulerHandle)
 	if err != nil {
 		return
 	}
 
+	if s.args.Strategy.IsMultiNode() {
+		// stop the kube-scheduler before removing scale-out nodes that it did not assign any pods to, so that these
+		// are not carried into views derived from this simulation's view.
+		ioutil.CloseQuietly(schedulerHandle)
+		if _, err = s.state.DeleteUnusedSimulationNodes(); err != nil {
+			return
+		}
+	}
+
 	otherNodePodAssignments, err := s.state.getOtherPodNodeAssignments()
 	if err != nil {
 		return
 	}
 
-	s.result = plannerapi.ScaleOutSimResult{
-		Name:                    s.args.Name,
-		View:                    view,
-		Items:                   s.state.GetScaleOutItems(),
-		NodePodAssignments:      s.state.getScaleOutNodeAssignments(),
-		OtherNodePodAssignments: otherNodePodAssignments,
-		LeftoverUnscheduledPods: s.state.leftoverUnscheduledPodNames.UnsortedList(),
-	}
+	s.result = s.buildResult(view, otherNodePodAssignments)
 	s.state.status = plannerapi.ActivityStatusSuccess
 	log := logr.FromContextOrDiscard(ctx)
 	if len(s.result.LeftoverUnscheduledPods) > 0 {
@@ -124,63 +148,105 @@ func (s *defaultSimulation) Run(ctx context.Context, view minkapi.View) (err err
 	return
 }
 
+func (s *defaultSimulation) buildResult(view minkapi.View, otherNodePodAssignments []plannerapi.NodePodAssignment) plannerapi.ScaleOutSimResult {
+	return plannerapi.ScaleOutSimResult{
+		Name:                        s.args.Name,
+		View:                        view,
+		Items:                       s.state.GetScaleOutItems(),
+		NodePodAssignments:          s.state.getScaleOutNodeAssignments(),
+		OtherNodePodAssignments:     otherNodePodAssignments,
+		LeftoverUnscheduledPods:     s.state.leftoverUnscheduledPodNames.UnsortedList(),
+		QuotaExhaustedPlacements:    s.state.quotaExhaustedPlacements.UnsortedList(),
+		MaxNodesExhaustedPlacements: s.state.maxNodesExhaustedPlacements.UnsortedList(),
+		PodSchedulingFailures:       s.state.getLeftoverPodSchedulingFailures(),
+	}
+}
+
 // workAndTrackUntilStabilized starts a loop which performs work and tracks the state of the simulation until one of the following conditions is met:
 //  1. All the pods are scheduled.
-//  2. Events have stabilized. i.e., no more scheduling events within maxUnchangedTrackAttempts
-//  3. Context timeout.
-//  4. Any error
-func (s *defaultSimulation) workAndTrackUntilStabilized(ctx context.Context, view minkapi.View) (err error) {
+//  2. The kube-scheduler of the given schedulerHandle is idle, and neither events were received nor work was performed
+//     within the last round.
+//  3. Events have stabilized. i.e., no more scheduling events within maxUnchangedTrackAttempts
+//  4. Context timeout.
+//  5. Any error
+//
+// Each round waits for a notification of the event sink of the view or at most TrackPollInterval, so that the run ends
+// shortly after no pod is schedulable anymore instead of after maxUnchangedTrackAttempts.
+func (s *defaultSimulation) workAndTrackUntilStabilized(ctx context.Context, view minkapi.View, schedulerHandle plannerapi.SchedulerHandle) (err error) {
 	log := logr.FromContextOrDiscard(ctx)
-	var stabilized bool
+	var stabilized, performedWork bool
 	for {
+		if performedWork, err = s.doWork(ctx, view); err != nil {
+			return
+		}
 		select {
 		case <-ctx.Done():
 			err = ctx.Err()
 			return
-		default:
-			if err = s.doWork(ctx, view); err != nil {
-				return
-			}
-			<-time.After(s.args.Config.TrackPollInterval)
-			if stabilized, err = s.state.Track(s.args.Config.MaxUnchangedTrackAttempts); err != nil || stabilized {
-				return
-			}
-			if len(s.state.leftoverUnscheduledPodNames) == 0 {
-				log.V(2).Info("ending simulation run since leftoverUnscheduledPodNames is zero", "numTrackAttempts", s.state.numTrackAttempts)
-				return
-			}
+		case <-view.GetEventSink().Notify():
+		case <-time.After(s.args.Config.TrackPollInterval):
+		}
+		numReceivedEvents := s.state.numReceivedEvents
+		if stabilized, err = s.state.Track(s.args.Config.MaxUnchangedTrackAttempts); err != nil || stabilized {
+			return
+		}
+		if len(s.state.leftoverUnscheduledPodNames) == 0 {
+			log.V(2).Info("ending simulation run since leftoverUnscheduledPodNames is zero", "numTrackAttempts", s.state.numTrackAttempts)
+			return
+		}
+		if !performedWork && s.state.numReceivedEvents == numReceivedEvents && schedulerHandle.IsIdle() {
+			log.V(2).Info("ending simulation run since kube-scheduler is idle", "numTrackAttempts", s.state.numTrackAttempts,
+				"leftoverUnscheduledPodCount", len(s.state.leftoverUnscheduledPodNames))
+			return
 		}
 	}
 }
 
 // doWork does miscellaneous simulation work to ensure that the kube-scheduler can
 // continue pod-node bindings. Currently, it delegates to BindClaimsAndVolumesWithNonNilClaimRefs and if the parent
-// SimulatorStrategy supports multiple node scaling, a call is issued to CreateSimulationNodes
-func (s *defaultSimulation) doWork(ctx context.Context, view minkapi.View) error {
+// SimulatorStrategy supports multiple node scaling and there are leftover unscheduled pods, a call is issued to
+// CreateSimulationNodes for the node templates whose scale-out nodes have all been assigned pods by the kube-scheduler.
+// It returns true if any work was performed.
+func (s *defaultSimulation) doWork(ctx context.Context, view minkapi.View) (performedWork bool, err error) {
+	ctx, span := traceutil.StartSpan(ctx, "SimulationDoWork")
+	defer func() {
+		span.SetAttributes(traceutil.AttrPerformedWork.Bool(performedWork))
+		traceutil.EndSpan(span, err)
+	}()
 	log := logr.FromContextOrDiscard(ctx)
 	log.V(3).Info("Invoked doWork", "viewName", view.GetName())
 	provisionedPvs, err := volutil.ProvisionAndBindVolumesFoSelectedClaimsInWFFC(ctx, view)
 	if err != nil {
-		return err
+		return
 	}
 	if len(provisionedPvs) > 0 {
 		log.V(3).Info("ProvisionAndBindVolumesFoSelectedClaimsInWFFC performed work - reset RunState.numUnchangedTrackAttempts since ",
 			"numProvisionedPvs", len(provisionedPvs))
 		s.state.numUnchangedTrackAttempts = 0
+		performedWork = true
 	}
 	numBound, err := volutil.FinalizeStaticBindingsForSelectedClaimsInWFFC(ctx, view)
 	if err != nil {
-		return err
+		return
 	}
 	if numBound > 0 {
 		log.V(3).Info("FinalizeStaticBindingsForSelectedClaimsInWFFC performed work - reset RunState.numUnchangedTrackAttempts since ", "numBound", numBound)
 		s.state.numUnchangedTrackAttempts = 0
+		performedWork = true
 	}
-	if s.args.Strategy.IsMultiNode() {
-		err = s.state.CreateSimulationNodes(s.args.StorageMetaAccess, s.args.NodeTemplates)
+	if s.args.Strategy.IsMultiNode() && len(s.state.leftoverUnscheduledPodNames) > 0 {
+		saturatedTemplates := s.state.GetSaturatedNodeTemplates(s.args.NodeTemplates)
+		if len(saturatedTemplates) > 0 {
+			if err = s.state.CreateSimulationNodes(s.args.StorageMetaAccess, s.args.DaemonSetPods, saturatedTemplates); err != nil {
+				return
+			}
+			log.V(3).Info("CreateSimulationNodes performed work - reset RunState.numUnchangedTrackAttempts since ", "numSaturatedTemplates", len(saturatedTemplates))
+			s.state.numUnchangedTrackAttempts = 0
+			performedWork = true
+		}
 	}
 	_ = viewutil.LogObjects(ctx, "doWork done", view)
-	return err
+	return
 }
 
 func validateSimArgs(args *plannerapi.ScaleOutSimArgs) error {
@@ -231,6 +297,7 @@ func (s *defaultSimulation) launchSchedulerForSimulation(ctx context.Context, si
 	schedLaunchParams := &plannerapi.SchedulerLaunchParams{
 		ClientFacades: clientFacades,
 		EventSink:     simView.GetEventSink(),
+		Profile:       s.args.SchedulerProfile,
 	}
 	return s.args.SchedulerLauncher.Launch(ctx, schedLaunchParams)
 }
@@ -242,4 +309,3 @@ func (s *defaultSimulation) runNum() uint32 {
 func (s *defaultSimulation) incRunNum() uint32 {
 	return s.args.RunCounter.Add(1)
 }
-
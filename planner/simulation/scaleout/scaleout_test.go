@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"context"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	"github.com/gardener/scaling-advisor/api/minkapi/typeinfo"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/podutil"
+	commontestutil "github.com/gardener/scaling-advisor/common/testutil"
+	"github.com/gardener/scaling-advisor/minkapi/view"
+	"github.com/gardener/scaling-advisor/planner/scheduler"
+	"github.com/gardener/scaling-advisor/planner/storagemeta"
+	"github.com/gardener/scaling-advisor/samples"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestRunEndsOnIdleScheduler tests that a run with a pod that fits no scale-out node ends once the kube-scheduler is
+// idle, long before maxUnchangedTrackAttempts, and still reports the bindings of the pods that fit.
+func TestRunEndsOnIdleScheduler(t *testing.T) {
+	const maxUnchangedTrackAttempts = 1000
+	ctx := commontestutil.NewTestContext(t, 30*time.Second, 0)
+	storageMetaAccess, err := storagemeta.NewProviderDefault(commontypes.CloudProviderAWS)
+	if err != nil {
+		t.Fatalf("failed to create storage meta access: %v", err)
+	}
+	simView := createView(ctx, t, []*corev1.Pod{newPod("p1", "500m"), newPod("p2", "500m"), newPod("huge", "64")})
+	sim, err := NewDefault(plannerapi.ScaleOutSimArgs{
+		SchedulerLauncher: createSchedulerLauncher(t),
+		StorageMetaAccess: storageMetaAccess,
+		RunCounter:        &atomic.Uint32{},
+		Name:              "test",
+		Strategy:          commontypes.SimulatorStrategySingleNodeMultiSim,
+		NodeTemplates: []plannerapi.ScaleOutNodeTemplate{{
+			NodePlacement: sacorev1alpha1.NodePlacement{
+				PoolName:         "a",
+				TemplateName:     "m5l",
+				InstanceType:     "m5.large",
+				Region:           "eu-west-1",
+				AvailabilityZone: "eu-west-1a",
+			},
+			Capacity:     corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("8Gi"), corev1.ResourcePods: resource.MustParse("110")},
+			Architecture: "amd64",
+		}},
+		Config: plannerapi.SimulatorConfig{
+			TrackPollInterval:         plannerapi.DefaultTrackPollInterval,
+			MaxUnchangedTrackAttempts: maxUnchangedTrackAttempts,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create simulation: %v", err)
+	}
+	if err = sim.Run(ctx, simView); err != nil {
+		t.Fatalf("failed to run simulation: %v", err)
+	}
+	if got := sim.(*defaultSimulation).state.numTrackAttempts; got >= maxUnchangedTrackAttempts {
+		t.Errorf("numTrackAttempts = %d, want less than %d", got, maxUnchangedTrackAttempts)
+	}
+	result, err := sim.Result()
+	if err != nil {
+		t.Fatalf("failed to get simulation result: %v", err)
+	}
+	if diff := cmp.Diff([]commontypes.NamespacedName{{Namespace: metav1.NamespaceDefault, Name: "huge"}}, result.LeftoverUnscheduledPods); diff != "" {
+		t.Errorf("LeftoverUnscheduledPods mismatch (-want +got):\n%s", diff)
+	}
+	if len(result.NodePodAssignments) != 1 {
+		t.Fatalf("got %d NodePodAssignments, want 1", len(result.NodePodAssignments))
+	}
+	var scheduledPodNames []string
+	for _, p := range result.NodePodAssignments[0].ScheduledPods {
+		scheduledPodNames = append(scheduledPodNames, p.Name)
+	}
+	slices.Sort(scheduledPodNames)
+	if diff := cmp.Diff([]string{"p1", "p2"}, scheduledPodNames); diff != "" {
+		t.Errorf("scheduled pods mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func createView(ctx context.Context, t *testing.T, pods []*corev1.Pod) minkapi.View {
+	t.Helper()
+	viewAccess, err := view.NewAccess(ctx, &minkapi.ViewArgs{
+		Name:   minkapi.DefaultBasePrefix,
+		Scheme: typeinfo.SupportedScheme,
+		WatchConfig: minkapi.WatchConfig{
+			QueueSize: minkapi.DefaultWatchQueueSize,
+			Timeout:   minkapi.DefaultWatchTimeout,
+		},
+	})
+	if err != nil {
+		t.Fatalf("failed to create ViewAccess: %v", err)
+	}
+	simView, err := viewAccess.GetSandboxViewOverDelegate(ctx, "test", viewAccess.GetBaseView())
+	if err != nil {
+		t.Fatalf("failed to create sandbox view: %v", err)
+	}
+	t.Cleanup(func() { _ = simView.Close() })
+	for _, p := range pods {
+		if _, err = simView.CreateObject(ctx, typeinfo.PodsDescriptor.GVK, p); err != nil {
+			t.Fatalf("failed to create pod %q: %v", p.Name, err)
+		}
+	}
+	return simView
+}
+
+func createSchedulerLauncher(t *testing.T) plannerapi.SchedulerLauncher {
+	t.Helper()
+	configBytes, err := samples.LoadBinPackingSchedulerConfig()
+	if err != nil {
+		t.Fatalf("failed to load scheduler config: %v", err)
+	}
+	launcher, err := scheduler.NewLauncherFromConfig(configBytes, 1, nil)
+	if err != nil {
+		t.Fatalf("failed to create scheduler launcher: %v", err)
+	}
+	return launcher
+}
+
+func newPod(name, cpu string) *corev1.Pod {
+	return podutil.AsPod(plannerapi.PodInfo{
+		ObjectMeta:         metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+		SchedulerName:      "bin-packing-scheduler",
+		AggregatedRequests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+	})
+}
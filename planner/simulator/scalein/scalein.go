@@ -0,0 +1,383 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package scalein provides the implementation of a ScaleInSimulator that simulates the removal of underutilized nodes
+// one at a time.
+package scalein
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gardener/scaling-advisor/planner/simulator"
+
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+)
+
+var (
+	_ plannerapi.ScaleInSimulator = (*defaultSimulator)(nil)
+)
+
+// defaultSimulator is the default implementation of a ScaleInSimulator.
+type defaultSimulator struct {
+	viewAccess        minkapi.ViewAccess
+	schedulerLauncher plannerapi.SchedulerLauncher
+	request           *plannerapi.Request
+	resultCh          chan plannerapi.ScaleInPlanResult
+	simRunCounter     *atomic.Uint32
+	traceDir          string
+	views             []minkapi.View
+	simulatorConfig   plannerapi.SimulatorConfig
+	mu                sync.Mutex
+}
+
+// candidate represents a node that is a candidate for scale-in.
+type candidate struct {
+	nodeName    string
+	placement   sacorev1alpha1.NodePlacement
+	utilization float64
+}
+
+// New creates a new plannerapi.ScaleInSimulator that simulates the removal of underutilized nodes one at a time.
+func New(args plannerapi.SimulatorArgs) (plannerapi.ScaleInSimulator, error) {
+	if args.ViewAccess == nil {
+		return nil, fmt.Errorf("%w: view access is required", plannerapi.ErrCreateSimulator)
+	}
+	if args.SchedulerLauncher == nil {
+		return nil, fmt.Errorf("%w: scheduler launcher is required", plannerapi.ErrCreateSimulator)
+	}
+	return &defaultSimulator{
+		viewAccess:        args.ViewAccess,
+		schedulerLauncher: args.SchedulerLauncher,
+		traceDir:          args.TraceDir,
+		simulatorConfig:   args.Config,
+		simRunCounter:     &atomic.Uint32{},
+	}, nil
+}
+
+// Simulate selects underutilized candidate nodes and runs a ScaleInSimulation for each candidate, in increasing order
+// of utilization, over the view of the last accepted simulation. A candidate is accepted if all pods evicted from it
+// could be re-placed on the remaining nodes and the MaxNodesPerPlan of the effective ScaleInPolicy of its node pool has
+// not yet been reached. If the ScalingAdviceGenerationMode is Incremental, a ScaleInPlanResult is sent on the
+// planResultCh for each accepted candidate, otherwise a cumulative ScaleInPlanResult is sent after all candidates have
+// been simulated.
+func (s *defaultSimulator) Simulate(ctx context.Context, request *plannerapi.Request, simulationFactory plannerapi.SimulationFactory) <-chan plannerapi.ScaleInPlanResult {
+	s.request = request
+	s.resultCh = make(chan plannerapi.ScaleInPlanResult)
+	go func() {
+		defer close(s.resultCh)
+		if err := s.doSimulate(ctx, simulationFactory); err != nil {
+			if errors.Is(context.Cause(ctx), plannerapi.ErrAdviceGenerationTimeout) && !errors.Is(err, plannerapi.ErrAdviceGenerationTimeout) {
+				err = fmt.Errorf("%w: scale-in plan generation incomplete within %s: %w", plannerapi.ErrAdviceGenerationTimeout, request.AdviceGenerationTimeout, err)
+			}
+			// the planner reads the resultCh until it is closed, so the terminal error is always sent.
+			s.resultCh <- plannerapi.ScaleInPlanResult{Error: plannerapi.AsGenError(request.ID, request.CorrelationID, err)}
+		}
+	}()
+	return s.resultCh
+}
+
+// Close closes all the simulation minkapi views and clears the planner Request.
+func (s *defaultSimulator) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var errs []error
+	for _, v := range s.views {
+		if err := v.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	s.views = nil
+	s.simRunCounter.Store(0)
+	s.request = nil
+	return errors.Join(errs...)
+}
+
+func (s *defaultSimulator) doSimulate(ctx context.Context, simulationFactory plannerapi.SimulationFactory) error {
+	log := logr.FromContextOrDiscard(ctx)
+	candidates, err := selectCandidates(s.request)
+	if err != nil {
+		return err
+	}
+	if len(candidates) == 0 {
+		log.V(2).Info("No underutilized candidate nodes found for scale-in.")
+		return plannerapi.ErrNoScaleInPlan
+	}
+	requestView, err := s.createSandboxView(ctx, "Request-"+s.request.ID, s.viewAccess.GetBaseView())
+	if err != nil {
+		return err
+	}
+	if err = simulator.PopulateView(ctx, requestView, &s.request.Snapshot); err != nil {
+		return fmt.Errorf("%w: %w", plannerapi.ErrPopulateRequestView, err)
+	}
+
+	var (
+		items             []sacorev1alpha1.ScaleInItem
+		passView          = requestView
+		numRemovedPerPool = make(map[string]int32)
+		nodeCounts        = countNodes(s.request)
+	)
+	for i, c := range candidates {
+		pool := s.request.Constraint.Spec.GetNodePool(c.placement.PoolName)
+		maxNodesPerPlan := getMaxNodesPerPlan(s.request.Constraint.Spec.GetScaleInPolicy(pool))
+		if numRemovedPerPool[c.placement.PoolName] >= maxNodesPerPlan {
+			log.V(3).Info("Skipping candidate since maxNodesPerPlan reached for pool", "nodeName", c.nodeName, "poolName", c.placement.PoolName, "maxNodesPerPlan", maxNodesPerPlan)
+			continue
+		}
+		if !nodeCounts.canRemove(pool, c.placement.TemplateName) {
+			log.V(3).Info("Skipping candidate since removal would go below minNodes", "nodeName", c.nodeName, "poolName", c.placement.PoolName, "templateName", c.placement.TemplateName)
+			continue
+		}
+		var simResult plannerapi.ScaleInSimResult
+		simResult, err = s.runSimulation(ctx, simulationFactory, passView, fmt.Sprintf("sim-%d_%s", i, c.nodeName), c)
+		if err != nil {
+			return err
+		}
+		if len(simResult.LeftoverUnscheduledPods) > 0 {
+			log.V(2).Info("Rejecting candidate since not all evicted pods could be re-placed", "nodeName", c.nodeName,
+				"numLeftoverUnscheduledPods", len(simResult.LeftoverUnscheduledPods))
+			// no later simulation runs over the view of a rejected candidate.
+			if err = s.closeView(simResult.View); err != nil {
+				return err
+			}
+			continue
+		}
+		log.V(2).Info("Accepting candidate for scale-in", "nodeName", c.nodeName, "utilization", c.utilization)
+		item := sacorev1alpha1.ScaleInItem{
+			NodePlacement: c.placement,
+			NodeName:      c.nodeName,
+		}
+		items = append(items, item)
+		numRemovedPerPool[c.placement.PoolName]++
+		nodeCounts.remove(c.placement)
+		passView = simResult.View
+		if err = passView.GetEventSink().Reset(); err != nil {
+			return err
+		}
+		if s.request.AdviceGenerationMode.IsIncremental() {
+			s.sendPlanResult([]sacorev1alpha1.ScaleInItem{item})
+		}
+	}
+	if len(items) == 0 {
+		log.V(3).Info("No candidate node could be removed without leaving unscheduled pods.")
+		return plannerapi.ErrNoScaleInPlan
+	}
+	if s.request.AdviceGenerationMode.IsAllAtOnce() {
+		s.sendPlanResult(items)
+	}
+	return nil
+}
+
+func (s *defaultSimulator) runSimulation(ctx context.Context, simulationFactory plannerapi.SimulationFactory, passView minkapi.View, name string, c candidate) (simResult plannerapi.ScaleInSimResult, err error) {
+	sim, err := simulationFactory.NewScaleIn(plannerapi.ScaleInSimArgs{
+		SchedulerLauncher:  s.schedulerLauncher,
+		RunCounter:         s.simRunCounter,
+		Name:               name,
+		TraceDir:           s.traceDir,
+		CandidateNodeNames: []string{c.nodeName},
+		SchedulerProfile:   s.request.SchedulerProfile,
+		Config:             s.simulatorConfig,
+	})
+	if err != nil {
+		return
+	}
+	simView, err := s.createSandboxView(ctx, fmt.Sprintf("%s_%s", s.request.ID, name), passView)
+	if err != nil {
+		return
+	}
+	if err = sim.Run(ctx, simView); err != nil {
+		return
+	}
+	return sim.Result()
+}
+
+func (s *defaultSimulator) createSandboxView(ctx context.Context, name string, delegate minkapi.View) (minkapi.View, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	sandboxView, err := s.viewAccess.GetSandboxViewOverDelegate(ctx, name, delegate)
+	if err != nil {
+		return nil, err
+	}
+	s.views = append(s.views, sandboxView)
+	return sandboxView, nil
+}
+
+// closeView closes the given view created by createSandboxView and removes it from the views of this simulator.
+func (s *defaultSimulator) closeView(v minkapi.View) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	idx := slices.Index(s.views, v)
+	if idx < 0 {
+		return nil
+	}
+	s.views = slices.Delete(s.views, idx, idx+1)
+	return v.Close()
+}
+
+// sendPlanResult sends a ScaleInPlanResult with the given items on the resultCh. The planner reads the resultCh until
+// it is closed, also once its context is done, so the send does not block indefinitely.
+func (s *defaultSimulator) sendPlanResult(items []sacorev1alpha1.ScaleInItem) {
+	planResult := plannerapi.ScaleInPlanResult{
+		Labels: map[string]string{
+			commonconstants.LabelRequestID:            s.request.ID,
+			commonconstants.LabelCorrelationID:        s.request.CorrelationID,
+			commonconstants.LabelTotalSimulationRuns:  strconv.Itoa(int(s.simRunCounter.Load())),
+			commonconstants.LabelPlanGenerateDuration: time.Since(s.request.CreationTime).String(),
+			commonconstants.LabelConstraintNumPools:   strconv.Itoa(len(s.request.Constraint.Spec.NodePools)),
+		},
+		ScaleInPlan: &sacorev1alpha1.ScaleInPlan{
+			Items: items,
+		},
+		Metrics: plannerapi.Metrics{
+			SimulationRuns: s.simRunCounter.Load(),
+		},
+	}
+	s.resultCh <- planResult
+}
+
+// selectCandidates returns the nodes of the request snapshot that belong to a node pool of the request constraint, are
+// schedulable, have an effective ScaleInPolicy, are older than its MinNodeAge and whose utilization is below its
+// threshold.
+// Candidates are sorted by increasing utilization and then by node name.
+func selectCandidates(request *plannerapi.Request) ([]candidate, error) {
+	requestsByNodeName := make(map[string]corev1.ResourceList)
+	for _, p := range request.Snapshot.Pods {
+		if p.NodeName == "" {
+			continue
+		}
+		requests := requestsByNodeName[p.NodeName]
+		if requests == nil {
+			requests = make(corev1.ResourceList)
+			requestsByNodeName[p.NodeName] = requests
+		}
+		for name, q := range p.AggregatedRequests {
+			sum := requests[name]
+			sum.Add(q)
+			requests[name] = sum
+		}
+	}
+	var candidates []candidate
+	for _, n := range request.Snapshot.Nodes {
+		if n.Unschedulable {
+			continue
+		}
+		pool := request.Constraint.Spec.GetNodePool(n.Labels[commonconstants.LabelNodePoolName])
+		if pool == nil {
+			continue
+		}
+		placement, err := n.GetNodePlacement()
+		if err != nil {
+			return nil, err
+		}
+		policy := request.Constraint.Spec.GetScaleInPolicy(pool)
+		if policy == nil {
+			continue
+		}
+		if request.CreationTime.Sub(n.CreationTimestamp.Time) < getMinNodeAge(policy) {
+			continue
+		}
+		utilization := computeUtilization(requestsByNodeName[n.Name], n.Allocatable)
+		if utilization*100 >= float64(getUtilizationThresholdPercent(policy)) {
+			continue
+		}
+		candidates = append(candidates, candidate{
+			nodeName:    n.Name,
+			placement:   placement,
+			utilization: utilization,
+		})
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		return cmp.Or(cmp.Compare(a.utilization, b.utilization), cmp.Compare(a.nodeName, b.nodeName))
+	})
+	return candidates, nil
+}
+
+// nodeCounts holds the number of nodes per node pool and per node template of a node pool.
+type nodeCounts struct {
+	byPool     map[string]int32
+	byTemplate map[[2]string]int32
+}
+
+// countNodes counts the nodes of the request snapshot per node pool and node template.
+func countNodes(request *plannerapi.Request) nodeCounts {
+	counts := nodeCounts{
+		byPool:     make(map[string]int32),
+		byTemplate: make(map[[2]string]int32),
+	}
+	for _, n := range request.Snapshot.Nodes {
+		poolName, ok := n.Labels[commonconstants.LabelNodePoolName]
+		if !ok {
+			continue
+		}
+		counts.byPool[poolName]++
+		counts.byTemplate[[2]string{poolName, n.Labels[commonconstants.LabelNodeTemplateName]}]++
+	}
+	return counts
+}
+
+// canRemove checks whether a node of the given pool and template can be removed without going below the MinNodes of
+// the pool or the template.
+func (c nodeCounts) canRemove(pool *sacorev1alpha1.NodePool, templateName string) bool {
+	if pool.MinNodes != nil && c.byPool[pool.Name] <= *pool.MinNodes {
+		return false
+	}
+	idx := slices.IndexFunc(pool.NodeTemplates, func(nt sacorev1alpha1.NodeTemplate) bool {
+		return nt.Name == templateName
+	})
+	if idx < 0 || pool.NodeTemplates[idx].MinNodes == nil {
+		return true
+	}
+	return c.byTemplate[[2]string{pool.Name, templateName}] > *pool.NodeTemplates[idx].MinNodes
+}
+
+func (c nodeCounts) remove(placement sacorev1alpha1.NodePlacement) {
+	c.byPool[placement.PoolName]--
+	c.byTemplate[[2]string{placement.PoolName, placement.TemplateName}]--
+}
+
+// computeUtilization computes the maximum ratio of the given requests to the given allocatable across cpu and memory.
+func computeUtilization(requests, allocatable corev1.ResourceList) (utilization float64) {
+	for _, name := range []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory} {
+		alloc, ok := allocatable[name]
+		if !ok || alloc.IsZero() {
+			continue
+		}
+		req := requests[name]
+		utilization = max(utilization, float64(req.MilliValue())/float64(alloc.MilliValue()))
+	}
+	return
+}
+
+func getUtilizationThresholdPercent(policy *sacorev1alpha1.ScaleInPolicy) int32 {
+	if policy == nil || policy.UtilizationThresholdPercent == nil {
+		return plannerapi.DefaultScaleInUtilizationThresholdPercent
+	}
+	return *policy.UtilizationThresholdPercent
+}
+
+func getMaxNodesPerPlan(policy *sacorev1alpha1.ScaleInPolicy) int32 {
+	if policy == nil || policy.MaxNodesPerPlan == nil {
+		return plannerapi.DefaultScaleInMaxNodesPerPlan
+	}
+	return *policy.MaxNodesPerPlan
+}
+
+func getMinNodeAge(policy *sacorev1alpha1.ScaleInPolicy) time.Duration {
+	if policy == nil || policy.MinNodeAge == nil {
+		return plannerapi.DefaultScaleInMinNodeAge
+	}
+	return policy.MinNodeAge.Duration
+}
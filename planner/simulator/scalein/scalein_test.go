@@ -0,0 +1,457 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scalein
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	"github.com/gardener/scaling-advisor/api/minkapi/typeinfo"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/nodeutil"
+	commontestutil "github.com/gardener/scaling-advisor/common/testutil"
+	"github.com/gardener/scaling-advisor/minkapi/view"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+var testCreationTime = time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestSelectCandidates(t *testing.T) {
+	tests := map[string]struct {
+		defaultPolicy *sacorev1alpha1.ScaleInPolicy
+		poolPolicy    *sacorev1alpha1.ScaleInPolicy
+		nodes         []plannerapi.NodeInfo
+		pods          []plannerapi.PodInfo
+		expectedNames []string
+	}{
+		"no scale-in policy": {
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "a", time.Hour)},
+			expectedNames: nil,
+		},
+		"default scale-in policy": {
+			defaultPolicy: &sacorev1alpha1.ScaleInPolicy{},
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "a", time.Hour)},
+			expectedNames: []string{"n1"},
+		},
+		"node pool scale-in policy": {
+			poolPolicy:    &sacorev1alpha1.ScaleInPolicy{},
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "a", time.Hour)},
+			expectedNames: []string{"n1"},
+		},
+		"node of unknown pool": {
+			defaultPolicy: &sacorev1alpha1.ScaleInPolicy{},
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "unknown", time.Hour)},
+			expectedNames: nil,
+		},
+		"unschedulable node": {
+			defaultPolicy: &sacorev1alpha1.ScaleInPolicy{},
+			nodes: []plannerapi.NodeInfo{func() plannerapi.NodeInfo {
+				n := newNodeInfo("n1", "a", time.Hour)
+				n.Unschedulable = true
+				return n
+			}()},
+			expectedNames: nil,
+		},
+		"node younger than default minNodeAge": {
+			defaultPolicy: &sacorev1alpha1.ScaleInPolicy{},
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "a", time.Minute)},
+			expectedNames: nil,
+		},
+		"node older than configured minNodeAge": {
+			poolPolicy:    &sacorev1alpha1.ScaleInPolicy{MinNodeAge: &metav1.Duration{Duration: 30 * time.Second}},
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "a", time.Minute)},
+			expectedNames: []string{"n1"},
+		},
+		"node utilization at default threshold": {
+			defaultPolicy: &sacorev1alpha1.ScaleInPolicy{},
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "a", time.Hour)},
+			pods:          []plannerapi.PodInfo{newPodInfo("p1", "n1", "1", "1Gi")},
+			expectedNames: nil,
+		},
+		"node utilization below configured threshold": {
+			defaultPolicy: &sacorev1alpha1.ScaleInPolicy{UtilizationThresholdPercent: ptr.To[int32](60)},
+			nodes:         []plannerapi.NodeInfo{newNodeInfo("n1", "a", time.Hour)},
+			pods:          []plannerapi.PodInfo{newPodInfo("p1", "n1", "1", "1Gi")},
+			expectedNames: []string{"n1"},
+		},
+		"candidates sorted by utilization and name": {
+			defaultPolicy: &sacorev1alpha1.ScaleInPolicy{},
+			nodes: []plannerapi.NodeInfo{
+				newNodeInfo("n1", "a", time.Hour),
+				newNodeInfo("n2", "a", time.Hour),
+				newNodeInfo("n3", "a", time.Hour),
+				newNodeInfo("n4", "a", time.Hour),
+			},
+			pods: []plannerapi.PodInfo{
+				newPodInfo("p1", "n1", "600m", "0"),
+				newPodInfo("p2", "n2", "0", "512Mi"),
+				newPodInfo("p3", "n4", "500m", "0"),
+				newPodInfo("p4", "", "1", "1Gi"),
+			},
+			expectedNames: []string{"n3", "n2", "n4", "n1"},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := newRequest(tc.defaultPolicy, tc.poolPolicy)
+			req.Snapshot.Nodes = tc.nodes
+			req.Snapshot.Pods = tc.pods
+			candidates, err := selectCandidates(req)
+			if err != nil {
+				t.Fatalf("selectCandidates failed: %v", err)
+			}
+			var gotNames []string
+			for _, c := range candidates {
+				gotNames = append(gotNames, c.nodeName)
+			}
+			if diff := cmp.Diff(tc.expectedNames, gotNames); diff != "" {
+				t.Errorf("candidate names mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestComputeUtilization(t *testing.T) {
+	allocatable := corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("4Gi")}
+	tests := map[string]struct {
+		requests    corev1.ResourceList
+		allocatable corev1.ResourceList
+		expected    float64
+	}{
+		"no requests": {
+			allocatable: allocatable,
+			expected:    0,
+		},
+		"cpu dominant": {
+			requests:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			allocatable: allocatable,
+			expected:    0.75,
+		},
+		"memory dominant": {
+			requests:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+			allocatable: allocatable,
+			expected:    0.5,
+		},
+		"other resources ignored": {
+			requests:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("200m"), corev1.ResourceEphemeralStorage: resource.MustParse("10Gi")},
+			allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceEphemeralStorage: resource.MustParse("10Gi")},
+			expected:    0.1,
+		},
+		"zero allocatable ignored": {
+			requests:    corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1"), corev1.ResourceMemory: resource.MustParse("1Gi")},
+			allocatable: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("0"), corev1.ResourceMemory: resource.MustParse("4Gi")},
+			expected:    0.25,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := computeUtilization(tc.requests, tc.allocatable); math.Abs(got-tc.expected) > 1e-9 {
+				t.Errorf("got utilization %v, want %v", got, tc.expected)
+			}
+		})
+	}
+}
+
+func TestNodeCountsCanRemove(t *testing.T) {
+	tests := map[string]struct {
+		poolMinNodes     *int32
+		templateMinNodes *int32
+		numNodes         int
+		expected         bool
+	}{
+		"no minNodes":                   {numNodes: 1, expected: true},
+		"above pool minNodes":           {poolMinNodes: ptr.To[int32](1), numNodes: 2, expected: true},
+		"at pool minNodes":              {poolMinNodes: ptr.To[int32](2), numNodes: 2, expected: false},
+		"above template minNodes":       {templateMinNodes: ptr.To[int32](1), numNodes: 2, expected: true},
+		"at template minNodes":          {templateMinNodes: ptr.To[int32](2), numNodes: 2, expected: false},
+		"pool minNodes reached earlier": {poolMinNodes: ptr.To[int32](2), templateMinNodes: ptr.To[int32](1), numNodes: 2, expected: false},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := newRequest(&sacorev1alpha1.ScaleInPolicy{}, nil)
+			pool := &req.Constraint.Spec.NodePools[0]
+			pool.MinNodes = tc.poolMinNodes
+			pool.NodeTemplates[0].MinNodes = tc.templateMinNodes
+			for i := range tc.numNodes {
+				req.Snapshot.Nodes = append(req.Snapshot.Nodes, newNodeInfo(fmt.Sprintf("n%d", i), "a", time.Hour))
+			}
+			if got := countNodes(req).canRemove(pool, "t"); got != tc.expected {
+				t.Errorf("got canRemove %t, want %t", got, tc.expected)
+			}
+		})
+	}
+}
+
+// TestSimulateSequentialFallback tests that a rejected candidate does not stop the simulation of further candidates,
+// that each simulation runs over the view of the last accepted simulation, that the views of rejected candidates are
+// closed right away and that MaxNodesPerPlan is respected.
+func TestSimulateSequentialFallback(t *testing.T) {
+	tests := map[string]struct {
+		maxNodesPerPlan   int32
+		mode              commontypes.ScalingAdviceGenerationMode
+		rejectedNodes     []string
+		expectedSimulated []string
+		expectedPlans     [][]string
+		expectedErr       error
+	}{
+		"all candidates accepted": {
+			maxNodesPerPlan:   3,
+			mode:              commontypes.ScalingAdviceGenerationModeAllAtOnce,
+			expectedSimulated: []string{"n1", "n2", "n3"},
+			expectedPlans:     [][]string{{"n1", "n2", "n3"}},
+		},
+		"rejected candidate skipped": {
+			maxNodesPerPlan:   3,
+			mode:              commontypes.ScalingAdviceGenerationModeAllAtOnce,
+			rejectedNodes:     []string{"n2"},
+			expectedSimulated: []string{"n1", "n2", "n3"},
+			expectedPlans:     [][]string{{"n1", "n3"}},
+		},
+		"incremental plan per accepted candidate": {
+			maxNodesPerPlan:   3,
+			mode:              commontypes.ScalingAdviceGenerationModeIncremental,
+			rejectedNodes:     []string{"n1"},
+			expectedSimulated: []string{"n1", "n2", "n3"},
+			expectedPlans:     [][]string{{"n2"}, {"n3"}},
+		},
+		"maxNodesPerPlan reached": {
+			maxNodesPerPlan:   1,
+			mode:              commontypes.ScalingAdviceGenerationModeAllAtOnce,
+			rejectedNodes:     []string{"n1"},
+			expectedSimulated: []string{"n1", "n2"},
+			expectedPlans:     [][]string{{"n2"}},
+		},
+		"all candidates rejected": {
+			maxNodesPerPlan:   3,
+			mode:              commontypes.ScalingAdviceGenerationModeAllAtOnce,
+			rejectedNodes:     []string{"n1", "n2", "n3"},
+			expectedSimulated: []string{"n1", "n2", "n3"},
+			expectedErr:       plannerapi.ErrNoScaleInPlan,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := commontestutil.NewTestContext(t, 10*time.Second, 0)
+			viewAccess, err := view.NewAccess(ctx, &minkapi.ViewArgs{
+				Name:   minkapi.DefaultBasePrefix,
+				Scheme: typeinfo.SupportedScheme,
+				WatchConfig: minkapi.WatchConfig{
+					QueueSize: minkapi.DefaultWatchQueueSize,
+					Timeout:   minkapi.DefaultWatchTimeout,
+				},
+			})
+			if err != nil {
+				t.Fatalf("failed to create ViewAccess: %v", err)
+			}
+			simulator, err := New(plannerapi.SimulatorArgs{ViewAccess: viewAccess, SchedulerLauncher: &fakeSchedulerLauncher{}})
+			if err != nil {
+				t.Fatalf("failed to create simulator: %v", err)
+			}
+			t.Cleanup(func() { _ = simulator.Close() })
+			req := newRequest(&sacorev1alpha1.ScaleInPolicy{MaxNodesPerPlan: ptr.To(tc.maxNodesPerPlan)}, nil)
+			req.ID = "test"
+			req.AdviceGenerationMode = tc.mode
+			req.Snapshot.Nodes = []plannerapi.NodeInfo{
+				newNodeInfo("n1", "a", time.Hour),
+				newNodeInfo("n2", "a", time.Hour),
+				newNodeInfo("n3", "a", time.Hour),
+			}
+			factory := &fakeSimulationFactory{t: t, rejectedNodes: tc.rejectedNodes}
+
+			var gotPlans [][]string
+			var gotErr error
+			for result := range simulator.Simulate(ctx, req, factory) {
+				if result.Error != nil {
+					gotErr = result.Error
+					continue
+				}
+				var names []string
+				for _, item := range result.ScaleInPlan.Items {
+					names = append(names, item.NodeName)
+				}
+				gotPlans = append(gotPlans, names)
+			}
+			if !errors.Is(gotErr, tc.expectedErr) {
+				t.Errorf("got error %v, want %v", gotErr, tc.expectedErr)
+			}
+			if diff := cmp.Diff(tc.expectedPlans, gotPlans); diff != "" {
+				t.Errorf("plans mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.expectedSimulated, factory.simulated); diff != "" {
+				t.Errorf("simulated candidates mismatch (-want +got):\n%s", diff)
+			}
+			// the request view and the views of the accepted candidates remain open until the simulator is closed.
+			wantNumViews := 1 + len(acceptedBefore(factory.simulated, tc.rejectedNodes))
+			if got := len(simulator.(*defaultSimulator).views); got != wantNumViews {
+				t.Errorf("got %d open views, want %d", got, wantNumViews)
+			}
+			for i, cordoned := range factory.cordonedBefore {
+				wantCordoned := acceptedBefore(factory.simulated[:i], tc.rejectedNodes)
+				if diff := cmp.Diff(wantCordoned, cordoned); diff != "" {
+					t.Errorf("nodes removed before simulating %q mismatch (-want +got):\n%s", factory.simulated[i], diff)
+				}
+			}
+		})
+	}
+}
+
+// acceptedBefore returns the given simulated node names that are not rejected.
+func acceptedBefore(simulated, rejected []string) (accepted []string) {
+	for _, name := range simulated {
+		if !slices.Contains(rejected, name) {
+			accepted = append(accepted, name)
+		}
+	}
+	return
+}
+
+func newRequest(defaultPolicy, poolPolicy *sacorev1alpha1.ScaleInPolicy) *plannerapi.Request {
+	return &plannerapi.Request{
+		CreationTime: testCreationTime,
+		Constraint: &sacorev1alpha1.ScalingConstraint{
+			Spec: sacorev1alpha1.ScalingConstraintSpec{
+				ScaleInPolicy: defaultPolicy,
+				NodePools: []sacorev1alpha1.NodePool{
+					{
+						Name:              "a",
+						Region:            "r",
+						AvailabilityZones: []string{"r-a"},
+						NodeTemplates:     []sacorev1alpha1.NodeTemplate{{Name: "t", InstanceType: "m5.large"}},
+						ScaleInPolicy:     poolPolicy,
+					},
+				},
+			},
+		},
+	}
+}
+
+func newNodeInfo(name, poolName string, age time.Duration) plannerapi.NodeInfo {
+	labels := make(map[string]string)
+	nodeutil.AddNodeLabels(labels, "amd64", name, sacorev1alpha1.NodePlacement{
+		PoolName:         poolName,
+		TemplateName:     "t",
+		InstanceType:     "m5.large",
+		Region:           "r",
+		AvailabilityZone: "r-a",
+	})
+	return plannerapi.NodeInfo{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Labels:            labels,
+			CreationTimestamp: metav1.NewTime(testCreationTime.Add(-age)),
+		},
+		InstanceType: "m5.large",
+		Allocatable:  corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2"), corev1.ResourceMemory: resource.MustParse("2Gi")},
+	}
+}
+
+func newPodInfo(name, nodeName, cpu, memory string) plannerapi.PodInfo {
+	return plannerapi.PodInfo{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+		NodeName:   nodeName,
+		AggregatedRequests: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse(cpu),
+			corev1.ResourceMemory: resource.MustParse(memory),
+		},
+	}
+}
+
+// fakeSimulationFactory creates fakeSimulations for scale-in and records the simulated candidate nodes and the nodes
+// already removed from the view of each simulation.
+type fakeSimulationFactory struct {
+	t              *testing.T
+	rejectedNodes  []string
+	simulated      []string
+	cordonedBefore [][]string
+	mu             sync.Mutex
+}
+
+func (f *fakeSimulationFactory) NewScaleOut(plannerapi.ScaleOutSimArgs) (plannerapi.ScaleOutSimulation, error) {
+	return nil, errors.New("scale-out not supported")
+}
+
+func (f *fakeSimulationFactory) NewScaleIn(args plannerapi.ScaleInSimArgs) (plannerapi.ScaleInSimulation, error) {
+	return &fakeSimulation{factory: f, args: args}, nil
+}
+
+// fakeSimulation cordons its candidate nodes and leaves an unscheduled pod if a candidate is rejected by its factory.
+type fakeSimulation struct {
+	factory *fakeSimulationFactory
+	args    plannerapi.ScaleInSimArgs
+	result  plannerapi.ScaleInSimResult
+}
+
+func (s *fakeSimulation) Reset() error {
+	s.result = plannerapi.ScaleInSimResult{}
+	return nil
+}
+
+func (s *fakeSimulation) Name() string {
+	return s.args.Name
+}
+
+func (s *fakeSimulation) Status() plannerapi.ActivityStatus {
+	return plannerapi.ActivityStatusSuccess
+}
+
+func (s *fakeSimulation) Run(ctx context.Context, v minkapi.View) error {
+	nodes, err := v.ListNodes(ctx)
+	if err != nil {
+		return err
+	}
+	var cordoned []string
+	for _, n := range nodes {
+		if n.Spec.Unschedulable {
+			cordoned = append(cordoned, n.Name)
+		}
+	}
+	slices.Sort(cordoned)
+	s.factory.mu.Lock()
+	s.factory.simulated = append(s.factory.simulated, s.args.CandidateNodeNames...)
+	s.factory.cordonedBefore = append(s.factory.cordonedBefore, cordoned)
+	s.factory.mu.Unlock()
+
+	s.result = plannerapi.ScaleInSimResult{Name: s.args.Name, View: v, RemovedNodeNames: s.args.CandidateNodeNames}
+	for _, nodeName := range s.args.CandidateNodeNames {
+		idx := slices.IndexFunc(nodes, func(n corev1.Node) bool { return n.Name == nodeName })
+		node := nodes[idx].DeepCopy()
+		node.Spec.Unschedulable = true
+		if err = v.UpdateObject(ctx, typeinfo.NodesDescriptor.GVK, node); err != nil {
+			return err
+		}
+		if slices.Contains(s.factory.rejectedNodes, nodeName) {
+			s.result.LeftoverUnscheduledPods = append(s.result.LeftoverUnscheduledPods, commontypes.NamespacedName{Namespace: metav1.NamespaceDefault, Name: "pod-of-" + nodeName})
+		}
+	}
+	return nil
+}
+
+func (s *fakeSimulation) Result() (plannerapi.ScaleInSimResult, error) {
+	return s.result, nil
+}
+
+// fakeSchedulerLauncher is a SchedulerLauncher that is never expected to be used by fakeSimulations.
+type fakeSchedulerLauncher struct{}
+
+func (f *fakeSchedulerLauncher) Launch(context.Context, *plannerapi.SchedulerLaunchParams) (plannerapi.SchedulerHandle, error) {
+	return nil, errors.New("scheduler launch not supported")
+}
+
+func (f *fakeSchedulerLauncher) LoadProfile(*plannerapi.SchedulerProfile) error {
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"time"
+
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// GetBackedOffPlacements returns the set of node placements of the request constraint that must not be considered for
+// scale-out at the request CreationTime because of the ScaleOutErrorInfos of the request Feedback. A scale-out error
+// info applies to all placements with its instance type, capacity type and availability zone. Such a placement is backed off until
+// the LastFailureTime of the error info plus the backoff duration computed by ComputeBackoffDuration from the effective
+// BackoffPolicy of its node pool. The result only depends on the request and is hence deterministic.
+func GetBackedOffPlacements(req *plannerapi.Request) sets.Set[sacorev1alpha1.NodePlacement] {
+	backedOff := sets.New[sacorev1alpha1.NodePlacement]()
+	if req.Feedback == nil || len(req.Feedback.ScaleOutErrorInfos) == 0 {
+		return backedOff
+	}
+	for i := range req.Constraint.Spec.NodePools {
+		pool := &req.Constraint.Spec.NodePools[i]
+		policy := req.Constraint.Spec.GetBackoffPolicy(pool)
+		for _, placement := range pool.GetNodePlacements() {
+			for _, errInfo := range req.Feedback.ScaleOutErrorInfos {
+				if errInfo.InstanceType != placement.InstanceType || errInfo.AvailabilityZone != placement.AvailabilityZone ||
+					errInfo.CapacityType.Canonical() != placement.CapacityType {
+					continue
+				}
+				if isBackedOff(req.CreationTime, errInfo, policy) {
+					backedOff.Insert(placement)
+				}
+			}
+		}
+	}
+	return backedOff
+}
+
+// ComputeBackoffDuration computes the exponential backoff duration for the given failCount. The duration starts at the
+// InitialBackoffDuration of the given policy for the first failure and doubles for every further failure, limited by
+// the MaxBackoffDuration. Default durations are used for a nil policy or unset durations.
+func ComputeBackoffDuration(policy *sacorev1alpha1.BackoffPolicy, failCount int32) time.Duration {
+	initialBackoff, maxBackoff := plannerapi.DefaultInitialBackoffDuration, plannerapi.DefaultMaxBackoffDuration
+	if policy != nil {
+		if policy.InitialBackoffDuration.Duration > 0 {
+			initialBackoff = policy.InitialBackoffDuration.Duration
+		}
+		if policy.MaxBackoffDuration.Duration > 0 {
+			maxBackoff = policy.MaxBackoffDuration.Duration
+		}
+	}
+	backoff := initialBackoff
+	for i := int32(1); i < failCount && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	return min(backoff, maxBackoff)
+}
+
+// isBackedOff checks whether the backoff window of the given errInfo according to the given policy has not yet elapsed
+// at the given requestTime. An errInfo without LastFailureTime has no known failure and hence no backoff window.
+func isBackedOff(requestTime time.Time, errInfo sacorev1alpha1.ScaleOutErrorInfo, policy *sacorev1alpha1.BackoffPolicy) bool {
+	if errInfo.LastFailureTime.IsZero() {
+		return false
+	}
+	backoffUntil := errInfo.LastFailureTime.Add(ComputeBackoffDuration(policy, errInfo.FailCount))
+	return requestTime.Before(backoffUntil)
+}
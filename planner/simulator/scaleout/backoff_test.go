@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"testing"
+	"time"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestComputeBackoffDuration(t *testing.T) {
+	policy := &sacorev1alpha1.BackoffPolicy{
+		InitialBackoffDuration: metav1.Duration{Duration: 2 * time.Minute},
+		MaxBackoffDuration:     metav1.Duration{Duration: 10 * time.Minute},
+	}
+	tests := []struct {
+		name      string
+		policy    *sacorev1alpha1.BackoffPolicy
+		failCount int32
+		want      time.Duration
+	}{
+		{name: "default policy first failure", policy: nil, failCount: 1, want: plannerapi.DefaultInitialBackoffDuration},
+		{name: "default policy capped", policy: nil, failCount: 100, want: plannerapi.DefaultMaxBackoffDuration},
+		{name: "zero fail count", policy: policy, failCount: 0, want: 2 * time.Minute},
+		{name: "first failure", policy: policy, failCount: 1, want: 2 * time.Minute},
+		{name: "second failure", policy: policy, failCount: 2, want: 4 * time.Minute},
+		{name: "third failure", policy: policy, failCount: 3, want: 8 * time.Minute},
+		{name: "capped at max", policy: policy, failCount: 4, want: 10 * time.Minute},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := ComputeBackoffDuration(tc.policy, tc.failCount); got != tc.want {
+				t.Errorf("ComputeBackoffDuration() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetBackedOffPlacements(t *testing.T) {
+	requestTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	pool := sacorev1alpha1.NodePool{
+		Name:              "a",
+		Region:            "eu-west-1",
+		AvailabilityZones: []string{"eu-west-1a", "eu-west-1b"},
+		NodeTemplates: []sacorev1alpha1.NodeTemplate{
+			{Name: "m5l", InstanceType: "m5.large"},
+			{Name: "m5l-spot", InstanceType: "m5.large", CapacityType: commontypes.CapacityTypeSpot},
+		},
+	}
+	placementA := sacorev1alpha1.NodePlacement{PoolName: "a", TemplateName: "m5l", InstanceType: "m5.large", Region: "eu-west-1", AvailabilityZone: "eu-west-1a"}
+	placementSpotA := placementA
+	placementSpotA.TemplateName = "m5l-spot"
+	placementSpotA.CapacityType = commontypes.CapacityTypeSpot
+	tests := []struct {
+		name    string
+		errInfo sacorev1alpha1.ScaleOutErrorInfo
+		want    []sacorev1alpha1.NodePlacement
+	}{
+		{
+			name:    "within backoff window",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: "eu-west-1a", InstanceType: "m5.large", FailCount: 1, LastFailureTime: metav1.NewTime(requestTime.Add(-30 * time.Second))},
+			want:    []sacorev1alpha1.NodePlacement{placementA},
+		},
+		{
+			name:    "backoff window elapsed",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: "eu-west-1a", InstanceType: "m5.large", FailCount: 1, LastFailureTime: metav1.NewTime(requestTime.Add(-2 * time.Minute))},
+		},
+		{
+			name:    "window grows with fail count",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: "eu-west-1a", InstanceType: "m5.large", FailCount: 3, LastFailureTime: metav1.NewTime(requestTime.Add(-2 * time.Minute))},
+			want:    []sacorev1alpha1.NodePlacement{placementA},
+		},
+		{
+			name:    "unset last failure time",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: "eu-west-1a", InstanceType: "m5.large", FailCount: 1},
+		},
+		{
+			name:    "explicit on-demand capacity type",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: "eu-west-1a", InstanceType: "m5.large", CapacityType: commontypes.CapacityTypeOnDemand, FailCount: 1, LastFailureTime: metav1.NewTime(requestTime.Add(-30 * time.Second))},
+			want:    []sacorev1alpha1.NodePlacement{placementA},
+		},
+		{
+			name:    "spot capacity type",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: "eu-west-1a", InstanceType: "m5.large", CapacityType: commontypes.CapacityTypeSpot, FailCount: 1, LastFailureTime: metav1.NewTime(requestTime.Add(-30 * time.Second))},
+			want:    []sacorev1alpha1.NodePlacement{placementSpotA},
+		},
+		{
+			name:    "other instance type",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{AvailabilityZone: "eu-west-1a", InstanceType: "m5.xlarge", FailCount: 1},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &plannerapi.Request{
+				CreationTime: requestTime,
+				Constraint: &sacorev1alpha1.ScalingConstraint{
+					Spec: sacorev1alpha1.ScalingConstraintSpec{NodePools: []sacorev1alpha1.NodePool{pool}},
+				},
+				Feedback: &sacorev1alpha1.ScalingFeedbackSpec{
+					ScaleOutErrorInfos: []sacorev1alpha1.ScaleOutErrorInfo{tc.errInfo},
+				},
+			}
+			got := GetBackedOffPlacements(req)
+			if got.Len() != len(tc.want) || !got.HasAll(tc.want...) {
+				t.Errorf("GetBackedOffPlacements() = %v, want %v", got.UnsortedList(), tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBackedOff(t *testing.T) {
+	requestTime := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		errInfo sacorev1alpha1.ScaleOutErrorInfo
+		want    bool
+	}{
+		{
+			name:    "zero last failure time",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{FailCount: 5},
+			want:    false,
+		},
+		{
+			name:    "failure within backoff window",
+			errInfo: sacorev1alpha1.ScaleOutErrorInfo{FailCount: 1, LastFailureTime: metav1.NewTime(requestTime.Add(-time.Second))},
+			want:    true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isBackedOff(requestTime, tc.errInfo, nil); got != tc.want {
+				t.Errorf("isBackedOff() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
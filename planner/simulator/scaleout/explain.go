@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// CollectPodSchedulingFailures collects the PodSchedulingFailures of the given scaleOutSimResults of one pass into the
+// given podSchedulingFailures. The distinct messages reported for a pod by the simulations of the pass replace the
+// messages collected for it in earlier passes.
+func CollectPodSchedulingFailures(podSchedulingFailures map[commontypes.NamespacedName][]string, scaleOutSimResults []plannerapi.ScaleOutSimResult) {
+	passFailures := make(map[commontypes.NamespacedName]sets.Set[string])
+	for _, sr := range scaleOutSimResults {
+		for podName, message := range sr.PodSchedulingFailures {
+			if passFailures[podName] == nil {
+				passFailures[podName] = sets.New[string]()
+			}
+			passFailures[podName].Insert(message)
+		}
+	}
+	for podName, messages := range passFailures {
+		podSchedulingFailures[podName] = sets.List(messages)
+	}
+}
+
+// createUnsatisfiedPodExplanations creates the explanations for the pods with the given full unsatisfiedPodNames from
+// the given podSchedulingFailures along with the explanation reasons aggregated by reason. Pods without scheduling
+// failures are not explained.
+func createUnsatisfiedPodExplanations(unsatisfiedPodNames []string, podSchedulingFailures map[commontypes.NamespacedName][]string) (explanations []sacorev1alpha1.UnsatisfiedPodExplanation, reasons []sacorev1alpha1.UnsatisfiedPodReason) {
+	messagesByPodName := make(map[string][]string, len(podSchedulingFailures))
+	for pod, messages := range podSchedulingFailures {
+		messagesByPodName[pod.String()] = messages
+	}
+	podNamesByReason := make(map[string][]string)
+	for _, podName := range unsatisfiedPodNames {
+		messages, ok := messagesByPodName[podName]
+		if !ok {
+			continue
+		}
+		podReasons := sets.New[string]()
+		for _, message := range messages {
+			podReasons.Insert(parseSchedulingFailureReasons(message)...)
+		}
+		explanation := sacorev1alpha1.UnsatisfiedPodExplanation{
+			PodName: podName,
+			Reasons: sets.List(podReasons),
+		}
+		for _, reason := range explanation.Reasons {
+			podNamesByReason[reason] = append(podNamesByReason[reason], explanation.PodName)
+		}
+		explanations = append(explanations, explanation)
+	}
+	slices.SortFunc(explanations, func(a, b sacorev1alpha1.UnsatisfiedPodExplanation) int {
+		return strings.Compare(a.PodName, b.PodName)
+	})
+	for _, reason := range slices.Sorted(maps.Keys(podNamesByReason)) {
+		podNames := podNamesByReason[reason]
+		slices.Sort(podNames)
+		reasons = append(reasons, sacorev1alpha1.UnsatisfiedPodReason{Reason: reason, PodNames: podNames})
+	}
+	return
+}
+
+// parseSchedulingFailureReasons parses the individual reasons from the given FailedScheduling message of the
+// kube-scheduler, stripping node counts and any sentences following the node reasons, such as preemption details. For
+// example, the message "0/3 nodes are available: 1 Insufficient cpu, 2 node(s) had untolerated taint {foo: bar}.
+// preemption: ..." yields
+// the reasons "Insufficient cpu" and "node(s) had untolerated taint {foo: bar}". Messages not listing node reasons are
+// returned as a single reason.
+func parseSchedulingFailureReasons(message string) []string {
+	message, _, _ = strings.Cut(message, " preemption:")
+	message = strings.TrimSuffix(strings.TrimSpace(message), ".")
+	_, nodeReasons, found := strings.Cut(message, "nodes are available: ")
+	if !found {
+		return []string{message}
+	}
+	nodeReasons, _, _ = strings.Cut(nodeReasons, ". ")
+	var reasons []string
+	for reason := range strings.SplitSeq(nodeReasons, ", ") {
+		reason = strings.TrimSpace(reason)
+		if count, rest, ok := strings.Cut(reason, " "); ok {
+			if _, err := strconv.Atoi(count); err == nil {
+				reason = rest
+			}
+		}
+		if reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"reflect"
+	"testing"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+)
+
+func TestParseSchedulingFailureReasons(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    []string
+	}{
+		{
+			name:    "single reason",
+			message: "0/1 nodes are available: 1 Insufficient memory. preemption: 0/1 nodes are available: 1 No preemption victims found for incoming pod.",
+			want:    []string{"Insufficient memory"},
+		},
+		{
+			name:    "multiple reasons",
+			message: "0/3 nodes are available: 1 Insufficient cpu, 2 node(s) had untolerated taint {foo: bar}. preemption: 0/3 nodes are available: 3 Preemption is not helpful for scheduling.",
+			want:    []string{"Insufficient cpu", "node(s) had untolerated taint {foo: bar}"},
+		},
+		{
+			name:    "trailing sentences",
+			message: "0/1 nodes are available: 1 Insufficient cpu, 1 Insufficient memory. no new claims to deallocate, preemption: 0/1 nodes are available: 1 Preemption is not helpful for scheduling.",
+			want:    []string{"Insufficient cpu", "Insufficient memory"},
+		},
+		{
+			name:    "no node reasons",
+			message: "no nodes available to schedule pods",
+			want:    []string{"no nodes available to schedule pods"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := parseSchedulingFailureReasons(tc.message); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("parseSchedulingFailureReasons() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCreateUnsatisfiedPodExplanations(t *testing.T) {
+	podA := commontypes.NamespacedName{Namespace: "default", Name: "a"}
+	podB := commontypes.NamespacedName{Namespace: "default", Name: "b"}
+	podC := commontypes.NamespacedName{Namespace: "default", Name: "c"}
+	podSchedulingFailures := make(map[commontypes.NamespacedName][]string)
+	// failures collected in a later pass replace the ones of earlier passes.
+	CollectPodSchedulingFailures(podSchedulingFailures, []plannerapi.ScaleOutSimResult{
+		{PodSchedulingFailures: map[commontypes.NamespacedName]string{podA: "0/1 nodes are available: 1 node(s) didn't match Pod's node affinity/selector."}},
+	})
+	CollectPodSchedulingFailures(podSchedulingFailures, []plannerapi.ScaleOutSimResult{
+		{PodSchedulingFailures: map[commontypes.NamespacedName]string{
+			podA: "0/2 nodes are available: 2 Insufficient memory.",
+			podB: "0/2 nodes are available: 1 Insufficient cpu, 1 Insufficient memory.",
+		}},
+		{PodSchedulingFailures: map[commontypes.NamespacedName]string{
+			podA: "0/2 nodes are available: 2 Insufficient memory.",
+		}},
+	})
+	if got := podSchedulingFailures[podA]; len(got) != 1 {
+		t.Errorf("collected failures of pod %q = %q, want one distinct message", podA, got)
+	}
+
+	explanations, reasons := createUnsatisfiedPodExplanations([]string{podB.String(), podA.String(), podC.String()}, podSchedulingFailures)
+	wantExplanations := []sacorev1alpha1.UnsatisfiedPodExplanation{
+		{PodName: podA.String(), Reasons: []string{"Insufficient memory"}},
+		{PodName: podB.String(), Reasons: []string{"Insufficient cpu", "Insufficient memory"}},
+	}
+	if !reflect.DeepEqual(explanations, wantExplanations) {
+		t.Errorf("explanations = %v, want %v", explanations, wantExplanations)
+	}
+	wantReasons := []sacorev1alpha1.UnsatisfiedPodReason{
+		{Reason: "Insufficient cpu", PodNames: []string{podB.String()}},
+		{Reason: "Insufficient memory", PodNames: []string{podA.String(), podB.String()}},
+	}
+	if !reflect.DeepEqual(reasons, wantReasons) {
+		t.Errorf("reasons = %v, want %v", reasons, wantReasons)
+	}
+}
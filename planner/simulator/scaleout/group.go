@@ -13,6 +13,7 @@ import (
 	"github.com/gardener/scaling-advisor/api/minkapi"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 	"github.com/gardener/scaling-advisor/common/ioutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -23,14 +24,19 @@ type simGroup struct {
 	name        string
 	simulations []plannerapi.ScaleOutSimulation
 	key         commontypes.PriorityKey
+	// sequential indicates whether the simulations are run one after the other in the order they were added.
+	sequential bool
 }
 
-// NewGroup creates a new ScaleOutSimGroup with the given name and simulation group key.
-func NewGroup(name string, key commontypes.PriorityKey, requestRef plannerapi.RequestRef) plannerapi.ScaleOutSimGroup {
+// NewGroup creates a new ScaleOutSimGroup with the given name and simulation group key. If sequential is true, the
+// simulations of the group are run one after the other in the order they were added instead of concurrently, which
+// makes the run numbers of the simulations reproducible.
+func NewGroup(name string, key commontypes.PriorityKey, requestRef plannerapi.RequestRef, sequential bool) plannerapi.ScaleOutSimGroup {
 	return &simGroup{
 		name:       name,
 		key:        key,
 		requestRef: requestRef,
+		sequential: sequential,
 	}
 }
 
@@ -50,14 +56,25 @@ func (g *simGroup) AddSimulation(sim plannerapi.ScaleOutSimulation) {
 	g.simulations = append(g.simulations, sim)
 }
 
+func (g *simGroup) DropSimulations(drop func(simulation plannerapi.ScaleOutSimulation) bool) int {
+	numBefore := len(g.simulations)
+	g.simulations = slices.DeleteFunc(g.simulations, drop)
+	return numBefore - len(g.simulations)
+}
+
 func (g *simGroup) Run(ctx context.Context, getViewFn minkapi.GetViewFunc) (runResults []plannerapi.ScaleOutSimResult, err error) {
+	// each run of the group is a pass of the group cycle of a simulator.
+	ctx, span := traceutil.StartSpan(ctx, "SimulationGroupPass", traceutil.AttrSimulationGroup.String(g.Name()))
 	defer func() {
 		if err != nil {
 			err = fmt.Errorf("%w: cannot run %q: %w", plannerapi.ErrRunSimulationGroup, g.Name(), err)
 		}
+		traceutil.EndSpan(span, err)
 	}()
 	eg, groupCtx := errgroup.WithContext(ctx)
-	// TODO: Create pool of similar NodeTemplate + Zone targets to scale and randomize over it so that we can have a balanced allocation across AZ.
+	if g.sequential {
+		eg.SetLimit(1)
+	}
 	for _, sim := range g.simulations {
 		eg.Go(func() error {
 			view, err := getViewFn(ctx, fmt.Sprintf("%s_%s", g.requestRef.ID, sim.Name()))
@@ -96,25 +113,23 @@ func asResettable(simulations []plannerapi.ScaleOutSimulation) []commontypes.Res
 	return resettable
 }
 
-// CreateScaleOutSimGroups groups the given ScaleOutSimulation instances into one or more SimulationGroups
-func CreateScaleOutSimGroups(requestRef plannerapi.RequestRef, simulations []plannerapi.ScaleOutSimulation) ([]plannerapi.ScaleOutSimGroup, error) {
-	groupsByKey := make(map[commontypes.PriorityKey]plannerapi.ScaleOutSimGroup)
-	groupCount := 0
+// CreateScaleOutSimGroups groups the given ScaleOutSimulation instances of the given request into one or more
+// SimulationGroups ordered by decreasing PriorityKey. The simulations of a group retain their given order. If the request
+// is deterministic, the simulations of each group are run sequentially, see NewGroup.
+func CreateScaleOutSimGroups(request *plannerapi.Request, simulations []plannerapi.ScaleOutSimulation) ([]plannerapi.ScaleOutSimGroup, error) {
+	var simGroups []plannerapi.ScaleOutSimGroup
 	for _, sim := range simulations {
 		pk := sim.PriorityKey()
-		g, ok := groupsByKey[pk]
-		if !ok {
-			groupCount++
-			name := fmt.Sprintf("sg-%d_%s", groupCount, pk.String())
-			g = NewGroup(name, pk, requestRef)
+		idx := slices.IndexFunc(simGroups, func(g plannerapi.ScaleOutSimGroup) bool {
+			return g.PriorityKey() == pk
+		})
+		if idx < 0 {
+			name := fmt.Sprintf("sg-%d_%s", len(simGroups)+1, pk.String())
+			simGroups = append(simGroups, NewGroup(name, pk, request.GetRef(), request.IsDeterministic()))
+			idx = len(simGroups) - 1
 		}
-		g.AddSimulation(sim)
-		groupsByKey[pk] = g
-	}
-	simGroups := make([]plannerapi.ScaleOutSimGroup, 0, len(groupsByKey))
-	for _, g := range groupsByKey {
-		simGroups = append(simGroups, g)
+		simGroups[idx].AddSimulation(sim)
 	}
-	slices.SortFunc(simGroups, plannerapi.CmpScaleOutSimGroup)
+	slices.SortStableFunc(simGroups, plannerapi.CmpScaleOutSimGroup)
 	return simGroups, nil
 }
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/api/pricing"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+)
+
+// CreateMinNodesScaleOutItems creates the [sacorev1alpha1.ScaleOutItem]'s required to bring the node templates and node
+// pools of the request constraint that are below their MinNodes up to it. The nodes of the request snapshot together
+// with the given plannedItems are considered as the current nodes.
+//
+// Node templates of a pool are filled up to their own MinNodes first. Any remaining deficit of the pool is then filled
+// using its node templates in order of priority. Nodes of a template are spread across the availability zones of the
+// pool by always choosing the zone with the least nodes. The MaxNodes of pools and templates are never exceeded and
+// placements backed off because of the request Feedback are never chosen. The HourlyCostDelta of the items is estimated
+// using the given pricingAccess.
+func CreateMinNodesScaleOutItems(ctx context.Context, req *plannerapi.Request, plannedItems []sacorev1alpha1.ScaleOutItem, pricingAccess pricing.InstancePricingAccess) ([]sacorev1alpha1.ScaleOutItem, error) {
+	existingNodeCountByPlacement, err := req.Snapshot.GetNodeCountByPlacement()
+	if err != nil {
+		return nil, err
+	}
+	nodeCountByPlacement := make(map[sacorev1alpha1.NodePlacement]int32, len(existingNodeCountByPlacement))
+	for placement, count := range existingNodeCountByPlacement {
+		nodeCountByPlacement[placement] = count
+	}
+	for _, item := range plannedItems {
+		nodeCountByPlacement[item.NodePlacement] += item.Delta
+	}
+	deltaByPlacement := make(map[sacorev1alpha1.NodePlacement]int32)
+	backedOffPlacements := GetBackedOffPlacements(req)
+	for _, pool := range req.Constraint.Spec.NodePools {
+		fillPoolToMinNodes(&pool, backedOffPlacements, nodeCountByPlacement, deltaByPlacement)
+	}
+	log := logr.FromContextOrDiscard(ctx)
+	items := make([]sacorev1alpha1.ScaleOutItem, 0, len(deltaByPlacement))
+	for placement, delta := range deltaByPlacement {
+		items = append(items, sacorev1alpha1.ScaleOutItem{
+			NodePlacement:   placement,
+			CurrentReplicas: existingNodeCountByPlacement[placement],
+			Delta:           delta,
+			HourlyCostDelta: getHourlyCostDelta(log, placement, delta, pricingAccess),
+		})
+	}
+	slices.SortFunc(items, func(a, b sacorev1alpha1.ScaleOutItem) int {
+		return sacorev1alpha1.CmpNodePlacement(a.NodePlacement, b.NodePlacement)
+	})
+	return items, nil
+}
+
+// MergeScaleOutItems merges the given additionalItems into the Items of the given scaleOutPlan by summing up the Delta
+// and HourlyCostDelta and joining the PodNames of items with the same NodePlacement. The HourlyCostDelta of a merged
+// item is unknown if that of either item is unknown. The TotalHourlyCostDelta of the scaleOutPlan is recomputed from
+// the merged items.
+func MergeScaleOutItems(scaleOutPlan *sacorev1alpha1.ScaleOutPlan, additionalItems []sacorev1alpha1.ScaleOutItem) {
+	for _, additional := range additionalItems {
+		idx := slices.IndexFunc(scaleOutPlan.Items, func(item sacorev1alpha1.ScaleOutItem) bool {
+			return item.NodePlacement == additional.NodePlacement
+		})
+		if idx < 0 {
+			scaleOutPlan.Items = append(scaleOutPlan.Items, additional)
+			continue
+		}
+		item := &scaleOutPlan.Items[idx]
+		item.Delta += additional.Delta
+		if item.HourlyCostDelta != nil && additional.HourlyCostDelta != nil {
+			item.HourlyCostDelta = ptr.To(*item.HourlyCostDelta + *additional.HourlyCostDelta)
+		} else {
+			item.HourlyCostDelta = nil
+		}
+		if len(additional.PodNames) > 0 {
+			item.PodNames = slices.Compact(slices.Sorted(slices.Values(append(item.PodNames, additional.PodNames...))))
+		}
+	}
+	scaleOutPlan.TotalHourlyCostDelta = sumHourlyCostDeltas(scaleOutPlan.Items)
+}
+
+// fillPoolToMinNodes adds deltas for the node templates of the given pool to deltaByPlacement and nodeCountByPlacement
+// until the MinNodes of the templates and the pool are satisfied or no further node can be added within MaxNodes to a
+// placement that is not backed off.
+func fillPoolToMinNodes(pool *sacorev1alpha1.NodePool, backedOffPlacements sets.Set[sacorev1alpha1.NodePlacement], nodeCountByPlacement, deltaByPlacement map[sacorev1alpha1.NodePlacement]int32) {
+	templates := slices.Clone(pool.NodeTemplates)
+	slices.SortStableFunc(templates, func(a, b sacorev1alpha1.NodeTemplate) int {
+		return cmp.Compare(a.Priority, b.Priority)
+	})
+	var poolCount int32
+	templateCounts := make(map[string]int32, len(templates))
+	for _, t := range templates {
+		for _, az := range pool.AvailabilityZones {
+			count := nodeCountByPlacement[newNodePlacement(pool, &t, az)]
+			templateCounts[t.Name] += count
+			poolCount += count
+		}
+	}
+	// leastPlacement returns the placement of the given template not backed off with the least nodes, if any.
+	leastPlacement := func(t *sacorev1alpha1.NodeTemplate) (placement sacorev1alpha1.NodePlacement, ok bool) {
+		for _, az := range pool.AvailabilityZones {
+			p := newNodePlacement(pool, t, az)
+			if backedOffPlacements.Has(p) {
+				continue
+			}
+			if !ok || nodeCountByPlacement[p] < nodeCountByPlacement[placement] {
+				placement, ok = p, true
+			}
+		}
+		return
+	}
+	canAdd := func(t *sacorev1alpha1.NodeTemplate) bool {
+		if pool.MaxNodes != nil && poolCount >= *pool.MaxNodes {
+			return false
+		}
+		if t.MaxNodes != nil && templateCounts[t.Name] >= *t.MaxNodes {
+			return false
+		}
+		_, ok := leastPlacement(t)
+		return ok
+	}
+	addNode := func(t *sacorev1alpha1.NodeTemplate) {
+		placement, _ := leastPlacement(t)
+		nodeCountByPlacement[placement]++
+		deltaByPlacement[placement]++
+		templateCounts[t.Name]++
+		poolCount++
+	}
+	for i := range templates {
+		t := &templates[i]
+		for t.MinNodes != nil && templateCounts[t.Name] < *t.MinNodes && canAdd(t) {
+			addNode(t)
+		}
+	}
+	if pool.MinNodes == nil {
+		return
+	}
+	for poolCount < *pool.MinNodes {
+		idx := slices.IndexFunc(templates, func(t sacorev1alpha1.NodeTemplate) bool {
+			return canAdd(&t)
+		})
+		if idx < 0 {
+			return
+		}
+		addNode(&templates[idx])
+	}
+}
+
+func newNodePlacement(pool *sacorev1alpha1.NodePool, template *sacorev1alpha1.NodeTemplate, zone string) sacorev1alpha1.NodePlacement {
+	return sacorev1alpha1.NodePlacement{
+		PoolName:         pool.Name,
+		TemplateName:     template.Name,
+		InstanceType:     template.InstanceType,
+		Region:           pool.Region,
+		AvailabilityZone: zone,
+		CapacityType:     template.CapacityType.Canonical(),
+	}
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"math"
+	"testing"
+
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/utils/ptr"
+)
+
+func TestMergeScaleOutItems(t *testing.T) {
+	placementA := sacorev1alpha1.NodePlacement{PoolName: "a", TemplateName: "m5l", InstanceType: "m5.large", Region: "eu-west-1", AvailabilityZone: "eu-west-1a"}
+	placementB := placementA
+	placementB.AvailabilityZone = "eu-west-1b"
+	scaleOutPlan := &sacorev1alpha1.ScaleOutPlan{
+		Items: []sacorev1alpha1.ScaleOutItem{
+			{NodePlacement: placementA, Delta: 1, HourlyCostDelta: ptr.To(0.1), PodNames: []string{"default/b"}},
+		},
+		TotalHourlyCostDelta: ptr.To(0.1),
+	}
+	MergeScaleOutItems(scaleOutPlan, []sacorev1alpha1.ScaleOutItem{
+		{NodePlacement: placementA, Delta: 2, HourlyCostDelta: ptr.To(0.2), PodNames: []string{"default/a"}},
+		{NodePlacement: placementB, Delta: 1, HourlyCostDelta: ptr.To(0.1)},
+	})
+	wantItems := []sacorev1alpha1.ScaleOutItem{
+		{NodePlacement: placementA, Delta: 3, HourlyCostDelta: ptr.To(0.3), PodNames: []string{"default/a", "default/b"}},
+		{NodePlacement: placementB, Delta: 1, HourlyCostDelta: ptr.To(0.1)},
+	}
+	if diff := cmp.Diff(wantItems, scaleOutPlan.Items, cmpopts.EquateApprox(0, 1e-9)); diff != "" {
+		t.Errorf("items mismatch (-want +got):\n%s", diff)
+	}
+	if got := scaleOutPlan.TotalHourlyCostDelta; got == nil || math.Abs(*got-0.4) > 1e-9 {
+		t.Errorf("TotalHourlyCostDelta = %v, want %v", got, 0.4)
+	}
+
+	MergeScaleOutItems(scaleOutPlan, []sacorev1alpha1.ScaleOutItem{
+		{NodePlacement: placementB, Delta: 1},
+	})
+	if got := scaleOutPlan.Items[1].HourlyCostDelta; got != nil {
+		t.Errorf("HourlyCostDelta of item merged with unpriced item = %v, want nil", *got)
+	}
+	if got := scaleOutPlan.TotalHourlyCostDelta; got != nil {
+		t.Errorf("TotalHourlyCostDelta = %v with unpriced item, want nil", *got)
+	}
+}
@@ -0,0 +1,305 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package multinode provides implementation and helper routines of a ScaleOutSimulator that performs a single simulation
+// per priority group which scales multiple nodes at a time.
+package multinode
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/gardener/scaling-advisor/planner/simulator/scaleout"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/api/pricing"
+	"github.com/gardener/scaling-advisor/common/ioutil"
+	"github.com/gardener/scaling-advisor/common/logutil"
+	"github.com/gardener/scaling-advisor/common/nodeutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
+	"github.com/gardener/scaling-advisor/common/viewutil"
+	"github.com/go-logr/logr"
+)
+
+var (
+	_ plannerapi.ScaleOutSimulator = (*simulatorSingleSim)(nil)
+)
+
+// simulatorSingleSim is a Simulator that implements ScaleOutSimulator for the SimulatorStrategyMultiNodeSingleSim.
+type simulatorSingleSim struct {
+	viewAccess        minkapi.ViewAccess
+	schedulerLauncher plannerapi.SchedulerLauncher
+	storageMetaAccess plannerapi.StorageMetaAccess
+	nodeScorer        plannerapi.NodeScorer
+	pricingAccess     pricing.InstancePricingAccess
+	state             *scaleout.SimulatorState
+	simulatorConfig   plannerapi.SimulatorConfig
+}
+
+// New creates a new plannerapi.ScaleOutSimulator that runs a single simulation per priority group, scaling multiple
+// nodes within the simulation until the kube-scheduler stops binding pods.
+func New(args plannerapi.SimulatorArgs) (plannerapi.ScaleOutSimulator, error) {
+	if err := scaleout.ValidateSimulatorArgs(args); err != nil {
+		return nil, err
+	}
+	return &simulatorSingleSim{
+		simulatorConfig:   args.Config,
+		viewAccess:        args.ViewAccess,
+		schedulerLauncher: args.SchedulerLauncher,
+		storageMetaAccess: args.StorageMetaAccess,
+		nodeScorer:        args.NodeScorer,
+		pricingAccess:     args.PricingAccess,
+	}, nil
+}
+
+// Simulate constructs one ScaleOutSimulation for each priority group of ScaleOutNodeTemplate's, wraps each of them
+// into a ScaleOutSimGroup and runs the groups in order. Each simulation keeps adding scale-out nodes for its templates
+// until the kube-scheduler stops binding pods. Every scale-out node that received pods is scored by the NodeScorer and
+// becomes a winner NodeScore of the ScaleOutSimGroupCycleResult. Leftover unscheduled pods are carried over to the next
+// group. If the ScalingAdviceGenerationMode is Incremental, a ScaleOutPlanResult is produced for each group cycle result
+// and sent on the planResultCh, otherwise a cumulative ScaleOutPlanResult is sent after all groups have been run.
+func (s *simulatorSingleSim) Simulate(ctx context.Context, request *plannerapi.Request, simulationFactory plannerapi.SimulationFactory) <-chan plannerapi.ScaleOutPlanResult {
+	s.state = scaleout.NewSimulatorState(request, s.simulatorConfig, simulationFactory, s.viewAccess)
+	go func() {
+		defer close(s.state.ResultCh)
+		if err := s.doSimulate(ctx); err != nil {
+			scaleout.SendPlanError(s.state.ResultCh, request.GetRef(), err)
+		}
+	}()
+	return s.state.ResultCh
+}
+
+func (s *simulatorSingleSim) doSimulate(ctx context.Context) (err error) {
+	if err = s.state.InitializeRequestView(ctx); err != nil {
+		return
+	}
+	s.state.SimulationGroups, err = s.createAndGroupSimulations(ctx)
+	if err != nil {
+		return
+	}
+	err = s.runAllGroups(ctx)
+	return
+}
+
+// Close closes all the resources of this simulator's state: all simulation minkapi views, resets simulation run counters,
+// clears any ScaleOutSimGroup's, clears the planner Request, etc.
+func (s *simulatorSingleSim) Close() error {
+	return s.state.Reset()
+}
+
+func (s *simulatorSingleSim) createAndGroupSimulations(ctx context.Context) ([]plannerapi.ScaleOutSimGroup, error) {
+	var (
+		allScaleOutNodeTemplates = scaleout.CreateEligibleNodeTemplates(ctx, s.state.Request)
+		templatesByPriority      = scaleout.GroupScaleOutNodeTemplatesByPriority(allScaleOutNodeTemplates)
+		allSimulations           = make([]plannerapi.ScaleOutSimulation, 0, len(templatesByPriority))
+	)
+	priorityKeys := slices.SortedFunc(maps.Keys(templatesByPriority), commontypes.CmpPriorityKeyDecreasing)
+	for i, pk := range priorityKeys {
+		simulationName := fmt.Sprintf("sim-%d_%s", i, pk.String())
+		simArgs := plannerapi.ScaleOutSimArgs{
+			Name:              simulationName,
+			RunCounter:        s.state.SimRunCounter,
+			SchedulerLauncher: s.schedulerLauncher,
+			StorageMetaAccess: s.storageMetaAccess,
+			Config:            s.simulatorConfig,
+			NodeTemplates:     templatesByPriority[pk],
+			DaemonSetPods:     s.state.Request.Snapshot.DaemonSetPods,
+			SchedulerProfile:  s.state.Request.SchedulerProfile,
+			Strategy:          commontypes.SimulatorStrategyMultiNodeSingleSim,
+		}
+		sim, err := s.state.SimulationFactory.NewScaleOut(simArgs)
+		if err != nil {
+			return nil, err
+		}
+		allSimulations = append(allSimulations, sim)
+	}
+	return scaleout.CreateScaleOutSimGroups(s.state.Request, allSimulations)
+}
+
+// runAllGroups runs all simulation groups in order until there are no leftover unscheduled pods or the context is done.
+// If the request AdviceGenerationMode is Incremental, after running each group it will construct a ScaleOutPlanResult
+// from the group's cycle result and send it over the simulator's result channel.
+// If the request AdviceGenerationMode is AllAtOnce, after running all groups it will construct a ScaleOutPlanResult
+// from all group cycle results and send it over the simulator's result channel.
+func (s *simulatorSingleSim) runAllGroups(ctx context.Context) (err error) {
+	var (
+		allWinnerNodeScores     []plannerapi.NodeScore
+		simGroupCycleResult     plannerapi.ScaleOutSimGroupCycleResult
+		allSimGroupCycleResults []plannerapi.ScaleOutSimGroupCycleResult
+		quotaCycleResults       []plannerapi.ScaleOutSimGroupCycleResult // cycle results without winners that exhausted pool quotas
+		log                     = logr.FromContextOrDiscard(ctx)
+	)
+	simGroupCycleResult.NextGroupPassView = s.state.RequestView()
+	for groupIndex, group := range s.state.SimulationGroups {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			if scaleout.IsAdviceGenerationTimeout(ctx) {
+				err = s.sendPartialPlanResult(ctx, allSimGroupCycleResults, quotaCycleResults, err)
+			}
+			return
+		default:
+		}
+		log := log.WithValues("groupIndex", groupIndex, "groupName", group.Name()) // in-loop log enhanced with further params
+		grpCtx := logr.NewContext(ctx, log)
+		log.V(3).Info("Invoking runGroup")
+		simGroupCycleResult, err = s.runGroup(grpCtx, simGroupCycleResult.NextGroupPassView, group)
+		if err != nil && scaleout.IsAdviceGenerationTimeout(ctx) {
+			err = s.sendPartialPlanResult(ctx, allSimGroupCycleResults, quotaCycleResults, err)
+			return
+		}
+		if err != nil {
+			err = fmt.Errorf("failed to run group %q: %w", group.Name(), err)
+			return
+		}
+		if len(simGroupCycleResult.WinnerNodeScores) == 0 {
+			log.V(2).Info("No winning node scores produced for group. Continuing to next group.")
+			if len(simGroupCycleResult.QuotaExhaustedPlacements) > 0 {
+				quotaCycleResults = append(quotaCycleResults, simGroupCycleResult)
+				allSimGroupCycleResults = append(allSimGroupCycleResults, simGroupCycleResult)
+			}
+			continue
+		}
+		allWinnerNodeScores = append(allWinnerNodeScores, simGroupCycleResult.WinnerNodeScores...)
+		if s.state.Request.AdviceGenerationMode.IsIncremental() {
+			log.V(4).Info("Sending ScalingPlanResult", "adviceGenerationMode", s.state.Request.AdviceGenerationMode)
+			if err = scaleout.SendPlanResult(ctx, s.state.ResultCh, s.state.Request, s.state.SimRunCounter.Load(),
+				append(quotaCycleResults, simGroupCycleResult), s.pricingAccess); err != nil {
+				return
+			}
+			quotaCycleResults = nil
+		}
+		allSimGroupCycleResults = append(allSimGroupCycleResults, simGroupCycleResult)
+		if len(simGroupCycleResult.LeftoverUnscheduledPods) == 0 {
+			log.V(2).Info("Ending further runGroup since there are no LeftoverUnscheduledPods.")
+			break
+		}
+	}
+	if len(allWinnerNodeScores) == 0 {
+		log.V(3).Info("No winning node scores produced by any simulation group.")
+		err = plannerapi.ErrNoScaleOutPlan
+		return
+	}
+	if s.state.Request.AdviceGenerationMode.IsAllAtOnce() {
+		log.V(4).Info("Sending ScalingPlanResult", "adviceGenerationMode", s.state.Request.AdviceGenerationMode)
+		err = scaleout.SendPlanResult(ctx, s.state.ResultCh, s.state.Request, s.state.SimRunCounter.Load(), allSimGroupCycleResults, s.pricingAccess)
+	}
+	return
+}
+
+// sendPartialPlanResult sends a partial ScaleOutPlanResult when the AdviceGenerationTimeout of the request elapsed. Only
+// the cycle results of the groups completed before the timeout that have not yet been sent are considered.
+func (s *simulatorSingleSim) sendPartialPlanResult(ctx context.Context, allSimGroupCycleResults, quotaCycleResults []plannerapi.ScaleOutSimGroupCycleResult, err error) error {
+	partialResults := allSimGroupCycleResults
+	if s.state.Request.AdviceGenerationMode.IsIncremental() {
+		partialResults = quotaCycleResults
+	}
+	return scaleout.SendPartialPlanResult(ctx, s.state.ResultCh, s.state.Request, s.state.SimRunCounter.Load(), partialResults, s.pricingAccess, err)
+}
+
+// runGroup runs the single simulation of the given group once over the provided groupPassView and scores each scale-out
+// node that was assigned pods. Since the simulation scales nodes until the kube-scheduler stops binding pods, one pass is
+// sufficient for the group. If the simulation produced winner node scores, the simulation view becomes the
+// NextGroupPassView of the returned cycle result, otherwise the given groupPassView is retained.
+func (s *simulatorSingleSim) runGroup(ctx context.Context, groupPassView minkapi.View, group plannerapi.ScaleOutSimGroup) (cycleResult plannerapi.ScaleOutSimGroupCycleResult, err error) {
+	ctx, span := traceutil.StartSpan(ctx, "SimulationGroupCycle", traceutil.AttrSimulationGroup.String(group.Name()), traceutil.AttrPassNum.Int(1))
+	defer func() {
+		traceutil.EndSpan(span, err)
+	}()
+	log := logr.FromContextOrDiscard(ctx)
+	cycleResult.Name = group.Name()
+	cycleResult.NextGroupPassView = groupPassView
+	cycleResult.PassNum = 1
+	scaleOutSimResults, err := group.Run(ctx, func(ctx context.Context, name string) (minkapi.View, error) {
+		return s.state.CreateSandboxView(ctx, name, groupPassView)
+	})
+	if err != nil {
+		return
+	}
+	cycleResult.PodSchedulingFailures = make(map[commontypes.NamespacedName][]string)
+	scaleout.CollectPodSchedulingFailures(cycleResult.PodSchedulingFailures, scaleOutSimResults)
+	for _, sr := range scaleOutSimResults {
+		cycleResult.QuotaExhaustedPlacements = append(cycleResult.QuotaExhaustedPlacements, sr.QuotaExhaustedPlacements...)
+		var nodeScores []plannerapi.NodeScore
+		nodeScores, err = s.computeNodeScores(ctx, group.Name(), groupPassView, sr)
+		if err != nil {
+			return
+		}
+		if len(nodeScores) == 0 {
+			log.V(2).Info("No NodePodAssignments for simulation, skipping NodeScoring", "simulationName", sr.Name)
+			continue
+		}
+		cycleResult.WinnerNodeScores = append(cycleResult.WinnerNodeScores, nodeScores...)
+		cycleResult.LeftoverUnscheduledPods = sr.LeftoverUnscheduledPods
+		cycleResult.NextGroupPassView = sr.View
+	}
+	if len(cycleResult.WinnerNodeScores) == 0 {
+		return
+	}
+	if logutil.VerbosityFromContext(ctx) > 3 {
+		if err = viewutil.LogObjects(ctx, "post_runGroup", cycleResult.NextGroupPassView); err != nil {
+			return
+		}
+	}
+	err = ioutil.ResetAll(cycleResult.NextGroupPassView.GetEventSink(), group)
+	if err != nil {
+		err = fmt.Errorf("cannot reset event sink of view %q and/or simulation group %q: %w", cycleResult.NextGroupPassView.GetName(), group.Name(), err)
+	}
+	return
+}
+
+// computeNodeScores invokes the NodeScorer for each scale-out node of the given ScaleOutSimResult that was assigned
+// pods and returns the resulting NodeScore's along with their zone balance info from the given groupPassView (see
+// scaleout.SetZoneBalanceInfo).
+func (s *simulatorSingleSim) computeNodeScores(ctx context.Context, simulationGroupName string, groupPassView minkapi.View, simResult plannerapi.ScaleOutSimResult) ([]plannerapi.NodeScore, error) {
+	if len(simResult.NodePodAssignments) == 0 {
+		return nil, nil
+	}
+	nodeNames := make([]string, 0, len(simResult.NodePodAssignments))
+	for _, npa := range simResult.NodePodAssignments {
+		nodeNames = append(nodeNames, npa.NodeResources.Name)
+	}
+	nodes, err := simResult.View.ListNodes(ctx, nodeNames...)
+	if err != nil {
+		return nil, err
+	}
+	nodeInfosByName := make(map[string]plannerapi.NodeInfo, len(nodes))
+	for _, n := range nodes {
+		nodeInfosByName[n.Name] = nodeutil.AsNodeInfo(n)
+	}
+	nodeScores := make([]plannerapi.NodeScore, 0, len(simResult.NodePodAssignments))
+	for i := range simResult.NodePodAssignments {
+		npa := &simResult.NodePodAssignments[i]
+		nodeInfo, ok := nodeInfosByName[npa.NodeResources.Name]
+		if !ok {
+			return nil, fmt.Errorf("%w: scale-out node %q of simulation %q not found in view %q",
+				plannerapi.ErrComputeNodeScore, npa.NodeResources.Name, simResult.Name, simResult.View.GetName())
+		}
+		placement, err := nodeInfo.GetNodePlacement()
+		if err != nil {
+			return nil, fmt.Errorf("%w: cannot get placement of scale-out node %q of simulation %q: %w",
+				plannerapi.ErrComputeNodeScore, npa.NodeResources.Name, simResult.Name, err)
+		}
+		nodeScore, err := s.nodeScorer.Compute(plannerapi.NodeScorerArgs{
+			ID:                      fmt.Sprintf("%s_%s", simResult.Name, npa.NodeResources.Name),
+			ScaledNodePlacement:     placement,
+			ScaledNodePodAssignment: npa,
+			OtherNodePodAssignments: simResult.OtherNodePodAssignments,
+			LeftOverUnscheduledPods: simResult.LeftoverUnscheduledPods,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("%w: node scoring failed for node %q of simulation %q of group %q: %w",
+				plannerapi.ErrComputeNodeScore, npa.NodeResources.Name, simResult.Name, simulationGroupName, err)
+		}
+		nodeScores = append(nodeScores, nodeScore)
+	}
+	if err = scaleout.SetZoneBalanceInfo(ctx, s.state.Request, groupPassView, nodeScores); err != nil {
+		return nil, err
+	}
+	return nodeScores, nil
+}
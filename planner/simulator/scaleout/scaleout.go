@@ -9,6 +9,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -21,10 +23,15 @@ import (
 	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
 	"github.com/gardener/scaling-advisor/api/minkapi"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/api/pricing"
+	"github.com/gardener/scaling-advisor/common/nodeutil"
 	"github.com/gardener/scaling-advisor/common/objutil"
 	"github.com/gardener/scaling-advisor/common/viewutil"
 	"github.com/gardener/scaling-advisor/common/volutil"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
 )
 
 var (
@@ -103,6 +110,24 @@ func (s *SimulatorState) CreateSandboxView(ctx context.Context, name string, del
 	return sandboxView, nil
 }
 
+// CloseViews closes the given views created by CreateSandboxView and removes them from this state.
+func (s *SimulatorState) CloseViews(views ...minkapi.View) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var errs []error
+	for _, v := range views {
+		idx := slices.Index(s.views, v)
+		if idx < 0 {
+			continue
+		}
+		s.views = slices.Delete(s.views, idx, idx+1)
+		if err := v.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
 // RequestView gets the request minkapi view within this state. request Views are views that only have the request
 // cluster snapshot populated within them along with any initialization done by InitializeRequestView.
 func (s *SimulatorState) RequestView() minkapi.View {
@@ -140,41 +165,88 @@ func SendPlanError(planResultCh chan<- plannerapi.ScaleOutPlanResult, requestRef
 }
 
 // SendPlanResult creates a plannerapi.ScaleOutPlanResult from the given plannerapi.Request and plannerapi.SimulationGroupCycleResults
-// and sends this result to the resultCh.
+// and sends this result to the resultCh. The given pricingAccess is used to estimate the hourly cost of the plan. The
+// plannerapi.Metrics of the result hold the given simulationRunCount and the passes of each of the groupCycleResults.
 func SendPlanResult(ctx context.Context, resultCh chan<- plannerapi.ScaleOutPlanResult,
-	req *plannerapi.Request, simulationRunCount uint32, // TODO: introduce a plannerapi.Metrics.
-	groupCycleResults []plannerapi.ScaleOutSimGroupCycleResult) error {
+	req *plannerapi.Request, simulationRunCount uint32,
+	groupCycleResults []plannerapi.ScaleOutSimGroupCycleResult, pricingAccess pricing.InstancePricingAccess) error {
+	return sendPlanResult(ctx, resultCh, req, simulationRunCount, CreatePlanLabels(req, simulationRunCount), groupCycleResults, pricingAccess)
+}
+
+// IsAdviceGenerationTimeout checks whether the given context is done because the AdviceGenerationTimeout of the
+// request elapsed.
+func IsAdviceGenerationTimeout(ctx context.Context) bool {
+	return errors.Is(context.Cause(ctx), plannerapi.ErrAdviceGenerationTimeout)
+}
+
+// SendPartialPlanResult is used by a ScaleOutSimulator whose context is done because of the AdviceGenerationTimeout of
+// the request. It creates a plannerapi.ScaleOutPlanResult labelled with LabelPartialPlan from the given
+// groupCycleResults accumulated so far and sends it to the resultCh. If none of the groupCycleResults has winner node
+// scores, no result is sent and the given err is returned wrapped with plannerapi.ErrAdviceGenerationTimeout.
+func SendPartialPlanResult(ctx context.Context, resultCh chan<- plannerapi.ScaleOutPlanResult, req *plannerapi.Request,
+	simulationRunCount uint32, groupCycleResults []plannerapi.ScaleOutSimGroupCycleResult, pricingAccess pricing.InstancePricingAccess, err error) error {
+	if !slices.ContainsFunc(groupCycleResults, func(gcr plannerapi.ScaleOutSimGroupCycleResult) bool {
+		return len(gcr.WinnerNodeScores) > 0
+	}) {
+		return fmt.Errorf("%w: scale-out plan generation incomplete within %s: %w", plannerapi.ErrAdviceGenerationTimeout, req.AdviceGenerationTimeout, err)
+	}
+	logr.FromContextOrDiscard(ctx).Info("Advice generation timeout elapsed, sending partial ScaleOutPlanResult", "error", err)
+	labels := CreatePlanLabels(req, simulationRunCount)
+	labels[commonconstants.LabelPartialPlan] = strconv.FormatBool(true)
+	return sendPlanResult(ctx, resultCh, req, simulationRunCount, labels, groupCycleResults, pricingAccess)
+}
+
+func sendPlanResult(ctx context.Context, resultCh chan<- plannerapi.ScaleOutPlanResult, req *plannerapi.Request,
+	simulationRunCount uint32, labels map[string]string, groupCycleResults []plannerapi.ScaleOutSimGroupCycleResult, pricingAccess pricing.InstancePricingAccess) error {
 	log := logr.FromContextOrDiscard(ctx)
 	existingNodeCountByPlacement, err := req.Snapshot.GetNodeCountByPlacement()
 	if err != nil {
 		return err
 	}
-	planGenerateDuration := time.Since(req.CreationTime)
-	numUnscheduledPods := len(req.Snapshot.GetUnscheduledPods())
-	labels := map[string]string{
-		commonconstants.LabelRequestID:                  req.ID,
-		commonconstants.LabelCorrelationID:              req.CorrelationID,
-		commonconstants.LabelTotalSimulationRuns:        fmt.Sprintf("%d", simulationRunCount),
-		commonconstants.LabelPlanGenerateDuration:       planGenerateDuration.String(),
-		commonconstants.LabelSnapshotNumUnscheduledPods: strconv.Itoa(numUnscheduledPods),
-		commonconstants.LabelConstraintNumPools:         strconv.Itoa(len(req.Constraint.Spec.NodePools)),
-	}
+	metrics := plannerapi.Metrics{SimulationRuns: simulationRunCount}
 	var allWinnerNodeScores []plannerapi.NodeScore
 	var leftOverUnscheduledPods []commontypes.NamespacedName
+	quotaExhaustedPlacements := sets.New[sacorev1alpha1.NodePlacement]()
+	podSchedulingFailures := make(map[commontypes.NamespacedName][]string)
 	for _, gcr := range groupCycleResults {
+		metrics.GroupPasses = append(metrics.GroupPasses, gcr.PassNum)
+		quotaExhaustedPlacements.Insert(gcr.QuotaExhaustedPlacements...)
+		maps.Copy(podSchedulingFailures, gcr.PodSchedulingFailures)
+		if len(gcr.WinnerNodeScores) == 0 {
+			continue
+		}
 		allWinnerNodeScores = append(allWinnerNodeScores, gcr.WinnerNodeScores...)
 		leftOverUnscheduledPods = gcr.LeftoverUnscheduledPods
 	}
-	scaleOutPlan := createScaleOutPlan(allWinnerNodeScores, existingNodeCountByPlacement, leftOverUnscheduledPods)
+	scaleOutPlan := createScaleOutPlan(log, allWinnerNodeScores, existingNodeCountByPlacement, leftOverUnscheduledPods, pricingAccess)
+	if quotaExhaustedPlacements.Len() > 0 {
+		splitQuotaUnsatisfiedPods(&scaleOutPlan, req, leftOverUnscheduledPods, quotaExhaustedPlacements)
+	}
+	scaleOutPlan.UnsatisfiedPodExplanations, scaleOutPlan.UnsatisfiedPodReasons = createUnsatisfiedPodExplanations(scaleOutPlan.UnsatisfiedPodNames, podSchedulingFailures)
 	planResult := plannerapi.ScaleOutPlanResult{
 		Labels:       labels,
 		ScaleOutPlan: &scaleOutPlan,
+		Metrics:      metrics,
 	}
 	log.V(2).Info("Sent Planner Success Response", "response", planResult)
 	resultCh <- planResult
 	return nil
 }
 
+// CreatePlanLabels creates the labels of a ScaleOutPlanResult for the given planner Request and simulationRunCount.
+func CreatePlanLabels(req *plannerapi.Request, simulationRunCount uint32) map[string]string {
+	planGenerateDuration := time.Since(req.CreationTime)
+	numUnscheduledPods := len(req.Snapshot.GetUnscheduledPods())
+	return map[string]string{
+		commonconstants.LabelRequestID:                  req.ID,
+		commonconstants.LabelCorrelationID:              req.CorrelationID,
+		commonconstants.LabelTotalSimulationRuns:        fmt.Sprintf("%d", simulationRunCount),
+		commonconstants.LabelPlanGenerateDuration:       planGenerateDuration.String(),
+		commonconstants.LabelSnapshotNumUnscheduledPods: strconv.Itoa(numUnscheduledPods),
+		commonconstants.LabelConstraintNumPools:         strconv.Itoa(len(req.Constraint.Spec.NodePools)),
+	}
+}
+
 // CreateAllNodeTemplates creates a slice of all possible [plannerapi.ScaleOutNodeTemplate] for the given slice of
 // [sacorev1alpha1.NodePool].
 func CreateAllNodeTemplates(pools []sacorev1alpha1.NodePool) []plannerapi.ScaleOutNodeTemplate {
@@ -189,22 +261,52 @@ func CreateAllNodeTemplates(pools []sacorev1alpha1.NodePool) []plannerapi.ScaleO
 	return allNodeTemplates
 }
 
+// CreateEligibleNodeTemplates creates a slice of the [plannerapi.ScaleOutNodeTemplate] for the node pools of the
+// request constraint whose placements are not backed off because of the request Feedback (see GetBackedOffPlacements).
+// If all templates of a priority are backed off, scale-out hence falls back to the templates of the next priority.
+func CreateEligibleNodeTemplates(ctx context.Context, req *plannerapi.Request) []plannerapi.ScaleOutNodeTemplate {
+	allNodeTemplates := CreateAllNodeTemplates(req.Constraint.Spec.NodePools)
+	backedOffPlacements := GetBackedOffPlacements(req)
+	if backedOffPlacements.Len() == 0 {
+		return allNodeTemplates
+	}
+	logr.FromContextOrDiscard(ctx).V(2).Info("Excluding backed off node placements from scale-out", "backedOffPlacements", backedOffPlacements.UnsortedList())
+	return slices.DeleteFunc(allNodeTemplates, func(t plannerapi.ScaleOutNodeTemplate) bool {
+		return backedOffPlacements.Has(t.NodePlacement)
+	})
+}
+
 // GroupScaleOutNodeTemplatesByPriority does just exactly that and returns a map keyed by PriorityKey to slice of
 // [plannerapi.ScaleOutNodeTemplate]
 func GroupScaleOutNodeTemplatesByPriority(templates []plannerapi.ScaleOutNodeTemplate) map[commontypes.PriorityKey][]plannerapi.ScaleOutNodeTemplate {
 	templatesByPriority := make(map[commontypes.PriorityKey][]plannerapi.ScaleOutNodeTemplate)
 	for _, t := range templates {
 		pk := t.PriorityKey
-		group, ok := templatesByPriority[pk]
-		if !ok {
-			group = []plannerapi.ScaleOutNodeTemplate{t}
-		}
-		group = append(group, t)
-		templatesByPriority[pk] = group
+		templatesByPriority[pk] = append(templatesByPriority[pk], t)
 	}
 	return templatesByPriority
 }
 
+// ValidateSimulatorArgs validates the common [plannerapi.SimulatorArgs] required by all ScaleOutSimulator implementations.
+func ValidateSimulatorArgs(args plannerapi.SimulatorArgs) error {
+	if args.ViewAccess == nil {
+		return fmt.Errorf("%w: view access is required", plannerapi.ErrCreateSimulator)
+	}
+	if args.NodeScorer == nil {
+		return fmt.Errorf("%w: node scorer is required", plannerapi.ErrCreateSimulator)
+	}
+	if args.SchedulerLauncher == nil {
+		return fmt.Errorf("%w: scheduler launcher is required", plannerapi.ErrCreateSimulator)
+	}
+	if args.StorageMetaAccess == nil {
+		return fmt.Errorf("%w: storage meta access is required", plannerapi.ErrCreateSimulator)
+	}
+	if args.PricingAccess == nil {
+		return fmt.Errorf("%w: pricing access is required", plannerapi.ErrCreateSimulator)
+	}
+	return nil
+}
+
 // createNodeTemplate creates a [plannerapi.ScaleOutNodeTemplate] for the given [sacorev1alpha1.NodePool],
 // [sacorev1alpha1.NodeTemplate] and availability zone.
 func createNodeTemplate(pool sacorev1alpha1.NodePool, template sacorev1alpha1.NodeTemplate, zone string) plannerapi.ScaleOutNodeTemplate {
@@ -215,11 +317,15 @@ func createNodeTemplate(pool sacorev1alpha1.NodePool, template sacorev1alpha1.No
 			InstanceType:     template.InstanceType,
 			Region:           pool.Region,
 			AvailabilityZone: zone,
+			CapacityType:     template.CapacityType.Canonical(),
 		},
-		Labels:      pool.Labels,
-		Annotations: pool.Annotations,
-		Quota:       pool.Quota,
-		Taints:      pool.Taints,
+		Labels:       pool.Labels,
+		Annotations:  pool.Annotations,
+		Quota:        pool.Quota,
+		PoolMaxNodes: pool.MaxNodes,
+		MaxNodes:     template.MaxNodes,
+		MaxVolumes:   template.MaxVolumes,
+		Taints:       pool.Taints,
 		PriorityKey: commontypes.PriorityKey{
 			First:  pool.Priority,
 			Second: template.Priority,
@@ -241,22 +347,92 @@ func (s *SimulatorState) createRequestView(ctx context.Context, viewAccess minka
 }
 
 // createScaleOutPlan creates a ScaleOutPlan based on the given winningNodeScores, existingNodeCountByPlacement and leftoverUnscheduledPods.
-func createScaleOutPlan(winningNodeScores []plannerapi.NodeScore, existingNodeCountByPlacement map[sacorev1alpha1.NodePlacement]int32, leftoverUnscheduledPods []commontypes.NamespacedName) sacorev1alpha1.ScaleOutPlan {
+// The hourly cost of the plan is estimated using the given pricingAccess (see getHourlyCostDelta).
+func createScaleOutPlan(log logr.Logger, winningNodeScores []plannerapi.NodeScore, existingNodeCountByPlacement map[sacorev1alpha1.NodePlacement]int32, leftoverUnscheduledPods []commontypes.NamespacedName, pricingAccess pricing.InstancePricingAccess) sacorev1alpha1.ScaleOutPlan {
 	scaleItems := make([]sacorev1alpha1.ScaleOutItem, 0, len(winningNodeScores))
 	nodeScoresByPlacement := groupNodeScoresByNodePlacement(winningNodeScores)
 	for placement, nodeScores := range nodeScoresByPlacement {
 		delta := int32(len(nodeScores)) // #nosec G115 -- length of nodeScores cannot be greater than max int32.
 		currentReplicas := existingNodeCountByPlacement[placement]
+		hourlyCostDelta := getHourlyCostDelta(log, placement, delta, pricingAccess)
+		var podNames []commontypes.NamespacedName
+		for _, ns := range nodeScores {
+			podNames = append(podNames, ns.ScheduledPods...)
+		}
+		podFullNames := objutil.GetFullNames(podNames)
+		slices.Sort(podFullNames)
 		scaleItems = append(scaleItems, sacorev1alpha1.ScaleOutItem{
 			NodePlacement:   placement,
 			CurrentReplicas: currentReplicas,
 			Delta:           delta,
+			HourlyCostDelta: hourlyCostDelta,
+			PodNames:        podFullNames,
 		})
 	}
+	slices.SortFunc(scaleItems, func(a, b sacorev1alpha1.ScaleOutItem) int {
+		return sacorev1alpha1.CmpNodePlacement(a.NodePlacement, b.NodePlacement)
+	})
 	return sacorev1alpha1.ScaleOutPlan{
-		UnsatisfiedPodNames: objutil.GetFullNames(leftoverUnscheduledPods),
-		Items:               scaleItems,
+		UnsatisfiedPodNames:  objutil.GetFullNames(leftoverUnscheduledPods),
+		Items:                scaleItems,
+		TotalHourlyCostDelta: sumHourlyCostDeltas(scaleItems),
+	}
+}
+
+// getHourlyCostDelta returns the hourly cost of delta nodes of the given placement according to the given pricingAccess.
+// If the pricingAccess has no price for the placement, e.g. for spot capacity of an instance type without spot price,
+// this is logged and nil is returned, so that the placement remains part of the plan and does not fail it.
+func getHourlyCostDelta(log logr.Logger, placement sacorev1alpha1.NodePlacement, delta int32, pricingAccess pricing.InstancePricingAccess) *float64 {
+	info, err := pricingAccess.GetInfo(placement.Region, placement.InstanceType, placement.CapacityType)
+	if err != nil {
+		log.Info("Cannot get price of node placement, the hourly cost of the plan is unknown", "placement", placement, "error", err)
+		return nil
+	}
+	return ptr.To(float64(delta) * info.HourlyPrice)
+}
+
+// sumHourlyCostDeltas returns the sum of the HourlyCostDelta of the given items, or nil if the HourlyCostDelta of any
+// of the items is unknown.
+func sumHourlyCostDeltas(items []sacorev1alpha1.ScaleOutItem) *float64 {
+	var total float64
+	for _, item := range items {
+		if item.HourlyCostDelta == nil {
+			return nil
+		}
+		total += *item.HourlyCostDelta
+	}
+	return &total
+}
+
+// splitQuotaUnsatisfiedPods moves the names of the given leftover unscheduled pods that were left unsatisfied because of
+// exhausted node pool quotas from the UnsatisfiedPodNames to the QuotaUnsatisfiedPodNames of the given scaleOutPlan.
+// A pod is considered to be unsatisfied because of quota if its aggregated resource requests fit within the allocatable
+// of at least one node template whose placement was quota exhausted. Other scheduling constraints of the pod are not
+// considered.
+func splitQuotaUnsatisfiedPods(scaleOutPlan *sacorev1alpha1.ScaleOutPlan, req *plannerapi.Request, leftoverUnscheduledPods []commontypes.NamespacedName, quotaExhaustedPlacements sets.Set[sacorev1alpha1.NodePlacement]) {
+	var exhaustedAllocatables []corev1.ResourceList
+	for _, t := range CreateAllNodeTemplates(req.Constraint.Spec.NodePools) {
+		if quotaExhaustedPlacements.Has(t.NodePlacement) {
+			exhaustedAllocatables = append(exhaustedAllocatables, nodeutil.BuildAllocatable(t.Capacity, t.SystemReserved, t.KubeReserved))
+		}
+	}
+	podRequests := make(map[commontypes.NamespacedName]corev1.ResourceList, len(req.Snapshot.Pods))
+	for _, p := range req.Snapshot.Pods {
+		podRequests[commontypes.NamespacedName{Namespace: p.Namespace, Name: p.Name}] = p.AggregatedRequests
+	}
+	var unsatisfied, quotaUnsatisfied []commontypes.NamespacedName
+	for _, podName := range leftoverUnscheduledPods {
+		requests, ok := podRequests[podName]
+		if ok && slices.ContainsFunc(exhaustedAllocatables, func(allocatable corev1.ResourceList) bool {
+			return !objutil.ExceedsResources(nil, requests, allocatable)
+		}) {
+			quotaUnsatisfied = append(quotaUnsatisfied, podName)
+			continue
+		}
+		unsatisfied = append(unsatisfied, podName)
 	}
+	scaleOutPlan.UnsatisfiedPodNames = objutil.GetFullNames(unsatisfied)
+	scaleOutPlan.QuotaUnsatisfiedPodNames = objutil.GetFullNames(quotaUnsatisfied)
 }
 
 // groupNodeScoresByNodePlacement groups the given nodeScores by their NodePlacement and returns a map of NodePlacement to slice of NodeScores.
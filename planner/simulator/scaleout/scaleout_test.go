@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"context"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	pricingtestutil "github.com/gardener/scaling-advisor/pricing/testutil"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+)
+
+func TestSendPartialPlanResult(t *testing.T) {
+	placement := sacorev1alpha1.NodePlacement{PoolName: "a", TemplateName: "m5l", InstanceType: "m5.large", Region: "eu-west-1", AvailabilityZone: "eu-west-1a"}
+	leftoverPod := commontypes.NamespacedName{Namespace: "default", Name: "berry-2"}
+	req := &plannerapi.Request{
+		CreationTime:            time.Now(),
+		AdviceGenerationTimeout: time.Second,
+		Constraint: &sacorev1alpha1.ScalingConstraint{
+			Spec: sacorev1alpha1.ScalingConstraintSpec{NodePools: []sacorev1alpha1.NodePool{{Name: "a"}}},
+		},
+	}
+	timeoutErr := context.DeadlineExceeded
+	pricingAccess, err := pricingtestutil.GetInstancePricingAccessForTop20AWSInstanceTypes()
+	if err != nil {
+		t.Fatalf("failed to get pricing access: %v", err)
+	}
+
+	t.Run("with winner node scores", func(t *testing.T) {
+		resultCh := make(chan plannerapi.ScaleOutPlanResult, 1)
+		groupCycleResults := []plannerapi.ScaleOutSimGroupCycleResult{
+			{
+				WinnerNodeScores:        []plannerapi.NodeScore{{Name: "sim-0", Placement: placement}},
+				LeftoverUnscheduledPods: []commontypes.NamespacedName{leftoverPod},
+			},
+		}
+		if err := SendPartialPlanResult(t.Context(), resultCh, req, 1, groupCycleResults, pricingAccess, timeoutErr); err != nil {
+			t.Fatalf("SendPartialPlanResult() error = %v", err)
+		}
+		result := <-resultCh
+		if got := result.Labels[commonconstants.LabelPartialPlan]; got != "true" {
+			t.Errorf("label %q = %q, want %q", commonconstants.LabelPartialPlan, got, "true")
+		}
+		if result.ScaleOutPlan == nil || len(result.ScaleOutPlan.Items) != 1 || result.ScaleOutPlan.Items[0].Delta != 1 {
+			t.Fatalf("ScaleOutPlan = %v, want one item with delta 1", result.ScaleOutPlan)
+		}
+		if got := result.ScaleOutPlan.UnsatisfiedPodNames; len(got) != 1 || got[0] != leftoverPod.String() {
+			t.Errorf("UnsatisfiedPodNames = %v, want [%s]", got, leftoverPod)
+		}
+	})
+
+	t.Run("without winner node scores", func(t *testing.T) {
+		resultCh := make(chan plannerapi.ScaleOutPlanResult, 1)
+		err := SendPartialPlanResult(t.Context(), resultCh, req, 1, []plannerapi.ScaleOutSimGroupCycleResult{{}}, pricingAccess, timeoutErr)
+		if !errors.Is(err, plannerapi.ErrAdviceGenerationTimeout) || !errors.Is(err, timeoutErr) {
+			t.Errorf("SendPartialPlanResult() error = %v, want %v wrapping %v", err, plannerapi.ErrAdviceGenerationTimeout, timeoutErr)
+		}
+		if len(resultCh) != 0 {
+			t.Errorf("got %d results sent, want none", len(resultCh))
+		}
+	})
+}
+
+func TestIsAdviceGenerationTimeout(t *testing.T) {
+	timeoutCtx, cancel := context.WithDeadlineCause(t.Context(), time.Now(), plannerapi.ErrAdviceGenerationTimeout)
+	defer cancel()
+	if !IsAdviceGenerationTimeout(timeoutCtx) {
+		t.Errorf("IsAdviceGenerationTimeout() = false for context with elapsed advice generation deadline, want true")
+	}
+	canceledCtx, cancel := context.WithCancel(t.Context())
+	cancel()
+	if IsAdviceGenerationTimeout(canceledCtx) {
+		t.Errorf("IsAdviceGenerationTimeout() = true for canceled context, want false")
+	}
+}
+
+func TestCreateScaleOutPlan(t *testing.T) {
+	pricingAccess, err := pricingtestutil.GetInstancePricingAccessForTop20AWSInstanceTypes()
+	if err != nil {
+		t.Fatalf("failed to get pricing access: %v", err)
+	}
+	placementL := sacorev1alpha1.NodePlacement{PoolName: "a", TemplateName: "m5l", InstanceType: "m5.large", Region: "eu-west-1", AvailabilityZone: "eu-west-1a"}
+	placementXL := sacorev1alpha1.NodePlacement{PoolName: "a", TemplateName: "m5xl", InstanceType: "m5.xlarge", Region: "eu-west-1", AvailabilityZone: "eu-west-1a"}
+	podA := commontypes.NamespacedName{Namespace: "default", Name: "a"}
+	podB := commontypes.NamespacedName{Namespace: "default", Name: "b"}
+	podC := commontypes.NamespacedName{Namespace: "default", Name: "c"}
+	winnerNodeScores := []plannerapi.NodeScore{
+		{Name: "sim-0", Placement: placementL, ScheduledPods: []commontypes.NamespacedName{podB}},
+		{Name: "sim-1", Placement: placementXL, ScheduledPods: []commontypes.NamespacedName{podC}},
+		{Name: "sim-2", Placement: placementL, ScheduledPods: []commontypes.NamespacedName{podA}},
+	}
+	plan := createScaleOutPlan(logr.Discard(), winnerNodeScores, map[sacorev1alpha1.NodePlacement]int32{placementL: 1}, nil, pricingAccess)
+	infoL, err := pricingAccess.GetInfo(placementL.Region, placementL.InstanceType, placementL.CapacityType)
+	if err != nil {
+		t.Fatalf("failed to get price of %q: %v", placementL.InstanceType, err)
+	}
+	infoXL, err := pricingAccess.GetInfo(placementXL.Region, placementXL.InstanceType, placementXL.CapacityType)
+	if err != nil {
+		t.Fatalf("failed to get price of %q: %v", placementXL.InstanceType, err)
+	}
+	wantItems := map[sacorev1alpha1.NodePlacement]sacorev1alpha1.ScaleOutItem{
+		placementL:  {NodePlacement: placementL, CurrentReplicas: 1, Delta: 2, HourlyCostDelta: ptr.To(2 * infoL.HourlyPrice), PodNames: []string{podA.String(), podB.String()}},
+		placementXL: {NodePlacement: placementXL, Delta: 1, HourlyCostDelta: ptr.To(infoXL.HourlyPrice), PodNames: []string{podC.String()}},
+	}
+	if len(plan.Items) != len(wantItems) {
+		t.Fatalf("got %d items, want %d", len(plan.Items), len(wantItems))
+	}
+	for _, item := range plan.Items {
+		if want := wantItems[item.NodePlacement]; !reflect.DeepEqual(item, want) {
+			t.Errorf("item = %+v, want %+v", item, want)
+		}
+	}
+	if want := 2*infoL.HourlyPrice + infoXL.HourlyPrice; plan.TotalHourlyCostDelta == nil || math.Abs(*plan.TotalHourlyCostDelta-want) > 1e-9 {
+		t.Errorf("TotalHourlyCostDelta = %v, want %v", plan.TotalHourlyCostDelta, want)
+	}
+
+	placementSpot := placementXL
+	placementSpot.CapacityType = commontypes.CapacityTypeSpot
+	plan = createScaleOutPlan(logr.Discard(), []plannerapi.NodeScore{
+		{Name: "sim-0", Placement: placementL},
+		{Name: "sim-1", Placement: placementSpot},
+	}, nil, nil, pricingAccess)
+	if len(plan.Items) != 2 {
+		t.Fatalf("got %d items, want 2 including the placement without price", len(plan.Items))
+	}
+	for _, item := range plan.Items {
+		if item.NodePlacement == placementSpot && item.HourlyCostDelta != nil {
+			t.Errorf("HourlyCostDelta of the placement without price = %v, want nil", *item.HourlyCostDelta)
+		}
+	}
+	if plan.TotalHourlyCostDelta != nil {
+		t.Errorf("TotalHourlyCostDelta = %v, want nil since the placement without price has an unknown cost", *plan.TotalHourlyCostDelta)
+	}
+}
+
+func TestGroupScaleOutNodeTemplatesByPriority(t *testing.T) {
+	high, low := commontypes.PriorityKey{First: 2}, commontypes.PriorityKey{First: 1}
+	newTemplate := func(templateName string, priorityKey commontypes.PriorityKey) plannerapi.ScaleOutNodeTemplate {
+		return plannerapi.ScaleOutNodeTemplate{
+			NodePlacement: sacorev1alpha1.NodePlacement{PoolName: "a", TemplateName: templateName},
+			PriorityKey:   priorityKey,
+		}
+	}
+	templates := []plannerapi.ScaleOutNodeTemplate{newTemplate("m5l", high), newTemplate("m5xl", low), newTemplate("m5xxl", high)}
+	wantTemplateNames := map[commontypes.PriorityKey][]string{
+		high: {"m5l", "m5xxl"},
+		low:  {"m5xl"},
+	}
+	got := GroupScaleOutNodeTemplatesByPriority(templates)
+	if len(got) != len(wantTemplateNames) {
+		t.Fatalf("got %d priority groups, want %d", len(got), len(wantTemplateNames))
+	}
+	for pk, wantNames := range wantTemplateNames {
+		group := got[pk]
+		if len(group) != len(wantNames) {
+			t.Errorf("got %d templates with priority %s, want %d", len(group), pk, len(wantNames))
+			continue
+		}
+		for i, template := range group {
+			if template.TemplateName != wantNames[i] {
+				t.Errorf("template %d with priority %s is %q, want %q", i, pk, template.TemplateName, wantNames[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package singlenode
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/gardener/scaling-advisor/planner/scorer"
+	"github.com/gardener/scaling-advisor/planner/simulator/scaleout"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/ioutil"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// beamState is a candidate sequence of scaled nodes retained by the lookahead search of a simulation group.
+type beamState struct {
+	// view is the view in which the nodes of the sequence are scaled and the pods are scheduled on them.
+	view minkapi.View
+	// pathViews are the views created for the sequence in order, where each view delegates to its predecessor.
+	pathViews []minkapi.View
+	// winnerNodeScores are the node scores of the scaled nodes of the sequence in order.
+	winnerNodeScores         []plannerapi.NodeScore
+	leftoverUnscheduledPods  []commontypes.NamespacedName
+	quotaExhaustedPlacements []sacorev1alpha1.NodePlacement
+	// totalCost is the sum of the effective hourly prices of the scaled nodes of the sequence, where the hourly price of
+	// spot capacity is increased by the SpotInterruptionPenaltyPercent as done by the node scorers.
+	totalCost float64
+	// final is set if the sequence leaves no unscheduled pods and hence is not expanded further.
+	final bool
+}
+
+// runBeamSearchCycleForGroup runs passes for the given simulation group as a lookahead search in windows of at most
+// LookaheadDepth passes. Within a window, all simulations of the group are run over each candidate view of the beam,
+// and the LookaheadBeamWidth candidates ranked best by the configured node scorer are retained for the next pass (see
+// selectBeamStates). Candidates that cannot be expanded further are set aside instead of being pruned. At the end of a
+// window, the candidate sequence with the fewest leftover unscheduled pods and the lowest total cost among the set
+// aside and retained candidates is chosen, where remaining ties are broken by the node scorer, and its winner node
+// scores are appended to the cycle result. Windows are run until there are no leftover unscheduled pods, no further
+// node can be scaled or the context is done.
+func (s *simulatorMultiSim) runBeamSearchCycleForGroup(ctx context.Context, groupPassView minkapi.View, group plannerapi.ScaleOutSimGroup) (cycleResult plannerapi.ScaleOutSimGroupCycleResult, err error) {
+	log := logr.FromContextOrDiscard(ctx)
+	quotaExhaustedPlacements := sets.New[sacorev1alpha1.NodePlacement]()
+	podSchedulingFailures := make(map[commontypes.NamespacedName][]string)
+	defer func() {
+		cycleResult.QuotaExhaustedPlacements = quotaExhaustedPlacements.UnsortedList()
+		cycleResult.PodSchedulingFailures = podSchedulingFailures
+	}()
+	cycleResult.NextGroupPassView = groupPassView
+	for {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		default:
+		}
+		var best *beamState
+		best, err = s.searchBeam(ctx, cycleResult.NextGroupPassView, group, podSchedulingFailures)
+		if err != nil {
+			return
+		}
+		quotaExhaustedPlacements.Insert(best.quotaExhaustedPlacements...)
+		if len(best.winnerNodeScores) == 0 {
+			log.V(2).Info("No winning node score sequence produced by lookahead search. Ending group passes.")
+			return
+		}
+		log.V(2).Info("Lookahead search chose node score sequence", "numNodes", len(best.winnerNodeScores), "totalCost", best.totalCost)
+		cycleResult.PassNum += len(best.winnerNodeScores)
+		cycleResult.NextGroupPassView = best.view
+		cycleResult.WinnerNodeScores = append(cycleResult.WinnerNodeScores, best.winnerNodeScores...)
+		cycleResult.LeftoverUnscheduledPods = best.leftoverUnscheduledPods
+		if len(cycleResult.LeftoverUnscheduledPods) == 0 {
+			log.V(2).Info("All pods have been scheduled by lookahead search")
+			return
+		}
+	}
+}
+
+// searchBeam runs one window of the lookahead search over the given rootView and returns the chosen candidate
+// sequence, which has no winner node scores if no node could be scaled. The views of pruned candidates are closed as
+// soon as they are pruned and all other views created in the window except the ones of the chosen sequence are closed
+// before returning. The scheduling failures of pods reported by the simulations of the window are collected into the
+// given podSchedulingFailures.
+func (s *simulatorMultiSim) searchBeam(ctx context.Context, rootView minkapi.View, group plannerapi.ScaleOutSimGroup, podSchedulingFailures map[commontypes.NamespacedName][]string) (best *beamState, err error) {
+	var (
+		log          = logr.FromContextOrDiscard(ctx)
+		root         = &beamState{view: rootView}
+		beam         = []*beamState{root}
+		createdViews []minkapi.View
+		closedViews  = sets.New[minkapi.View]()
+	)
+	closeViews := func(views ...minkapi.View) error {
+		views = slices.DeleteFunc(views, closedViews.Has)
+		closedViews.Insert(views...)
+		return s.state.CloseViews(views...)
+	}
+	defer func() {
+		retained := sets.New[minkapi.View]()
+		if err == nil {
+			retained.Insert(best.pathViews...)
+		}
+		err = errors.Join(err, closeViews(slices.DeleteFunc(createdViews, retained.Has)...))
+	}()
+	depth := s.simulatorConfig.LookaheadDepth
+	if depth <= 0 {
+		depth = plannerapi.DefaultLookaheadDepth
+	}
+	// finished are the candidates that cannot be expanded further. They are not subject to pruning.
+	var finished []*beamState
+	for range depth {
+		var candidates []*beamState
+		for _, state := range beam {
+			var (
+				children   []*beamState
+				childViews []minkapi.View
+			)
+			children, childViews, err = s.expandBeamState(ctx, state, group, podSchedulingFailures)
+			createdViews = append(createdViews, childViews...)
+			if err != nil {
+				return
+			}
+			if len(children) == 0 {
+				finished = append(finished, state)
+				continue
+			}
+			for _, child := range children {
+				if child.final {
+					finished = append(finished, child)
+				} else {
+					candidates = append(candidates, child)
+				}
+			}
+		}
+		var prunedStates []*beamState
+		beam, prunedStates, err = s.selectBeamStates(candidates, s.simulatorConfig.LookaheadBeamWidth)
+		if err != nil {
+			return
+		}
+		for _, pruned := range prunedStates {
+			// pruned candidates are leaves of the search, hence no retained candidate delegates to their views.
+			if err = closeViews(pruned.view); err != nil {
+				return
+			}
+		}
+		log.V(3).Info("Pruned lookahead search beam", "numCandidates", len(candidates), "beamWidth", len(beam), "numFinished", len(finished))
+		if len(beam) == 0 {
+			break
+		}
+	}
+	best, err = s.selectBestBeamState(append(finished, beam...))
+	if err == nil && best == nil {
+		// the node scorer selects no winner, hence no node is scaled as for passes without lookahead.
+		best = root
+	}
+	return
+}
+
+// expandBeamState runs all simulations of the given group over the view of the given state and returns the child
+// states for the simulations that scheduled pods on their scaled node, along with all views created for the run. The
+// scheduling failures of pods reported by the simulations are collected into the given podSchedulingFailures.
+func (s *simulatorMultiSim) expandBeamState(ctx context.Context, state *beamState, group plannerapi.ScaleOutSimGroup, podSchedulingFailures map[commontypes.NamespacedName][]string) (children []*beamState, createdViews []minkapi.View, err error) {
+	var mu sync.Mutex
+	scaleOutSimResults, err := group.Run(ctx, func(ctx context.Context, name string) (minkapi.View, error) {
+		view, err := s.state.CreateSandboxView(ctx, fmt.Sprintf("%s_beam-%d", name, s.beamViewCounter.Add(1)), state.view)
+		if err != nil {
+			return nil, err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		createdViews = append(createdViews, view)
+		return view, nil
+	})
+	if err != nil {
+		return
+	}
+	if err = ioutil.ResetAll(group); err != nil {
+		err = fmt.Errorf("cannot reset simulation group %q: %w", group.Name(), err)
+		return
+	}
+	scaleout.CollectPodSchedulingFailures(podSchedulingFailures, scaleOutSimResults)
+	quotaExhaustedPlacements := slices.Clone(state.quotaExhaustedPlacements)
+	for _, sr := range scaleOutSimResults {
+		quotaExhaustedPlacements = append(quotaExhaustedPlacements, sr.QuotaExhaustedPlacements...)
+	}
+	scaledSimResults := slices.DeleteFunc(slices.Clone(scaleOutSimResults), func(sr plannerapi.ScaleOutSimResult) bool {
+		return len(sr.NodePodAssignments) == 0
+	})
+	nodeScores := make([]plannerapi.NodeScore, 0, len(scaledSimResults))
+	for _, sr := range scaledSimResults {
+		var nodeScore plannerapi.NodeScore
+		nodeScore, err = s.nodeScorer.Compute(mapSimulationResultToNodeScoreArgs(sr))
+		if err != nil {
+			err = fmt.Errorf("%w: node scoring failed for simulation %q of group %q: %w", plannerapi.ErrComputeNodeScore, sr.Name, group.Name(), err)
+			return
+		}
+		nodeScores = append(nodeScores, nodeScore)
+	}
+	// the node scores of the children are balanced across zones relative to the nodes of the expanded state.
+	if err = scaleout.SetZoneBalanceInfo(ctx, s.state.Request, state.view, nodeScores); err != nil {
+		return
+	}
+	for i, sr := range scaledSimResults {
+		var (
+			nodeScore = nodeScores[i]
+			price     float64
+			placement = sr.Items[0].NodePlacement
+		)
+		price, err = scorer.GetEffectiveHourlyPrice(s.pricingAccess, placement, s.simulatorConfig.SpotInterruptionPenaltyPercent)
+		if err != nil {
+			err = fmt.Errorf("cannot get price of instance type %q in region %q for simulation %q: %w", placement.InstanceType, placement.Region, sr.Name, err)
+			return
+		}
+		if err = ioutil.ResetAll(sr.View.GetEventSink()); err != nil {
+			err = fmt.Errorf("cannot reset event sink of view %q: %w", sr.View.GetName(), err)
+			return
+		}
+		children = append(children, &beamState{
+			view:                     sr.View,
+			pathViews:                append(slices.Clone(state.pathViews), sr.View),
+			winnerNodeScores:         append(slices.Clone(state.winnerNodeScores), nodeScore),
+			leftoverUnscheduledPods:  sr.LeftoverUnscheduledPods,
+			quotaExhaustedPlacements: quotaExhaustedPlacements,
+			totalCost:                state.totalCost + price,
+			final:                    len(sr.LeftoverUnscheduledPods) == 0,
+		})
+	}
+	if len(children) == 0 {
+		state.quotaExhaustedPlacements = quotaExhaustedPlacements
+	}
+	return
+}
+
+// selectBeamStates returns at most n of the given candidates in the order in which they are ranked by the node scorer,
+// along with the remaining candidates. The candidate whose last winner node score is selected by the node scorer among
+// the last winner node scores of the candidates not yet returned is ranked next, hence a beam width of 1 follows the
+// same path as the passes without lookahead. Candidates without winner node scores are ranked last. Once the node
+// scorer selects none of the candidates not yet returned, they all remain.
+func (s *simulatorMultiSim) selectBeamStates(candidates []*beamState, n int) (selected, remaining []*beamState, err error) {
+	remaining = slices.Clone(candidates)
+	for len(selected) < n && len(remaining) > 0 {
+		var (
+			i     int
+			found bool
+		)
+		if i, found, err = s.selectBeamState(remaining); err != nil || !found {
+			return
+		}
+		selected = append(selected, remaining[i])
+		remaining = slices.Delete(remaining, i, i+1)
+	}
+	return
+}
+
+// selectBestBeamState returns the beam state with the fewest leftover unscheduled pods and the lowest total cost among
+// the given beam states. Remaining ties are broken by the node scorer as described in selectBeamStates. It returns nil
+// if the node scorer selects none of the tied beam states.
+func (s *simulatorMultiSim) selectBestBeamState(beam []*beamState) (*beamState, error) {
+	best := slices.MinFunc(beam, compareBeamStates)
+	ties := slices.DeleteFunc(slices.Clone(beam), func(state *beamState) bool {
+		return compareBeamStates(state, best) != 0
+	})
+	i, found, err := s.selectBeamState(ties)
+	if err != nil || !found {
+		return nil, err
+	}
+	return ties[i], nil
+}
+
+// selectBeamState returns the index of the given beam state whose last winner node score is selected by the node
+// scorer, or the index of the first beam state if none of them has winner node scores. found is false if the node
+// scorer selects no winner. Since beam states expanded from different views share the names of their simulations, the
+// node scores passed to the node scorer are named uniquely by suffixing the index of their beam state, which preserves
+// the order of the names, and the selected node score is matched by its name.
+func (s *simulatorMultiSim) selectBeamState(states []*beamState) (int, bool, error) {
+	var (
+		indices    []int
+		nodeScores []plannerapi.NodeScore
+	)
+	for i, state := range states {
+		if len(state.winnerNodeScores) > 0 {
+			nodeScore := state.winnerNodeScores[len(state.winnerNodeScores)-1]
+			nodeScore.Name = fmt.Sprintf("%s#%d", nodeScore.Name, i)
+			indices = append(indices, i)
+			nodeScores = append(nodeScores, nodeScore)
+		}
+	}
+	if len(nodeScores) == 0 {
+		return 0, true, nil
+	}
+	winner, err := s.nodeScorer.Select(nodeScores)
+	if err != nil {
+		return 0, false, fmt.Errorf("%w: cannot select lookahead beam state: %w", plannerapi.ErrSelectNodeScore, err)
+	}
+	if winner == nil {
+		return 0, false, nil
+	}
+	j := slices.IndexFunc(nodeScores, func(nodeScore plannerapi.NodeScore) bool {
+		return nodeScore.Name == winner.Name
+	})
+	if j < 0 {
+		return 0, false, fmt.Errorf("%w: node scorer selected node score %q not among the given ones", plannerapi.ErrSelectNodeScore, winner.Name)
+	}
+	return indices[j], true, nil
+}
+
+// compareBeamStates orders beam states by increasing number of leftover unscheduled pods, then by increasing total cost.
+func compareBeamStates(a, b *beamState) int {
+	return cmp.Or(cmp.Compare(len(a.leftoverUnscheduledPods), len(b.leftoverUnscheduledPods)),
+		cmp.Compare(a.totalCost, b.totalCost))
+}
@@ -9,16 +9,22 @@ package singlenode
 import (
 	"context"
 	"fmt"
+	"slices"
+	"sync/atomic"
 
 	"github.com/gardener/scaling-advisor/planner/simulator/scaleout"
 
 	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	sacorev1alpha1 "github.com/gardener/scaling-advisor/api/core/v1alpha1"
 	"github.com/gardener/scaling-advisor/api/minkapi"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/api/pricing"
 	"github.com/gardener/scaling-advisor/common/ioutil"
 	"github.com/gardener/scaling-advisor/common/logutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
 	"github.com/gardener/scaling-advisor/common/viewutil"
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 var (
@@ -31,13 +37,16 @@ type simulatorMultiSim struct {
 	schedulerLauncher plannerapi.SchedulerLauncher
 	storageMetaAccess plannerapi.StorageMetaAccess
 	nodeScorer        plannerapi.NodeScorer
+	pricingAccess     pricing.InstancePricingAccess
 	state             *scaleout.SimulatorState
 	simulatorConfig   plannerapi.SimulatorConfig
+	// beamViewCounter is used to name the views created by the lookahead search uniquely.
+	beamViewCounter atomic.Uint64
 }
 
 // New creates a new plannerapi.ScaleOutSimulator that runs simulations for a single scaled node concurrently.
 func New(args plannerapi.SimulatorArgs) (plannerapi.ScaleOutSimulator, error) {
-	if err := validateSimulatorArgs(args); err != nil {
+	if err := scaleout.ValidateSimulatorArgs(args); err != nil {
 		return nil, err
 	}
 	return &simulatorMultiSim{
@@ -46,6 +55,7 @@ func New(args plannerapi.SimulatorArgs) (plannerapi.ScaleOutSimulator, error) {
 		schedulerLauncher: args.SchedulerLauncher,
 		storageMetaAccess: args.StorageMetaAccess,
 		nodeScorer:        args.NodeScorer,
+		pricingAccess:     args.PricingAccess,
 	}, nil
 }
 
@@ -71,7 +81,7 @@ func (s *simulatorMultiSim) doSimulate(ctx context.Context) (err error) {
 	if err = s.state.InitializeRequestView(ctx); err != nil {
 		return
 	}
-	s.state.SimulationGroups, err = s.createAndGroupSimulations()
+	s.state.SimulationGroups, err = s.createAndGroupSimulations(ctx)
 	if err != nil {
 		return
 	}
@@ -85,9 +95,9 @@ func (s *simulatorMultiSim) Close() error {
 	return s.state.Reset()
 }
 
-func (s *simulatorMultiSim) createAndGroupSimulations() ([]plannerapi.ScaleOutSimGroup, error) {
+func (s *simulatorMultiSim) createAndGroupSimulations(ctx context.Context) ([]plannerapi.ScaleOutSimGroup, error) {
 	var (
-		allScaleOutNodeTemplates = scaleout.CreateAllNodeTemplates(s.state.Request.Constraint.Spec.NodePools)
+		allScaleOutNodeTemplates = scaleout.CreateEligibleNodeTemplates(ctx, s.state.Request)
 		allSimulations           = make([]plannerapi.ScaleOutSimulation, 0, len(allScaleOutNodeTemplates))
 	)
 	for i, snt := range allScaleOutNodeTemplates {
@@ -99,6 +109,8 @@ func (s *simulatorMultiSim) createAndGroupSimulations() ([]plannerapi.ScaleOutSi
 			StorageMetaAccess: s.storageMetaAccess,
 			Config:            s.simulatorConfig,
 			NodeTemplates:     []plannerapi.ScaleOutNodeTemplate{snt},
+			DaemonSetPods:     s.state.Request.Snapshot.DaemonSetPods,
+			SchedulerProfile:  s.state.Request.SchedulerProfile,
 			Strategy:          commontypes.SimulatorStrategySingleNodeMultiSim,
 		}
 		sim, err := s.state.SimulationFactory.NewScaleOut(simArgs)
@@ -107,7 +119,7 @@ func (s *simulatorMultiSim) createAndGroupSimulations() ([]plannerapi.ScaleOutSi
 		}
 		allSimulations = append(allSimulations, sim)
 	}
-	return scaleout.CreateScaleOutSimGroups(s.state.Request.GetRef(), allSimulations)
+	return scaleout.CreateScaleOutSimGroups(s.state.Request, allSimulations)
 }
 
 // runStabilizationCyclesForAllGroups runs all simulation groups until there is no winner or there are no leftover unscheduled
@@ -122,6 +134,7 @@ func (s *simulatorMultiSim) runStabilizationCyclesForAllGroups(ctx context.Conte
 		allWinnerNodeScores     []plannerapi.NodeScore
 		simGroupCycleResult     plannerapi.ScaleOutSimGroupCycleResult
 		allSimGroupCycleResults []plannerapi.ScaleOutSimGroupCycleResult
+		quotaCycleResults       []plannerapi.ScaleOutSimGroupCycleResult // cycle results without winners that exhausted pool quotas
 		log                     = logr.FromContextOrDiscard(ctx)
 	)
 	simGroupCycleResult.NextGroupPassView = s.state.RequestView()
@@ -131,12 +144,26 @@ func (s *simulatorMultiSim) runStabilizationCyclesForAllGroups(ctx context.Conte
 		grpCtx := logr.NewContext(ctx, log)
 		log.V(3).Info("Invoking runStabilizationCycleForGroup")
 		simGroupCycleResult, err = s.runStabilizationCycleForGroup(grpCtx, simGroupCycleResult.NextGroupPassView, group)
+		if err != nil && scaleout.IsAdviceGenerationTimeout(ctx) {
+			// the cycle result holds the winner node scores of the passes completed before the timeout.
+			partialResults := allSimGroupCycleResults
+			if s.state.Request.AdviceGenerationMode.IsIncremental() {
+				partialResults = quotaCycleResults
+			}
+			err = scaleout.SendPartialPlanResult(ctx, s.state.ResultCh, s.state.Request, s.state.SimRunCounter.Load(),
+				append(partialResults, simGroupCycleResult), s.pricingAccess, err)
+			return
+		}
 		if err != nil {
 			err = fmt.Errorf("failed to run all passes for group %q: %w", group.Name(), err)
 			return
 		}
 		if len(simGroupCycleResult.WinnerNodeScores) == 0 {
 			log.V(2).Info("No winning node scores produced for group. Continuing to next group.")
+			if len(simGroupCycleResult.QuotaExhaustedPlacements) > 0 {
+				quotaCycleResults = append(quotaCycleResults, simGroupCycleResult)
+				allSimGroupCycleResults = append(allSimGroupCycleResults, simGroupCycleResult)
+			}
 			groupIndex++
 			continue
 		}
@@ -144,9 +171,10 @@ func (s *simulatorMultiSim) runStabilizationCyclesForAllGroups(ctx context.Conte
 		if s.state.Request.AdviceGenerationMode.IsIncremental() {
 			log.V(4).Info("Sending ScalingPlanResult", "adviceGenerationMode", s.state.Request.AdviceGenerationMode)
 			if err = scaleout.SendPlanResult(ctx, s.state.ResultCh, s.state.Request, s.state.SimRunCounter.Load(),
-				[]plannerapi.ScaleOutSimGroupCycleResult{simGroupCycleResult}); err != nil {
+				append(quotaCycleResults, simGroupCycleResult), s.pricingAccess); err != nil {
 				return
 			}
+			quotaCycleResults = nil
 		}
 		allSimGroupCycleResults = append(allSimGroupCycleResults, simGroupCycleResult)
 		if len(simGroupCycleResult.LeftoverUnscheduledPods) == 0 {
@@ -161,7 +189,7 @@ func (s *simulatorMultiSim) runStabilizationCyclesForAllGroups(ctx context.Conte
 	}
 	if s.state.Request.AdviceGenerationMode.IsAllAtOnce() {
 		log.V(4).Info("Sending ScalingPlanResult", "adviceGenerationMode", s.state.Request.AdviceGenerationMode)
-		err = scaleout.SendPlanResult(ctx, s.state.ResultCh, s.state.Request, s.state.SimRunCounter.Load(), allSimGroupCycleResults)
+		err = scaleout.SendPlanResult(ctx, s.state.ResultCh, s.state.Request, s.state.SimRunCounter.Load(), allSimGroupCycleResults, s.pricingAccess)
 	}
 	return
 }
@@ -171,8 +199,26 @@ func (s *simulatorMultiSim) runStabilizationCyclesForAllGroups(ctx context.Conte
 //   - the simulation group has stabilized with no scheduled pods for all its child simulations.
 //   - there is no winner node score after running a pass for the group
 //   - the context is done.
+//
+// If the LookaheadBeamWidth of the simulator config is greater than 1, the passes are run as a lookahead search by
+// runBeamSearchCycleForGroup instead of choosing the winner of each pass greedily.
 func (s *simulatorMultiSim) runStabilizationCycleForGroup(ctx context.Context, groupPassView minkapi.View, group plannerapi.ScaleOutSimGroup) (cycleResult plannerapi.ScaleOutSimGroupCycleResult, err error) {
+	ctx, span := traceutil.StartSpan(ctx, "SimulationGroupCycle", traceutil.AttrSimulationGroup.String(group.Name()))
+	defer func() {
+		span.SetAttributes(traceutil.AttrPassNum.Int(cycleResult.PassNum))
+		traceutil.EndSpan(span, err)
+	}()
+	if s.simulatorConfig.LookaheadBeamWidth > 1 {
+		return s.runBeamSearchCycleForGroup(ctx, groupPassView, group)
+	}
 	var winningNodeScore *plannerapi.NodeScore
+	quotaExhaustedPlacements := sets.New[sacorev1alpha1.NodePlacement]()
+	maxNodesExhaustedPlacements := sets.New[sacorev1alpha1.NodePlacement]()
+	podSchedulingFailures := make(map[commontypes.NamespacedName][]string)
+	defer func() {
+		cycleResult.QuotaExhaustedPlacements = quotaExhaustedPlacements.UnsortedList()
+		cycleResult.PodSchedulingFailures = podSchedulingFailures
+	}()
 	cycleResult.NextGroupPassView = groupPassView
 	cycleResult.PassNum = 0
 	for {
@@ -184,10 +230,12 @@ func (s *simulatorMultiSim) runStabilizationCycleForGroup(ctx context.Context, g
 			cycleResult.PassNum++
 			log := logr.FromContextOrDiscard(ctx).WithValues("groupRunPassNum", cycleResult.PassNum)
 			passCtx := logr.NewContext(ctx, log)
-			cycleResult.NextGroupPassView, winningNodeScore, err = s.runPassForGroup(passCtx, cycleResult.NextGroupPassView, group)
+			var nextGroupPassView minkapi.View
+			nextGroupPassView, winningNodeScore, err = s.runPassForGroup(passCtx, cycleResult.NextGroupPassView, group, quotaExhaustedPlacements, maxNodesExhaustedPlacements, podSchedulingFailures)
 			if err != nil {
 				return
 			}
+			cycleResult.NextGroupPassView = nextGroupPassView
 			// winningNodeScore being nil indicates that there are no more winning node score, further passes can be aborted.
 			if winningNodeScore == nil {
 				log.V(2).Info("No winning node score produced in pass. Ending group passes.")
@@ -216,19 +264,35 @@ func (s *simulatorMultiSim) runStabilizationCycleForGroup(ctx context.Context, g
 // invokes the NodeScorer for each valid ScaleOutSimResult to compute the NodeScore and aggregates scores into the ScaleOutSimGroupPassScores - which includes the WinnerScore if any.
 // If there is a WinnerScore among the SimulationRunResults, within the SimulationGroupRunResult, it is returned along with the nextGroupView.
 // If there is no WinnerScore then return nil for both winnerNodeScore and the nextPassView.
-func (s *simulatorMultiSim) runPassForGroup(ctx context.Context, groupPassView minkapi.View, group plannerapi.ScaleOutSimGroup) (nextGroupPassView minkapi.View, winnerNodeScore *plannerapi.NodeScore, err error) {
+// Simulations whose node template placement is in the given quotaExhaustedPlacements or maxNodesExhaustedPlacements are
+// dropped from the group before the pass, and placements found to be quota or max nodes exhausted in this pass are added
+// to them. The scheduling failures of pods reported by the simulations of this pass are collected into the given
+// podSchedulingFailures.
+func (s *simulatorMultiSim) runPassForGroup(ctx context.Context, groupPassView minkapi.View, group plannerapi.ScaleOutSimGroup, quotaExhaustedPlacements, maxNodesExhaustedPlacements sets.Set[sacorev1alpha1.NodePlacement], podSchedulingFailures map[commontypes.NamespacedName][]string) (nextGroupPassView minkapi.View, winnerNodeScore *plannerapi.NodeScore, err error) {
 	log := logr.FromContextOrDiscard(ctx)
 	var (
 		groupScores plannerapi.ScaleOutSimGroupPassScores
 		winnerView  minkapi.View
 	)
+	if numDropped := group.DropSimulations(func(sim plannerapi.ScaleOutSimulation) bool {
+		return slices.ContainsFunc(sim.NodeTemplates(), func(t plannerapi.ScaleOutNodeTemplate) bool {
+			return quotaExhaustedPlacements.Has(t.NodePlacement) || maxNodesExhaustedPlacements.Has(t.NodePlacement)
+		})
+	}); numDropped > 0 {
+		log.V(2).Info("Dropped simulations whose node templates exceed pool quota or max nodes", "numDropped", numDropped)
+	}
 	scaleOutSimResults, err := group.Run(ctx, func(ctx context.Context, name string) (minkapi.View, error) {
 		return s.state.CreateSandboxView(ctx, name, groupPassView)
 	})
 	if err != nil {
 		return
 	}
-	groupScores, winnerView, err = s.processScaleOutSimResults(log, group.Name(), scaleOutSimResults)
+	for _, sr := range scaleOutSimResults {
+		quotaExhaustedPlacements.Insert(sr.QuotaExhaustedPlacements...)
+		maxNodesExhaustedPlacements.Insert(sr.MaxNodesExhaustedPlacements...)
+	}
+	scaleout.CollectPodSchedulingFailures(podSchedulingFailures, scaleOutSimResults)
+	groupScores, winnerView, err = s.processScaleOutSimResults(ctx, groupPassView, group.Name(), scaleOutSimResults)
 	if err != nil {
 		return
 	}
@@ -246,7 +310,11 @@ func (s *simulatorMultiSim) runPassForGroup(ctx context.Context, groupPassView m
 	return
 }
 
-func (s *simulatorMultiSim) processScaleOutSimResults(log logr.Logger, simulationGroupName string, scaleOutSimResults []plannerapi.ScaleOutSimResult) (simGroupPassScores plannerapi.ScaleOutSimGroupPassScores, winningView minkapi.View, err error) {
+// processScaleOutSimResults computes the NodeScore for each of the given scaleOutSimResults and selects the WinnerScore
+// among them. The nodes of the given groupPassView are used to balance the winner across availability zones for node
+// pools with a balanced ZoneBalancePolicy.
+func (s *simulatorMultiSim) processScaleOutSimResults(ctx context.Context, groupPassView minkapi.View, simulationGroupName string, scaleOutSimResults []plannerapi.ScaleOutSimResult) (simGroupPassScores plannerapi.ScaleOutSimGroupPassScores, winningView minkapi.View, err error) {
+	log := logr.FromContextOrDiscard(ctx)
 	var nodeScore plannerapi.NodeScore
 
 	for _, sr := range scaleOutSimResults {
@@ -262,6 +330,9 @@ func (s *simulatorMultiSim) processScaleOutSimResults(log logr.Logger, simulatio
 		simGroupPassScores.AllScores = append(simGroupPassScores.AllScores, nodeScore)
 	}
 	if len(simGroupPassScores.AllScores) > 0 {
+		if err = scaleout.SetZoneBalanceInfo(ctx, s.state.Request, groupPassView, simGroupPassScores.AllScores); err != nil {
+			return
+		}
 		simGroupPassScores.WinnerScore, err = s.nodeScorer.Select(simGroupPassScores.AllScores)
 		if err != nil {
 			err = fmt.Errorf("%w: node score selection failed for group %q: %w", plannerapi.ErrSelectNodeScore, simulationGroupName, err)
@@ -294,19 +365,3 @@ func mapSimulationResultToNodeScoreArgs(simResult plannerapi.ScaleOutSimResult)
 		LeftOverUnscheduledPods: simResult.LeftoverUnscheduledPods,
 	}
 }
-
-func validateSimulatorArgs(args plannerapi.SimulatorArgs) error {
-	if args.ViewAccess == nil {
-		return fmt.Errorf("%w: view access is required", plannerapi.ErrCreateSimulator)
-	}
-	if args.NodeScorer == nil {
-		return fmt.Errorf("%w: node scorer is required", plannerapi.ErrCreateSimulator)
-	}
-	if args.SchedulerLauncher == nil {
-		return fmt.Errorf("%w: scheduler launcher is required", plannerapi.ErrCreateSimulator)
-	}
-	if args.StorageMetaAccess == nil {
-		return fmt.Errorf("%w: storage meta access is required", plannerapi.ErrCreateSimulator)
-	}
-	return nil
-}
@@ -0,0 +1,50 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package scaleout
+
+import (
+	"context"
+	"fmt"
+
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// poolZone is the key for counting nodes of a node pool in an availability zone.
+type poolZone struct {
+	poolName string
+	zone     string
+}
+
+// SetZoneBalanceInfo sets the ZoneBalance policy of the node pool of each of the given nodeScores and the
+// PoolZoneNodeCount, which is the number of nodes of that pool in the availability zone of the score placement present
+// in the given view. Since the view of a pass includes the nodes scaled in prior passes, the count covers both existing
+// and planned nodes. The node scorer uses this information to break ties in favour of the least populated zone.
+func SetZoneBalanceInfo(ctx context.Context, req *plannerapi.Request, view minkapi.View, nodeScores []plannerapi.NodeScore) error {
+	nodes, err := view.ListNodes(ctx)
+	if err != nil {
+		return fmt.Errorf("cannot list nodes of view %q to count nodes per pool and zone: %w", view.GetName(), err)
+	}
+	nodeCounts := make(map[poolZone]int)
+	for _, n := range nodes {
+		poolName, ok := n.Labels[commonconstants.LabelNodePoolName]
+		if !ok {
+			continue
+		}
+		nodeCounts[poolZone{poolName: poolName, zone: n.Labels[corev1.LabelTopologyZone]}]++
+	}
+	for i := range nodeScores {
+		placement := nodeScores[i].Placement
+		pool := req.Constraint.Spec.GetNodePool(placement.PoolName)
+		if pool == nil {
+			continue
+		}
+		nodeScores[i].ZoneBalance = pool.ZoneBalance
+		nodeScores[i].PoolZoneNodeCount = nodeCounts[poolZone{poolName: placement.PoolName, zone: placement.AvailabilityZone}]
+	}
+	return nil
+}
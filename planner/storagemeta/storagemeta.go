@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package storagemeta provides implementations of the [plannerapi.StorageMetaAccess]
+package storagemeta
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	commonerrors "github.com/gardener/scaling-advisor/api/common/errors"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+//go:embed data/volume-limits.json
+var dataFS embed.FS
+
+// DefaultMaxVolumes is the conservative maximum number of volumes that can be attached to a node of a cloud provider
+// that is not contained in the default VolumeLimitsCatalog, which is the same as the one of the samples.
+const DefaultMaxVolumes int32 = 16
+
+// defaultDriverNames are the names of the CSI drivers of the block storage of the cloud providers that are not
+// contained in the default VolumeLimitsCatalog, as registered by the drivers:
+//   - gcp: https://github.com/kubernetes-sigs/gcp-compute-persistent-disk-csi-driver
+//   - azure: https://github.com/kubernetes-sigs/azuredisk-csi-driver
+//   - ali: https://github.com/kubernetes-sigs/alibaba-cloud-csi-driver
+//   - openstack: https://github.com/kubernetes/cloud-provider-openstack/tree/master/docs/cinder-csi-plugin
+var defaultDriverNames = map[commontypes.CloudProvider]string{
+	commontypes.CloudProviderGCP:       "pd.csi.storage.gke.io",
+	commontypes.CloudProviderAzure:     "disk.csi.azure.com",
+	commontypes.CloudProviderAli:       "diskplugin.csi.alibabacloud.com",
+	commontypes.CloudProviderOpenStack: "cinder.csi.openstack.org",
+}
+
+var _ plannerapi.StorageMetaAccess = (*catalogAccess)(nil)
+
+// catalogAccess is a StorageMetaAccess that returns the CSINodeSpec for an instance type from the ProviderVolumeLimits
+// of a cloud provider.
+type catalogAccess struct {
+	limits plannerapi.ProviderVolumeLimits
+}
+
+// New creates a StorageMetaAccess that returns the CSINodeSpec for an instance type from the ProviderVolumeLimits of
+// the given cloud provider in the given catalog after validating them.
+func New(provider commontypes.CloudProvider, catalog plannerapi.VolumeLimitsCatalog) (plannerapi.StorageMetaAccess, error) {
+	limits, ok := catalog.Providers[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w: %w: no volume limits for %q", plannerapi.ErrCreateStorageMetaAccess, commonerrors.ErrUnsupportedCloudProvider, provider)
+	}
+	if err := validateProviderVolumeLimits(limits); err != nil {
+		return nil, fmt.Errorf("%w: invalid volume limits for %q: %w", plannerapi.ErrCreateStorageMetaAccess, provider, err)
+	}
+	return &catalogAccess{limits: limits}, nil
+}
+
+// NewProviderDefault creates a StorageMetaAccess that returns the CSINodeSpec for an instance type from the default
+// VolumeLimitsCatalog for the given cloud provider. For a cloud provider that the default VolumeLimitsCatalog does not
+// contain, the CSINodeSpec of all instance types has the CSI driver of the block storage of the cloud provider with
+// DefaultMaxVolumes allocatable volumes.
+func NewProviderDefault(provider commontypes.CloudProvider) (plannerapi.StorageMetaAccess, error) {
+	catalog, err := GetDefaultVolumeLimitsCatalog()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := catalog.Providers[provider]; !ok {
+		if driverName, ok := defaultDriverNames[provider]; ok {
+			catalog.Providers[provider] = plannerapi.ProviderVolumeLimits{DriverName: driverName, DefaultMaxVolumes: DefaultMaxVolumes}
+		}
+	}
+	return New(provider, catalog)
+}
+
+// GetDefaultVolumeLimitsCatalog returns the default VolumeLimitsCatalog embedded in this package. It only contains the
+// volume limits of AWS, which are the ones of the samples, where the maximum number of volumes of instance types that
+// it does not contain is the one of m5.large. More precise volume limits of other cloud providers can be given by a
+// catalog loaded with LoadVolumeLimitsCatalog.
+func GetDefaultVolumeLimitsCatalog() (catalog plannerapi.VolumeLimitsCatalog, err error) {
+	data, err := dataFS.ReadFile("data/volume-limits.json")
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(data, &catalog); err != nil {
+		err = fmt.Errorf("%w: cannot parse default volume limits catalog: %w", plannerapi.ErrCreateStorageMetaAccess, err)
+	}
+	return
+}
+
+// LoadVolumeLimitsCatalog loads the VolumeLimitsCatalog from the JSON file at the given catalogPath.
+func LoadVolumeLimitsCatalog(catalogPath string) (catalog plannerapi.VolumeLimitsCatalog, err error) {
+	data, err := os.ReadFile(filepath.Clean(catalogPath))
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(data, &catalog); err != nil {
+		err = fmt.Errorf("%w: cannot parse volume limits catalog %q: %w", plannerapi.ErrCreateStorageMetaAccess, catalogPath, err)
+	}
+	return
+}
+
+// GetFallbackCSINodeSpec returns a CSINodeSpec with the CSI driver of the cloud provider, whose number of allocatable
+// volumes is the one of the given instanceType, or the default of the cloud provider if the instanceType is unknown.
+func (a *catalogAccess) GetFallbackCSINodeSpec(instanceType string) (storagev1.CSINodeSpec, error) {
+	maxVolumes, ok := a.limits.InstanceTypes[instanceType]
+	if !ok {
+		maxVolumes = a.limits.DefaultMaxVolumes
+	}
+	return storagev1.CSINodeSpec{
+		Drivers: []storagev1.CSINodeDriver{
+			{
+				Name:         a.limits.DriverName,
+				TopologyKeys: slices.Clone(a.limits.TopologyKeys),
+				Allocatable:  &storagev1.VolumeNodeResources{Count: &maxVolumes},
+			},
+		},
+	}, nil
+}
+
+func validateProviderVolumeLimits(limits plannerapi.ProviderVolumeLimits) error {
+	if limits.DriverName == "" {
+		return fmt.Errorf("driverName must be set")
+	}
+	if limits.DefaultMaxVolumes <= 0 {
+		return fmt.Errorf("defaultMaxVolumes must be positive")
+	}
+	for instanceType, maxVolumes := range limits.InstanceTypes {
+		if maxVolumes <= 0 {
+			return fmt.Errorf("maxVolumes of instance type %q must be positive", instanceType)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package storagemeta
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	commonerrors "github.com/gardener/scaling-advisor/api/common/errors"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+)
+
+func TestNewProviderDefault(t *testing.T) {
+	access, err := NewProviderDefault(commontypes.CloudProviderAWS)
+	if err != nil {
+		t.Fatalf("NewProviderDefault() error = %v", err)
+	}
+	spec, err := access.GetFallbackCSINodeSpec("m5.large")
+	if err != nil {
+		t.Fatalf("GetFallbackCSINodeSpec() error = %v", err)
+	}
+	if len(spec.Drivers) != 1 || spec.Drivers[0].Name != "ebs.csi.aws.com" {
+		t.Fatalf("drivers = %+v, want single driver %q", spec.Drivers, "ebs.csi.aws.com")
+	}
+	if got := *spec.Drivers[0].Allocatable.Count; got != 26 {
+		t.Errorf("allocatable volume count = %d, want %d", got, 26)
+	}
+
+	if _, err = NewProviderDefault("unknown"); !errors.Is(err, plannerapi.ErrCreateStorageMetaAccess) || !errors.Is(err, commonerrors.ErrUnsupportedCloudProvider) {
+		t.Errorf("NewProviderDefault() error = %v for unknown provider, want %v", err, commonerrors.ErrUnsupportedCloudProvider)
+	}
+}
+
+func TestNewProviderDefaultWithoutCatalogVolumeLimits(t *testing.T) {
+	for provider, driverName := range map[commontypes.CloudProvider]string{
+		commontypes.CloudProviderGCP:       "pd.csi.storage.gke.io",
+		commontypes.CloudProviderAzure:     "disk.csi.azure.com",
+		commontypes.CloudProviderAli:       "diskplugin.csi.alibabacloud.com",
+		commontypes.CloudProviderOpenStack: "cinder.csi.openstack.org",
+	} {
+		access, err := NewProviderDefault(provider)
+		if err != nil {
+			t.Fatalf("NewProviderDefault(%q) error = %v", provider, err)
+		}
+		spec, err := access.GetFallbackCSINodeSpec("any.large")
+		if err != nil {
+			t.Fatalf("GetFallbackCSINodeSpec() error = %v for provider %q", err, provider)
+		}
+		if len(spec.Drivers) != 1 || spec.Drivers[0].Name != driverName {
+			t.Fatalf("drivers = %+v for provider %q, want single driver %q", spec.Drivers, provider, driverName)
+		}
+		if got := *spec.Drivers[0].Allocatable.Count; got != DefaultMaxVolumes {
+			t.Errorf("allocatable volume count for provider %q = %d, want %d", provider, got, DefaultMaxVolumes)
+		}
+	}
+}
+
+func TestGetFallbackCSINodeSpecForInstanceType(t *testing.T) {
+	access, err := NewProviderDefault(commontypes.CloudProviderAWS)
+	if err != nil {
+		t.Fatalf("NewProviderDefault() error = %v", err)
+	}
+	for instanceType, want := range map[string]int32{"c3.8xlarge": 38, "unknown.large": 26} {
+		spec, err := access.GetFallbackCSINodeSpec(instanceType)
+		if err != nil {
+			t.Fatalf("GetFallbackCSINodeSpec(%q) error = %v", instanceType, err)
+		}
+		if got := *spec.Drivers[0].Allocatable.Count; got != want {
+			t.Errorf("allocatable volume count of %q = %d, want %d", instanceType, got, want)
+		}
+	}
+}
+
+func TestLoadVolumeLimitsCatalog(t *testing.T) {
+	catalogPath := filepath.Join(t.TempDir(), "volume-limits.json")
+	data := `{"providers":{"gcp":{"driverName":"pd.csi.storage.gke.io","defaultMaxVolumes":15,"instanceTypes":{"n2-standard-4":127}}}}`
+	if err := os.WriteFile(catalogPath, []byte(data), 0600); err != nil {
+		t.Fatalf("cannot write catalog: %v", err)
+	}
+	catalog, err := LoadVolumeLimitsCatalog(catalogPath)
+	if err != nil {
+		t.Fatalf("LoadVolumeLimitsCatalog() error = %v", err)
+	}
+	access, err := New(commontypes.CloudProviderGCP, catalog)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	spec, err := access.GetFallbackCSINodeSpec("n2-standard-4")
+	if err != nil {
+		t.Fatalf("GetFallbackCSINodeSpec() error = %v", err)
+	}
+	if got := *spec.Drivers[0].Allocatable.Count; got != 127 {
+		t.Errorf("allocatable volume count = %d, want %d", got, 127)
+	}
+
+	if _, err = New(commontypes.CloudProviderAWS, catalog); !errors.Is(err, commonerrors.ErrUnsupportedCloudProvider) {
+		t.Errorf("New() error = %v for provider missing in catalog, want %v", err, commonerrors.ErrUnsupportedCloudProvider)
+	}
+}
+
+func TestNewWithInvalidVolumeLimits(t *testing.T) {
+	for name, limits := range map[string]plannerapi.ProviderVolumeLimits{
+		"missing driver name":        {DefaultMaxVolumes: 15},
+		"zero default max volumes":   {DriverName: "pd.csi.storage.gke.io"},
+		"negative instance type max": {DriverName: "pd.csi.storage.gke.io", DefaultMaxVolumes: 15, InstanceTypes: map[string]int32{"n2-standard-4": -1}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			catalog := plannerapi.VolumeLimitsCatalog{Providers: map[commontypes.CloudProvider]plannerapi.ProviderVolumeLimits{commontypes.CloudProviderGCP: limits}}
+			if _, err := New(commontypes.CloudProviderGCP, catalog); !errors.Is(err, plannerapi.ErrCreateStorageMetaAccess) {
+				t.Errorf("New() error = %v, want %v", err, plannerapi.ErrCreateStorageMetaAccess)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"cmp"
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path"
 	"slices"
@@ -19,6 +20,7 @@ import (
 	"github.com/gardener/scaling-advisor/api/minkapi/typeinfo"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 	"github.com/gardener/scaling-advisor/common/ioutil"
+	"github.com/gardener/scaling-advisor/common/nodeutil"
 	"github.com/gardener/scaling-advisor/common/podutil"
 	commontestutil "github.com/gardener/scaling-advisor/common/testutil"
 	"github.com/gardener/scaling-advisor/common/volutil"
@@ -26,8 +28,10 @@ import (
 	pricingtestutil "github.com/gardener/scaling-advisor/pricing/testutil"
 	"github.com/gardener/scaling-advisor/samples"
 	gocmp "github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	corev1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
@@ -50,6 +54,12 @@ type Args struct {
 	PoolZones   [][]string
 	VolGenInput samples.VolGenInput
 	Timeout     time.Duration
+	// LookaheadBeamWidth is the LookaheadBeamWidth of the simulator config. The lookahead search is disabled if less than 2.
+	LookaheadBeamWidth int
+	// SpotInterruptionPenaltyPercent is the SpotInterruptionPenaltyPercent of the simulator config.
+	SpotInterruptionPenaltyPercent float64
+	// MetricsRecorder is the MetricsRecorder of the planner and its SchedulerLauncher. Metrics are not recorded if nil.
+	MetricsRecorder plannerapi.MetricsRecorder
 }
 
 // Data holds all the common test data necessary for carrying out the scale-out unit-tests of the ScalingPlanner and asserting conditions
@@ -160,25 +170,71 @@ func GenAndFillStorageAndVolumeObjects(t *testing.T, testGenDir string, volGenIn
 	return
 }
 
-// AssertExactScaleOutPlan asserts that the wanted ScaleOutPlan matches the gotten ScaleOutPlan
+// AssertExactScaleOutPlan asserts that the wanted ScaleOutPlan matches the gotten ScaleOutPlan. The hourly cost
+// estimates and the pod names of the items are not compared since they depend on the pricing data and on the choice
+// among equally scored pods respectively.
 func AssertExactScaleOutPlan(t *testing.T, want, got *sacorev1alpha1.ScaleOutPlan) bool {
 	if got == nil {
 		t.Fatalf("got nil ScaleOutPlan, want not nil ScaleOutPlan")
 		return false
 	}
-	slices.SortFunc(want.Items, func(a, b sacorev1alpha1.ScaleOutItem) int {
-		return strings.Compare(a.PoolName, b.PoolName)
-	})
-	slices.SortFunc(got.Items, func(a, b sacorev1alpha1.ScaleOutItem) int {
-		return strings.Compare(a.PoolName, b.PoolName)
-	})
-	if diff := gocmp.Diff(want, got); diff != "" {
+	compareItems := func(a, b sacorev1alpha1.ScaleOutItem) int {
+		return cmp.Or(strings.Compare(a.PoolName, b.PoolName), strings.Compare(a.TemplateName, b.TemplateName),
+			strings.Compare(a.AvailabilityZone, b.AvailabilityZone))
+	}
+	slices.SortFunc(want.Items, compareItems)
+	slices.SortFunc(got.Items, compareItems)
+	ignoreEstimates := cmpopts.IgnoreFields(sacorev1alpha1.ScaleOutItem{}, "HourlyCostDelta", "PodNames")
+	ignoreTotalCost := cmpopts.IgnoreFields(sacorev1alpha1.ScaleOutPlan{}, "TotalHourlyCostDelta")
+	if diff := gocmp.Diff(want, got, ignoreEstimates, ignoreTotalCost); diff != "" {
 		t.Errorf("ScaleOutPlan mismatch (-want +got):\n%s", diff)
 		return false
 	}
 	return true
 }
 
+// AddExistingNodesAndBindPods adds numNodes existing nodes for the given NodePlacement, created from the matching
+// NodeTemplate of the request constraint, into the snapshot of the request within testData and binds the pods of the
+// snapshot to these nodes in round-robin order. The nodes are created with the given age.
+func AddExistingNodesAndBindPods(t *testing.T, testData *Data, placement sacorev1alpha1.NodePlacement, numNodes int, age time.Duration) (nodeNames []string, ok bool) {
+	pool := testData.Request.Constraint.Spec.GetNodePool(placement.PoolName)
+	if pool == nil {
+		t.Fatalf("node pool %q not found in constraint", placement.PoolName)
+		return
+	}
+	idx := slices.IndexFunc(pool.NodeTemplates, func(nt sacorev1alpha1.NodeTemplate) bool {
+		return nt.Name == placement.TemplateName
+	})
+	if idx < 0 {
+		t.Fatalf("node template %q not found in node pool %q", placement.TemplateName, placement.PoolName)
+		return
+	}
+	template := pool.NodeTemplates[idx]
+	creationTime := metav1.NewTime(testData.Request.CreationTime.Add(-age))
+	for i := range numNodes {
+		name := fmt.Sprintf("node-%d_%s_%s_%s", i, placement.PoolName, placement.TemplateName, placement.AvailabilityZone)
+		labels := make(map[string]string)
+		nodeutil.AddNodeLabels(labels, template.Architecture, name, placement)
+		testData.Request.Snapshot.Nodes = append(testData.Request.Snapshot.Nodes, plannerapi.NodeInfo{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            labels,
+				CreationTimestamp: creationTime,
+			},
+			InstanceType: template.InstanceType,
+			Capacity:     template.Capacity,
+			Allocatable:  nodeutil.BuildAllocatable(template.Capacity, template.SystemReserved, template.KubeReserved),
+			Conditions:   nodeutil.BuildReadyConditions(creationTime.Time),
+		})
+		nodeNames = append(nodeNames, name)
+	}
+	for i := range testData.Request.Snapshot.Pods {
+		testData.Request.Snapshot.Pods[i].NodeName = nodeNames[i%numNodes]
+	}
+	ok = true
+	return
+}
+
 // ObtainAndAssertScaleOutPlan executes the given planner with the context and request within testData, obtains the plannerapi.Response
 // logs the same, and asserts that the embedded ScaleOutPlan within the Response matches the wanted ScaleOutPlan.
 // Returns true if all assertions succeeded or false if assertion failed or on any error.
@@ -273,22 +329,26 @@ func CreateTestScalingPlanner(t *testing.T, args Args, traceDir string, verbosit
 		}
 	}
 	simulatorConfig.BindVolumeClaimsForImmediateMode = true
-	schedulerLauncher, err := scheduler.NewLauncherFromConfig(schedulerConfigBytes, simulatorConfig.MaxParallelSimulations)
+	simulatorConfig.LookaheadBeamWidth = args.LookaheadBeamWidth
+	simulatorConfig.SpotInterruptionPenaltyPercent = args.SpotInterruptionPenaltyPercent
+	schedulerLauncher, err := scheduler.NewLauncherFromConfig(schedulerConfigBytes, simulatorConfig.MaxParallelSimulations, args.MetricsRecorder)
 	if err != nil {
 		t.Fatalf("failed to create SchedulerLauncher: %v", err)
 		return
 	}
 	storageMetaAccess := &testStorageMetaAccess{provider: args.VolGenInput.Provider}
 	scalePlannerArgs := plannerapi.ScalingPlannerArgs{
-		ViewAccess:        viewAccess,
-		ResourceWeigher:   args.Factories.ResourceWeigher,
-		PricingAccess:     pricingAccess,
-		SchedulerLauncher: schedulerLauncher,
-		StorageMetaAccess: storageMetaAccess,
-		SimulatorConfig:   simulatorConfig,
-		SimulatorFactory:  args.Factories.Simulator,
-		SimulationFactory: args.Factories.Simulation,
-		TraceDir:          traceDir,
+		ViewAccess:         viewAccess,
+		ResourceWeigher:    args.Factories.ResourceWeigher,
+		NodeScorerRegistry: args.Factories.NodeScorerRegistry,
+		PricingAccess:      pricingAccess,
+		SchedulerLauncher:  schedulerLauncher,
+		StorageMetaAccess:  storageMetaAccess,
+		SimulatorConfig:    simulatorConfig,
+		SimulatorFactory:   args.Factories.Simulator,
+		SimulationFactory:  args.Factories.Simulation,
+		TraceDir:           traceDir,
+		MetricsRecorder:    args.MetricsRecorder,
 	}
 	planr, err = args.Factories.Planner.NewPlanner(scalePlannerArgs)
 	if err != nil {
@@ -0,0 +1,245 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package weigher
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"unicode"
+
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	pricingapi "github.com/gardener/scaling-advisor/api/pricing"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ResourceGPU is the extended resource name of GPUs whose weight is derived from the GPU count of the pricing data.
+	ResourceGPU corev1.ResourceName = "nvidia.com/gpu"
+	// bytesPerGiB is the number of bytes in a GiB.
+	bytesPerGiB = 1 << 30
+	// defaultEphemeralStorageWeightPerGiB is the default weight of a GiB of ephemeral-storage relative to a GiB of memory.
+	defaultEphemeralStorageWeightPerGiB = 0.01
+	// familyRidgeFactor is the factor of the ridge penalty pulling the unit prices fitted for an instance family towards
+	// the unit prices fitted across all instance families, relative to the magnitude of the pricing data of the family.
+	// It keeps the fit well-defined for families whose instance types all have the same ratio of resources.
+	familyRidgeFactor = 0.1
+)
+
+// unitPrices are the hourly prices of one core of cpu, one GiB of memory and one GPU, in this order.
+type unitPrices [3]float64
+
+// fallbackUnitPrices are the unit prices used if they cannot be fitted from the pricing data.
+var fallbackUnitPrices = unitPrices{9, 1, 20}
+
+var _ plannerapi.ResourceWeigher = (*pricingResourceWeigher)(nil)
+
+type pricingResourceWeigher struct {
+	config          plannerapi.ResourceWeightsConfig
+	infosByFamily   map[string][]pricingapi.InstancePriceInfo
+	weightsByFamily map[string]map[corev1.ResourceName]float64
+	globalPrices    unitPrices
+	mu              sync.Mutex
+}
+
+// NewPricingResourceWeigher returns a ResourceWeigher that derives the weights of cpu, memory and GPUs per instance
+// family from the on-demand prices of the given pricingAccess, and caches them by instance family.
+//
+// The hourly prices of one core of cpu, one GiB of memory and one GPU are first fitted by least squares regression
+// across all instance types and then per instance family, where the fit of a family is regularized towards the fit
+// across all instance types. The weights are the fitted unit prices relative to the unit price of memory. The weights
+// of other resources are taken from config.Default, where ephemeral-storage has a small default weight, and the
+// weights of config.InstanceFamilies override all others.
+func NewPricingResourceWeigher(pricingAccess pricingapi.InstancePricingAccess, config plannerapi.ResourceWeightsConfig) (plannerapi.ResourceWeigher, error) {
+	if pricingAccess == nil {
+		return nil, fmt.Errorf("%w: pricing access is required", plannerapi.ErrCreateResourceWeigher)
+	}
+	for name, weight := range config.Default {
+		if weight < 0 {
+			return nil, fmt.Errorf("%w: default weight of resource %q must not be negative", plannerapi.ErrCreateResourceWeigher, name)
+		}
+	}
+	for family, weights := range config.InstanceFamilies {
+		for name, weight := range weights {
+			if weight < 0 {
+				return nil, fmt.Errorf("%w: weight of resource %q for instance family %q must not be negative", plannerapi.ErrCreateResourceWeigher, name, family)
+			}
+		}
+	}
+	w := &pricingResourceWeigher{
+		config:          config,
+		infosByFamily:   make(map[string][]pricingapi.InstancePriceInfo),
+		weightsByFamily: make(map[string]map[corev1.ResourceName]float64),
+	}
+	var infos []pricingapi.InstancePriceInfo
+	for _, info := range pricingAccess.ListInfos() {
+		if info.CapacityType.IsSpot() || info.HourlyPrice <= 0 {
+			continue
+		}
+		family := getInstanceFamily(info.InstanceType)
+		w.infosByFamily[family] = append(w.infosByFamily[family], info)
+		infos = append(infos, info)
+	}
+	var ok bool
+	if w.globalPrices, ok = fitUnitPrices(infos, nil); !ok {
+		w.globalPrices = fallbackUnitPrices
+	}
+	return w, nil
+}
+
+// GetWeights returns the resource weights for the instance family of the given instanceType.
+func (w *pricingResourceWeigher) GetWeights(instanceType string) (map[corev1.ResourceName]float64, error) {
+	family := getInstanceFamily(instanceType)
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if weights, ok := w.weightsByFamily[family]; ok {
+		return weights, nil
+	}
+	prices, ok := fitUnitPrices(w.infosByFamily[family], &w.globalPrices)
+	if !ok {
+		prices = w.globalPrices
+	}
+	weights := map[corev1.ResourceName]float64{
+		corev1.ResourceEphemeralStorage: defaultEphemeralStorageWeightPerGiB / bytesPerGiB,
+	}
+	maps.Copy(weights, w.config.Default)
+	weights[corev1.ResourceCPU] = prices[0] / prices[1]
+	weights[corev1.ResourceMemory] = 1.0 / bytesPerGiB
+	if prices[2] > 0 {
+		weights[ResourceGPU] = prices[2] / prices[1]
+	}
+	maps.Copy(weights, w.config.InstanceFamilies[family])
+	w.weightsByFamily[family] = weights
+	return weights, nil
+}
+
+// fitUnitPrices fits the unitPrices of the given infos by least squares regression of their hourly prices. If prior is
+// not nil, the fit is regularized towards it and resources not present in any of the infos are assigned their prior
+// unit price. It returns false if the unit prices cannot be fitted or are not positive.
+func fitUnitPrices(infos []pricingapi.InstancePriceInfo, prior *unitPrices) (prices unitPrices, ok bool) {
+	var (
+		a [3][3]float64
+		b [3]float64
+	)
+	for _, info := range infos {
+		x := unitPrices{float64(info.VCPU), float64(info.Memory) / bytesPerGiB, float64(info.GPU)}
+		for i := range x {
+			for j := range x {
+				a[i][j] += x[i] * x[j]
+			}
+			b[i] += x[i] * info.HourlyPrice
+		}
+	}
+	// only fit the unit prices of resources present in the infos.
+	var present []int
+	for i := range a {
+		if a[i][i] > 0 {
+			present = append(present, i)
+		} else if prior != nil {
+			prices[i] = prior[i]
+		}
+	}
+	if len(present) == 0 {
+		return
+	}
+	m := make([][]float64, len(present))
+	for r, i := range present {
+		m[r] = make([]float64, len(present)+1)
+		for c, j := range present {
+			m[r][c] = a[i][j]
+		}
+		m[r][len(present)] = b[i]
+		if prior != nil {
+			m[r][r] += familyRidgeFactor * a[i][i]
+			m[r][len(present)] += familyRidgeFactor * a[i][i] * prior[i]
+		}
+	}
+	solution, ok := solveLinearSystem(m)
+	if !ok {
+		return
+	}
+	for r, i := range present {
+		if solution[r] <= 0 {
+			return prices, false
+		}
+		prices[i] = solution[r]
+	}
+	if prices[0] <= 0 || prices[1] <= 0 {
+		return prices, false
+	}
+	return prices, true
+}
+
+// solveLinearSystem solves the linear system given by the augmented matrix m using Gaussian elimination with partial
+// pivoting. It returns false if the system has no unique solution.
+func solveLinearSystem(m [][]float64) ([]float64, bool) {
+	n := len(m)
+	for col := range n {
+		pivot := col
+		for r := col + 1; r < n; r++ {
+			if math.Abs(m[r][col]) > math.Abs(m[pivot][col]) {
+				pivot = r
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-12 {
+			return nil, false
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+		for r := col + 1; r < n; r++ {
+			f := m[r][col] / m[col][col]
+			for c := col; c <= n; c++ {
+				m[r][c] -= f * m[col][c]
+			}
+		}
+	}
+	solution := make([]float64, n)
+	for r := n - 1; r >= 0; r-- {
+		sum := m[r][n]
+		for c := r + 1; c < n; c++ {
+			sum -= m[r][c] * solution[c]
+		}
+		solution[r] = sum / m[r][r]
+	}
+	return solution, true
+}
+
+// getInstanceFamily returns the instance family of the given instanceType by removing its size. For example, the
+// family of the AWS instance type "m5.large" is "m5", of the Alibaba Cloud instance type "ecs.g6.large" is "ecs.g6",
+// of the GCP machine type "n2-standard-4" is "n2-standard" and of the Azure VM size "Standard_D4s_v3" is
+// "Standard_Ds_v3".
+func getInstanceFamily(instanceType string) string {
+	if i := strings.LastIndex(instanceType, "."); i > 0 {
+		return instanceType[:i]
+	}
+	if i := strings.LastIndex(instanceType, "-"); i > 0 {
+		return instanceType[:i]
+	}
+	start := strings.IndexFunc(instanceType, unicode.IsDigit)
+	if start < 0 {
+		return instanceType
+	}
+	end := start
+	for end < len(instanceType) && unicode.IsDigit(rune(instanceType[end])) {
+		end++
+	}
+	return instanceType[:start] + instanceType[end:]
+}
+
+// LoadResourceWeightsConfig loads the ResourceWeightsConfig from the JSON file at the given configPath.
+func LoadResourceWeightsConfig(configPath string) (config plannerapi.ResourceWeightsConfig, err error) {
+	data, err := os.ReadFile(filepath.Clean(configPath))
+	if err != nil {
+		return
+	}
+	if err = json.Unmarshal(data, &config); err != nil {
+		err = fmt.Errorf("%w: cannot parse resource weights config %q: %w", plannerapi.ErrCreateResourceWeigher, configPath, err)
+	}
+	return
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package weigher
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gardener/scaling-advisor/pricing"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const testPricingData = `[
+  {"instanceType": "m5.large", "region": "r", "VCPU": 2, "memory": 8589934592, "hourlyPrice": 0.096},
+  {"instanceType": "m5.xlarge", "region": "r", "VCPU": 4, "memory": 17179869184, "hourlyPrice": 0.192},
+  {"instanceType": "c5.large", "region": "r", "VCPU": 2, "memory": 4294967296, "hourlyPrice": 0.085},
+  {"instanceType": "c5.xlarge", "region": "r", "VCPU": 4, "memory": 8589934592, "hourlyPrice": 0.17},
+  {"instanceType": "r5.large", "region": "r", "VCPU": 2, "memory": 17179869184, "hourlyPrice": 0.126},
+  {"instanceType": "r5.xlarge", "region": "r", "VCPU": 4, "memory": 34359738368, "hourlyPrice": 0.252},
+  {"instanceType": "g4dn.xlarge", "region": "r", "VCPU": 4, "memory": 17179869184, "GPU": 1, "hourlyPrice": 0.526},
+  {"instanceType": "g4dn.2xlarge", "region": "r", "VCPU": 8, "memory": 34359738368, "GPU": 1, "hourlyPrice": 0.752},
+  {"instanceType": "c5.large", "region": "r", "VCPU": 2, "memory": 4294967296, "hourlyPrice": 0.001, "capacityType": "spot"}
+]`
+
+func TestPricingResourceWeigher(t *testing.T) {
+	access, err := pricing.GetInstancePricingFromData(commontypes.CloudProviderAWS, []byte(testPricingData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := plannerapi.ResourceWeightsConfig{
+		Default:          map[corev1.ResourceName]float64{"example.com/fpga": 3, corev1.ResourceCPU: 100},
+		InstanceFamilies: map[string]map[corev1.ResourceName]float64{"r5": {corev1.ResourceCPU: 7}},
+	}
+	w, err := NewPricingResourceWeigher(access, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	getWeights := func(instanceType string) map[corev1.ResourceName]float64 {
+		weights, err := w.GetWeights(instanceType)
+		if err != nil {
+			t.Fatalf("GetWeights(%q) error = %v", instanceType, err)
+		}
+		return weights
+	}
+	m5Weights := getWeights("m5.large")
+	c5Weights := getWeights("c5.xlarge")
+	if m5Weights[corev1.ResourceMemory] != 1.0/bytesPerGiB {
+		t.Errorf("memory weight = %v, want weight of one byte relative to a GiB", m5Weights[corev1.ResourceMemory])
+	}
+	if c5Weights[corev1.ResourceCPU] == m5Weights[corev1.ResourceCPU] {
+		t.Errorf("cpu weight of family c5 = %v, want different from cpu weight of family m5", c5Weights[corev1.ResourceCPU])
+	}
+	pw := w.(*pricingResourceWeigher)
+	for family, infos := range pw.infosByFamily {
+		prices, ok := fitUnitPrices(infos, &pw.globalPrices)
+		if !ok {
+			t.Errorf("cannot fit unit prices of family %q", family)
+			continue
+		}
+		for _, info := range infos {
+			fitted := prices[0]*float64(info.VCPU) + prices[1]*float64(info.Memory)/bytesPerGiB + prices[2]*float64(info.GPU)
+			if math.Abs(fitted-info.HourlyPrice) > 0.05*info.HourlyPrice {
+				t.Errorf("fitted hourly price of %q = %v, want within 5%% of %v", info.InstanceType, fitted, info.HourlyPrice)
+			}
+		}
+	}
+	if got := m5Weights["example.com/fpga"]; got != 3 {
+		t.Errorf("weight of extended resource = %v, want %v from default config", got, 3)
+	}
+	if got := m5Weights[corev1.ResourceEphemeralStorage]; got <= 0 || got >= m5Weights[corev1.ResourceMemory] {
+		t.Errorf("ephemeral-storage weight = %v, want positive and less than memory weight", got)
+	}
+	if got := getWeights("r5.large")[corev1.ResourceCPU]; got != 7 {
+		t.Errorf("cpu weight of family r5 = %v, want %v from instance family config", got, 7)
+	}
+	if got := getWeights("g4dn.xlarge")[ResourceGPU]; got <= m5Weights[corev1.ResourceCPU] {
+		t.Errorf("GPU weight = %v, want greater than cpu weight %v", got, m5Weights[corev1.ResourceCPU])
+	}
+	unknownWeights := getWeights("x9.large")
+	if math.Abs(unknownWeights[corev1.ResourceCPU]-pw.globalPrices[0]/pw.globalPrices[1]) > 1e-9 {
+		t.Errorf("cpu weight of unknown family = %v, want the weight fitted across all families", unknownWeights[corev1.ResourceCPU])
+	}
+	if again := getWeights("m5.xlarge"); len(again) != len(m5Weights) || again[corev1.ResourceCPU] != m5Weights[corev1.ResourceCPU] {
+		t.Errorf("weights of instance types of the same family differ: %v and %v", again, m5Weights)
+	}
+}
+
+func TestNewPricingResourceWeigherRejectsNegativeWeights(t *testing.T) {
+	access, err := pricing.GetInstancePricingFromData(commontypes.CloudProviderAWS, []byte(testPricingData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	config := plannerapi.ResourceWeightsConfig{
+		InstanceFamilies: map[string]map[corev1.ResourceName]float64{"m5": {corev1.ResourceCPU: -1}},
+	}
+	if _, err = NewPricingResourceWeigher(access, config); err == nil {
+		t.Errorf("NewPricingResourceWeigher() error = nil for negative weight, want error")
+	}
+}
+
+func TestGetInstanceFamily(t *testing.T) {
+	tests := map[string]string{
+		"m5.large":        "m5",
+		"ecs.g6.large":    "ecs.g6",
+		"n2-standard-4":   "n2-standard",
+		"Standard_D4s_v3": "Standard_Ds_v3",
+		"small":           "small",
+	}
+	for instanceType, want := range tests {
+		if got := getInstanceFamily(instanceType); got != want {
+			t.Errorf("getInstanceFamily(%q) = %q, want %q", instanceType, got, want)
+		}
+	}
+}
@@ -11,22 +11,20 @@ import (
 
 var _ plannerapi.ResourceWeigher = (*defaultResourceWeigher)(nil)
 
-// New returns the default instance of ResourceWeigher.
+// New returns the default instance of ResourceWeigher, which returns the same static weights for all instance types.
+// Use NewPricingResourceWeigher for weights derived per instance family from pricing data.
 func New() plannerapi.ResourceWeigher {
 	return &defaultResourceWeigher{
 		weights: createDefaultWeights(),
 	}
 }
 
-// GetWeights the resource weights for the given instanceType. Currently, this ignores instanceType parameter but this
-// needs to be enhanced.
+// GetWeights the resource weights for the given instanceType. This ignores the instanceType parameter.
 func (w *defaultResourceWeigher) GetWeights(_ string) (map[corev1.ResourceName]float64, error) {
 	return w.weights, nil
 }
 
 // createDefaultWeights returns default weights.
-// TODO: This is invalid. One must give specific weights for different instance families
-// TODO: solve the normalized unit weight linear optimization problem
 func createDefaultWeights() map[corev1.ResourceName]float64 {
 	return map[corev1.ResourceName]float64{
 		//corev1.ResourceEphemeralStorage: 1, // TODO: what should be weight for this ?
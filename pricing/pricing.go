@@ -5,10 +5,13 @@
 package pricing
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 
 	commontypes "github.com/gardener/scaling-advisor/api/common/types"
 	pricingapi "github.com/gardener/scaling-advisor/api/pricing"
@@ -44,8 +47,9 @@ func parseInstanceTypeInfos(data []byte) (map[pricingapi.PriceKey]pricingapi.Ins
 	infosByPriceKey := make(map[pricingapi.PriceKey]pricingapi.InstancePriceInfo, len(jsonEntries))
 	for _, info := range jsonEntries {
 		key := pricingapi.PriceKey{
-			Name:   info.InstanceType,
-			Region: info.Region,
+			Name:         info.InstanceType,
+			Region:       info.Region,
+			CapacityType: info.CapacityType.Canonical(),
 		}
 		infosByPriceKey[key] = info
 	}
@@ -59,14 +63,22 @@ type infoAccess struct {
 	CloudProvider   commontypes.CloudProvider
 }
 
-func (a *infoAccess) GetInfo(region, instanceType string) (info pricingapi.InstancePriceInfo, err error) {
+func (a *infoAccess) GetInfo(region, instanceType string, capacityType commontypes.CapacityType) (info pricingapi.InstancePriceInfo, err error) {
 	info, ok := a.infosByPriceKey[pricingapi.PriceKey{
-		Name:   instanceType,
-		Region: region,
+		Name:         instanceType,
+		Region:       region,
+		CapacityType: capacityType.Canonical(),
 	}]
 	if ok {
 		return
 	}
-	err = fmt.Errorf("no instance type info found for instanceType %q in region %q ", instanceType, region)
+	err = fmt.Errorf("no instance type info found for instanceType %q in region %q with capacity type %q", instanceType, region, capacityType.OrDefault())
 	return
 }
+
+func (a *infoAccess) ListInfos() []pricingapi.InstancePriceInfo {
+	return slices.SortedFunc(maps.Values(a.infosByPriceKey), func(x, y pricingapi.InstancePriceInfo) int {
+		return cmp.Or(cmp.Compare(x.InstanceType, y.InstanceType), cmp.Compare(x.Region, y.Region),
+			cmp.Compare(x.CapacityType.Canonical(), y.CapacityType.Canonical()))
+	})
+}
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	"github.com/gardener/scaling-advisor/pricing/testutil"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
 )
 
 func TestGetInstancePricing(t *testing.T) {
@@ -20,11 +22,11 @@ func TestGetInstancePricing(t *testing.T) {
 		t.Error("access is nil")
 		return
 	}
-	_, err = access.GetInfo("region_a", "instance_type_1")
+	_, err = access.GetInfo("region_a", "instance_type_1", "")
 	if err != nil {
 		t.Error("failed to fetch instance price for instance_type_1")
 	}
-	_, err = access.GetInfo("region_b", "instance_type_2")
+	_, err = access.GetInfo("region_b", "instance_type_2", "")
 	if err != nil {
 		t.Error("failed to fetch instance price for instance_type_2")
 	}
@@ -35,12 +37,17 @@ func TestGetPrice(t *testing.T) {
 		name         string
 		region       string
 		instanceType string
+		capacityType commontypes.CapacityType
+		price        float64
 		error        bool
 	}{
-		{"valid region and instance", "region_a", "instance_type_1", false},
-		{"valid region and instance, second entry", "region_b", "instance_type_2", false},
-		{"valid region but invalid instance", "region_a", "invalid_instance", true},
-		{"invalid region but valid instance", "invalid_region", "instance_type_2", true},
+		{"valid region and instance", "region_a", "instance_type_1", "", 105, false},
+		{"valid region and instance, second entry", "region_b", "instance_type_2", "", 206.87, false},
+		{"valid region but invalid instance", "region_a", "invalid_instance", "", 0, true},
+		{"invalid region but valid instance", "invalid_region", "instance_type_2", "", 0, true},
+		{"explicit on-demand capacity type", "s", "instance-a-2", commontypes.CapacityTypeOnDemand, 2, false},
+		{"spot capacity type", "s", "instance-a-2", commontypes.CapacityTypeSpot, 1, false},
+		{"spot capacity type without spot price", "s", "instance-a-1", commontypes.CapacityTypeSpot, 0, true},
 	}
 
 	access, err := testutil.GetInstancePricingAccessWithFakeData()
@@ -51,11 +58,13 @@ func TestGetPrice(t *testing.T) {
 
 	for _, entry := range entries {
 		t.Run(entry.name, func(t *testing.T) {
-			_, err := access.GetInfo(entry.region, entry.instanceType)
+			info, err := access.GetInfo(entry.region, entry.instanceType, entry.capacityType)
 			if entry.error && err == nil {
 				t.Error("expected error, found no error instead")
 			} else if !entry.error && err != nil {
 				t.Errorf("expected no error, got error instead: %v", err)
+			} else if info.HourlyPrice != entry.price {
+				t.Errorf("expected hourly price %v, got %v", entry.price, info.HourlyPrice)
 			}
 		})
 	}
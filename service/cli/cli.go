@@ -7,22 +7,34 @@ package cli
 import (
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"os"
 	"path"
+	"path/filepath"
+	"strings"
 
 	"github.com/gardener/scaling-advisor/service/internal/core"
 
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
 	commonerrors "github.com/gardener/scaling-advisor/api/common/errors"
 	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	configv1alpha1 "github.com/gardener/scaling-advisor/api/config/v1alpha1"
+	configv1alpha1validation "github.com/gardener/scaling-advisor/api/config/v1alpha1/validation"
 	"github.com/gardener/scaling-advisor/api/minkapi"
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 	"github.com/gardener/scaling-advisor/common/cliutil"
+	"github.com/gardener/scaling-advisor/common/objutil"
 	mkcli "github.com/gardener/scaling-advisor/minkapi/cli"
 	"github.com/gardener/scaling-advisor/planner"
+	"github.com/gardener/scaling-advisor/planner/storagemeta"
+	"github.com/gardener/scaling-advisor/planner/weigher"
 	"github.com/gardener/scaling-advisor/pricing"
 	"github.com/go-logr/logr"
 	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
 )
 
 // App represents an application process `scaling-planner` that wraps a ScalingPlannerService, an application context
@@ -37,9 +49,19 @@ type App struct {
 	Cancel context.CancelFunc
 }
 
+// ErrLoadScalingPlannerConfig is a sentinel error representing a problem loading the scaling-planner configuration.
+var ErrLoadScalingPlannerConfig = fmt.Errorf("cannot load %q config", plannerapi.ServiceName)
+
 // Opts is a struct that encapsulates target fields for CLI options parsing.
 type Opts struct {
+	// ConfigFile is the path to the optional ScalingPlannerConfig file, which cannot be combined with the flags
+	// configuring the scaling planner.
+	ConfigFile          string
 	InstancePricingPath string
+	// ResourceWeightsConfigPath is the path to the optional ResourceWeightsConfig file overriding resource weights.
+	ResourceWeightsConfigPath string
+	// VolumeLimitsCatalogPath is the path to the optional VolumeLimitsCatalog file overriding the default volume limits.
+	VolumeLimitsCatalogPath string
 	// CloudProvider is the cloud provider for which the scaling advisor planner is initialized.
 	CloudProvider    string
 	TraceDir         string
@@ -47,6 +69,14 @@ type Opts struct {
 	ClientConfig     commontypes.QPSBurst
 	WatchConfig      minkapi.WatchConfig
 	SimulationConfig plannerapi.SimulatorConfig
+	// MinKAPIBindAddress is the bind address of the embedded MinKAPI server.
+	MinKAPIBindAddress string
+	// PlanJobConfig holds the configuration for plan jobs.
+	PlanJobConfig plannerapi.PlanJobConfig
+	// GRPCBindAddress is the bind address of the gRPC server, which is not started if empty.
+	GRPCBindAddress string
+	// TracingConfig holds the configuration of the OpenTelemetry tracing.
+	TracingConfig commontypes.TracingConfig
 }
 
 // ParseProgramFlags parses the command line arguments and returns Opts.
@@ -56,6 +86,11 @@ func ParseProgramFlags(args []string) (*Opts, error) {
 	if err != nil {
 		return nil, err
 	}
+	if opts.ConfigFile != "" {
+		if err = validateFlagsWithConfigFile(flagSet); err != nil {
+			return nil, err
+		}
+	}
 	err = opts.validate()
 	if err != nil {
 		return nil, err
@@ -89,34 +124,36 @@ func LaunchApp(ctx context.Context) (app App, exitCode int, err error) {
 	cliutil.PrintVersion(plannerapi.ServiceName)
 	embeddedMinKAPIKubeConfigPath := path.Join(os.TempDir(), "embedded-minkapi.yaml")
 	log.Info("embedded minkapi-kube cfg path", "kubeConfigPath", embeddedMinKAPIKubeConfigPath)
-	cloudProvider, err := commontypes.AsCloudProvider(cliOpts.CloudProvider)
+	plannerConfig, err := cliOpts.LoadAndValidateScalingPlannerConfig()
 	if err != nil {
 		exitCode = cliutil.ExitErrParseOpts
 		return
 	}
-	cfg := plannerapi.ScalingPlannerServiceConfig{
-		ServerConfig: cliOpts.ServerConfig,
-		MinKAPIConfig: minkapi.Config{
-			BasePrefix: minkapi.DefaultBasePrefix,
-			ServerConfig: commontypes.ServerConfig{
-				BindAddress:             cliOpts.ServerConfig.BindAddress,
-				KubeConfigPath:          embeddedMinKAPIKubeConfigPath,
-				ProfilingEnabled:        cliOpts.ServerConfig.ProfilingEnabled,
-				GracefulShutdownTimeout: cliOpts.ServerConfig.GracefulShutdownTimeout,
-			},
-			WatchConfig: cliOpts.WatchConfig,
-		},
-		ClientConfig:    cliOpts.ClientConfig,
-		CloudProvider:   cloudProvider,
-		SimulatorConfig: cliOpts.SimulationConfig,
-		TraceDir:        cliOpts.TraceDir,
+	cfg := asServiceConfig(plannerConfig, embeddedMinKAPIKubeConfigPath)
+	pricingAccess, err := pricing.GetInstancePricingAccess(cfg.CloudProvider, plannerConfig.InstancePricingPath)
+	if err != nil {
+		exitCode = cliutil.ExitErrStart
+		return
 	}
-	pricingAccess, err := pricing.GetInstancePricingAccess(cloudProvider, cliOpts.InstancePricingPath)
+	storageMetaAccess, err := getStorageMetaAccess(cfg.CloudProvider, plannerConfig.VolumeLimitsCatalogPath)
 	if err != nil {
 		exitCode = cliutil.ExitErrStart
 		return
 	}
-	app.Service, err = core.NewService(app.Ctx, cfg, pricingAccess, planner.NewFactories())
+	var weightsConfig plannerapi.ResourceWeightsConfig
+	if plannerConfig.ResourceWeightsConfigPath != "" {
+		if weightsConfig, err = weigher.LoadResourceWeightsConfig(plannerConfig.ResourceWeightsConfigPath); err != nil {
+			exitCode = cliutil.ExitErrStart
+			return
+		}
+	}
+	factories := planner.NewFactories()
+	factories.ResourceWeigher, err = weigher.NewPricingResourceWeigher(pricingAccess, weightsConfig)
+	if err != nil {
+		exitCode = cliutil.ExitErrStart
+		return
+	}
+	app.Service, err = core.NewService(app.Ctx, cfg, pricingAccess, storageMetaAccess, factories)
 	if err != nil {
 		exitCode = cliutil.ExitErrStart
 		return
@@ -148,12 +185,140 @@ func ShutdownApp(app *App) (exitCode int) {
 	return
 }
 
+// LoadAndValidateScalingPlannerConfig loads the ScalingPlannerConfig from the ConfigFile, or maps it from the flags if
+// no ConfigFile is specified, and validates it after defaulting.
+func (o Opts) LoadAndValidateScalingPlannerConfig() (*configv1alpha1.ScalingPlannerConfig, error) {
+	plannerConfig := o.asScalingPlannerConfig()
+	if o.ConfigFile != "" {
+		var err error
+		if plannerConfig, err = loadScalingPlannerConfig(o.ConfigFile); err != nil {
+			return nil, err
+		}
+	}
+	configv1alpha1.SetObjectDefaults_ScalingPlannerConfig(plannerConfig)
+	if errs := configv1alpha1validation.ValidateScalingPlannerConfig(plannerConfig); len(errs) > 0 {
+		return nil, errs.ToAggregate()
+	}
+	return plannerConfig, nil
+}
+
+// loadScalingPlannerConfig loads the ScalingPlannerConfig from the file at the given configPath.
+func loadScalingPlannerConfig(configPath string) (*configv1alpha1.ScalingPlannerConfig, error) {
+	configScheme := runtime.NewScheme()
+	if err := configv1alpha1.AddToScheme(configScheme); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadScalingPlannerConfig, err)
+	}
+	plannerConfig := &configv1alpha1.ScalingPlannerConfig{}
+	configPath = filepath.Clean(configPath)
+	if err := objutil.LoadUsingSchemeIntoRuntimeObject(os.DirFS(filepath.Dir(configPath)), filepath.Base(configPath), configScheme, plannerConfig); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrLoadScalingPlannerConfig, err)
+	}
+	return plannerConfig, nil
+}
+
+// asScalingPlannerConfig maps the flags configuring the scaling planner to a ScalingPlannerConfig.
+func (o Opts) asScalingPlannerConfig() *configv1alpha1.ScalingPlannerConfig {
+	return &configv1alpha1.ScalingPlannerConfig{
+		Server:          o.ServerConfig,
+		GRPCBindAddress: o.GRPCBindAddress,
+		MinKAPI: configv1alpha1.MinKAPIConfig{
+			BindAddress:    o.MinKAPIBindAddress,
+			WatchQueueSize: o.WatchConfig.QueueSize,
+			WatchTimeout:   metav1.Duration{Duration: o.WatchConfig.Timeout},
+		},
+		ClientConnection:          o.ClientConfig,
+		CloudProvider:             commontypes.CloudProvider(o.CloudProvider),
+		InstancePricingPath:       o.InstancePricingPath,
+		ResourceWeightsConfigPath: o.ResourceWeightsConfigPath,
+		VolumeLimitsCatalogPath:   o.VolumeLimitsCatalogPath,
+		TraceDir:                  o.TraceDir,
+		Simulator: configv1alpha1.SimulatorConfig{
+			MaxParallelSimulations:           o.SimulationConfig.MaxParallelSimulations,
+			TrackPollInterval:                metav1.Duration{Duration: o.SimulationConfig.TrackPollInterval},
+			MaxUnchangedTrackAttempts:        o.SimulationConfig.MaxUnchangedTrackAttempts,
+			BindVolumeClaimsForImmediateMode: o.SimulationConfig.BindVolumeClaimsForImmediateMode,
+			LookaheadBeamWidth:               o.SimulationConfig.LookaheadBeamWidth,
+			LookaheadDepth:                   o.SimulationConfig.LookaheadDepth,
+			SpotInterruptionPenaltyPercent:   o.SimulationConfig.SpotInterruptionPenaltyPercent,
+		},
+		PlanJobs: configv1alpha1.PlanJobConfig{
+			MaxConcurrentPlanJobs: o.PlanJobConfig.MaxConcurrentPlanJobs,
+			MaxQueuedPlanJobs:     o.PlanJobConfig.MaxQueuedPlanJobs,
+			Retention:             metav1.Duration{Duration: o.PlanJobConfig.Retention},
+		},
+		Tracing: o.TracingConfig,
+	}
+}
+
+// getStorageMetaAccess returns the StorageMetaAccess of the given cloud provider backed by the VolumeLimitsCatalog at the
+// given catalogPath, or by the default VolumeLimitsCatalog if catalogPath is empty.
+func getStorageMetaAccess(provider commontypes.CloudProvider, catalogPath string) (plannerapi.StorageMetaAccess, error) {
+	if catalogPath == "" {
+		return storagemeta.NewProviderDefault(provider)
+	}
+	catalog, err := storagemeta.LoadVolumeLimitsCatalog(catalogPath)
+	if err != nil {
+		return nil, err
+	}
+	return storagemeta.New(provider, catalog)
+}
+
+// asServiceConfig converts the given ScalingPlannerConfig to the ScalingPlannerServiceConfig of the service whose
+// embedded MinKAPI server writes its kubeconfig to the given minKAPIKubeConfigPath.
+func asServiceConfig(plannerConfig *configv1alpha1.ScalingPlannerConfig, minKAPIKubeConfigPath string) plannerapi.ScalingPlannerServiceConfig {
+	return plannerapi.ScalingPlannerServiceConfig{
+		ServerConfig:    plannerConfig.Server,
+		GRPCBindAddress: plannerConfig.GRPCBindAddress,
+		MinKAPIConfig: minkapi.Config{
+			BasePrefix: minkapi.DefaultBasePrefix,
+			ServerConfig: commontypes.ServerConfig{
+				BindAddress:             plannerConfig.MinKAPI.BindAddress,
+				KubeConfigPath:          minKAPIKubeConfigPath,
+				ProfilingEnabled:        plannerConfig.Server.ProfilingEnabled,
+				GracefulShutdownTimeout: plannerConfig.Server.GracefulShutdownTimeout,
+			},
+			WatchConfig: minkapi.WatchConfig{
+				QueueSize: plannerConfig.MinKAPI.WatchQueueSize,
+				Timeout:   plannerConfig.MinKAPI.WatchTimeout.Duration,
+			},
+		},
+		ClientConfig:  plannerConfig.ClientConnection,
+		CloudProvider: plannerConfig.CloudProvider,
+		SimulatorConfig: plannerapi.SimulatorConfig{
+			MaxParallelSimulations:           plannerConfig.Simulator.MaxParallelSimulations,
+			TrackPollInterval:                plannerConfig.Simulator.TrackPollInterval.Duration,
+			MaxUnchangedTrackAttempts:        plannerConfig.Simulator.MaxUnchangedTrackAttempts,
+			BindVolumeClaimsForImmediateMode: plannerConfig.Simulator.BindVolumeClaimsForImmediateMode,
+			LookaheadBeamWidth:               plannerConfig.Simulator.LookaheadBeamWidth,
+			LookaheadDepth:                   plannerConfig.Simulator.LookaheadDepth,
+			SpotInterruptionPenaltyPercent:   plannerConfig.Simulator.SpotInterruptionPenaltyPercent,
+		},
+		PlanJobConfig: plannerapi.PlanJobConfig{
+			MaxConcurrentPlanJobs: plannerConfig.PlanJobs.MaxConcurrentPlanJobs,
+			MaxQueuedPlanJobs:     plannerConfig.PlanJobs.MaxQueuedPlanJobs,
+			Retention:             plannerConfig.PlanJobs.Retention.Duration,
+		},
+		TraceDir:      plannerConfig.TraceDir,
+		TracingConfig: plannerConfig.Tracing,
+	}
+}
+
 func (o Opts) validate() error {
+	if o.ConfigFile != "" {
+		// the flags configuring the scaling planner are not set, the loaded config file is validated instead.
+		return nil
+	}
 	var errs []error
 	errs = append(errs, cliutil.ValidateServerConfigFlags(o.ServerConfig))
 	if len(o.InstancePricingPath) == 0 {
 		errs = append(errs, fmt.Errorf("%w: --pricing", commonerrors.ErrMissingOpt))
 	}
+	if o.SimulationConfig.SpotInterruptionPenaltyPercent < 0 {
+		errs = append(errs, fmt.Errorf("%w: --spot-interruption-penalty-percent should not be negative", commonerrors.ErrInvalidOptVal))
+	}
+	if ratio := o.TracingConfig.SamplingRatio; ratio != nil && (*ratio < 0 || *ratio > 1) {
+		errs = append(errs, fmt.Errorf("%w: --trace-sampling-ratio should be between 0 and 1", commonerrors.ErrInvalidOptVal))
+	}
 	_, err := commontypes.AsCloudProvider(o.CloudProvider)
 	if err != nil {
 		errs = append(errs, err)
@@ -176,16 +341,50 @@ func (o Opts) validate() error {
 	return errors.Join(errs...)
 }
 
+// validateFlagsWithConfigFile checks that none of the flags configuring the scaling planner is set along with the
+// config flag, since the loaded ScalingPlannerConfig would silently take precedence over them. The logging flags of
+// klog do not configure the scaling planner and can be set.
+func validateFlagsWithConfigFile(flagSet *pflag.FlagSet) error {
+	klogFlagSet := flag.NewFlagSet("klog", flag.ContinueOnError)
+	klog.InitFlags(klogFlagSet)
+	var conflictingFlags []string
+	flagSet.Visit(func(f *pflag.Flag) {
+		if f.Name != "config" && klogFlagSet.Lookup(f.Name) == nil {
+			conflictingFlags = append(conflictingFlags, "--"+f.Name)
+		}
+	})
+	if len(conflictingFlags) > 0 {
+		return fmt.Errorf("%w: %s cannot be set along with --config", commonerrors.ErrInvalidOptVal, strings.Join(conflictingFlags, ", "))
+	}
+	return nil
+}
+
 func setupFlagsToOpts() (*pflag.FlagSet, *Opts) {
 	var opts Opts
 	flagSet := pflag.NewFlagSet(plannerapi.ServiceName, pflag.ContinueOnError)
-	cliutil.MapServerConfigFlags(flagSet, &opts.ServerConfig)
+	cliutil.MapServerConfigFlags(flagSet, &opts.ServerConfig, commonconstants.DefaultAdvisorServiceBindAddress)
 	cliutil.MapQPSBurstFlags(flagSet, &opts.ClientConfig)
 	mkcli.MapWatchConfigFlags(flagSet, &opts.WatchConfig)
+	flagSet.StringVar(&opts.ConfigFile, "config", "", "path to ScalingPlannerConfig file, which cannot be combined with the flags configuring the scaling planner")
 	flagSet.StringVar(&opts.InstancePricingPath, "instance-info", "", "path to instance info file (contains prices)")
 	flagSet.StringVarP(&opts.CloudProvider, "cloud-provider", "c", string(commontypes.CloudProviderAWS), "cloud provider")
 	flagSet.IntVarP(&opts.SimulationConfig.MaxParallelSimulations, "max-parallel-simulations", "m", plannerapi.DefaultMaxParallelSimulations, "maximum number of parallel simulations")
 	flagSet.DurationVar(&opts.SimulationConfig.TrackPollInterval, "track-poll-interval", plannerapi.DefaultTrackPollInterval, "poll interval for tracking pod scheduling in the view of the simulator")
+	flagSet.IntVar(&opts.SimulationConfig.LookaheadBeamWidth, "lookahead-beam-width", 0, "number of candidate views retained per pass by the scale-out lookahead search; values less than 2 disable it")
+	flagSet.IntVar(&opts.SimulationConfig.LookaheadDepth, "lookahead-depth", plannerapi.DefaultLookaheadDepth, "maximum number of passes expanded by the scale-out lookahead search")
+	flagSet.Float64Var(&opts.SimulationConfig.SpotInterruptionPenaltyPercent, "spot-interruption-penalty-percent", 0, "percentage by which the hourly price of spot capacity is increased by the node scoring strategies and the lookahead search to account for interruption risk")
+	flagSet.StringVar(&opts.ResourceWeightsConfigPath, "resource-weights-config", "", "path to JSON file with resource weights overriding the ones derived from instance pricing")
+	flagSet.StringVar(&opts.VolumeLimitsCatalogPath, "volume-limits-catalog", "", "path to JSON file with CSI drivers and max attachable volumes per instance type overriding the default volume limits catalog, which only contains aws and defaults other cloud providers to 16 volumes")
+	flagSet.IntVar(&opts.PlanJobConfig.MaxConcurrentPlanJobs, "max-concurrent-plan-jobs", plannerapi.DefaultMaxConcurrentPlanJobs, "maximum number of plans, including plan jobs, that are run concurrently")
+	flagSet.IntVar(&opts.PlanJobConfig.MaxQueuedPlanJobs, "max-queued-plan-jobs", plannerapi.DefaultMaxQueuedPlanJobs, "maximum number of submitted plan jobs that wait to be run")
+	flagSet.DurationVar(&opts.PlanJobConfig.Retention, "plan-job-retention", plannerapi.DefaultPlanJobRetention, "duration for which finished plan jobs are retained")
+	flagSet.StringVar(&opts.GRPCBindAddress, "grpc-bind-address", "", "bind address of the gRPC server, which is not started if empty")
+	flagSet.StringVar(&opts.TracingConfig.OTLPEndpoint, "otlp-endpoint", "", "host:port of the OTLP gRPC endpoint to which spans are exported")
+	flagSet.BoolVar(&opts.TracingConfig.OTLPInsecure, "otlp-insecure", false, "disables TLS for the connection to the OTLP endpoint")
+	flagSet.StringVar(&opts.TracingConfig.FilePath, "trace-file", "", "path to file to which spans are appended as JSON for offline analysis")
+	opts.TracingConfig.SamplingRatio = new(float64)
+	flagSet.Float64Var(opts.TracingConfig.SamplingRatio, "trace-sampling-ratio", commonconstants.DefaultTracingSamplingRatio, "ratio of traces that are sampled; no trace is sampled if 0")
+	flagSet.StringVar(&opts.MinKAPIBindAddress, "minkapi-bind-address", commonconstants.DefaultMinKAPIBindAddress, "bind address of the embedded minkapi server")
 	flagSet.StringVar(&opts.TraceDir, "trace-dir", os.TempDir(), "directory for traces ")
 	flagSet.StringVarP(&opts.InstancePricingPath, "pricing", "p", "", "path to instance pricing file")
 	return flagSet, &opts
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	commonconstants "github.com/gardener/scaling-advisor/api/common/constants"
+	commonerrors "github.com/gardener/scaling-advisor/api/common/errors"
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	configv1alpha1 "github.com/gardener/scaling-advisor/api/config/v1alpha1"
+	"github.com/gardener/scaling-advisor/api/minkapi"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/planner/storagemeta"
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLoadAndValidateScalingPlannerConfig(t *testing.T) {
+	opts := Opts{ConfigFile: "testdata/scaling-planner-config.yaml"}
+	got, err := opts.LoadAndValidateScalingPlannerConfig()
+	if err != nil {
+		t.Fatalf("LoadAndValidateScalingPlannerConfig() error = %v", err)
+	}
+	samplingRatio := commonconstants.DefaultTracingSamplingRatio
+	want := &configv1alpha1.ScalingPlannerConfig{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: configv1alpha1.SchemeGroupVersion.String(),
+			Kind:       commonconstants.KindScalingPlannerConfig,
+		},
+		Server: commontypes.ServerConfig{
+			BindAddress:             commonconstants.DefaultAdvisorServiceBindAddress,
+			GracefulShutdownTimeout: metav1.Duration{Duration: commonconstants.DefaultGracefulShutdownTimeout},
+		},
+		GRPCBindAddress: ":8092",
+		MinKAPI: configv1alpha1.MinKAPIConfig{
+			BindAddress:    commonconstants.DefaultMinKAPIBindAddress,
+			WatchQueueSize: minkapi.DefaultWatchQueueSize,
+			WatchTimeout:   metav1.Duration{Duration: minkapi.DefaultWatchTimeout},
+		},
+		CloudProvider:       commontypes.CloudProviderAWS,
+		InstancePricingPath: "/tmp/aws-instance-pricing.json",
+		Simulator: configv1alpha1.SimulatorConfig{
+			MaxParallelSimulations:    4,
+			TrackPollInterval:         metav1.Duration{Duration: plannerapi.DefaultTrackPollInterval},
+			MaxUnchangedTrackAttempts: plannerapi.DefaultMaxUnchangedTrackAttempts,
+			LookaheadBeamWidth:        3,
+			LookaheadDepth:            plannerapi.DefaultLookaheadDepth,
+		},
+		PlanJobs: configv1alpha1.PlanJobConfig{
+			MaxConcurrentPlanJobs: plannerapi.DefaultMaxConcurrentPlanJobs,
+			MaxQueuedPlanJobs:     plannerapi.DefaultMaxQueuedPlanJobs,
+			Retention:             metav1.Duration{Duration: 5 * time.Minute},
+		},
+		Tracing: commontypes.TracingConfig{FilePath: "/tmp/traces.json", SamplingRatio: &samplingRatio},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("scaling planner config mismatch (-want +got):\n%s", diff)
+	}
+
+	cfg := asServiceConfig(got, "/tmp/minkapi.yaml")
+	if cfg.SimulatorConfig.MaxParallelSimulations != 4 || cfg.PlanJobConfig.Retention != 5*time.Minute ||
+		cfg.MinKAPIConfig.KubeConfigPath != "/tmp/minkapi.yaml" || cfg.TracingConfig.FilePath != "/tmp/traces.json" {
+		t.Errorf("asServiceConfig() = %+v, does not match scaling planner config", cfg)
+	}
+}
+
+func TestLoadAndValidateScalingPlannerConfigInvalid(t *testing.T) {
+	opts := Opts{ConfigFile: "testdata/invalid-scaling-planner-config.yaml"}
+	_, err := opts.LoadAndValidateScalingPlannerConfig()
+	if err == nil {
+		t.Fatal("LoadAndValidateScalingPlannerConfig() error = nil, want validation error")
+	}
+	for _, fld := range []string{"cloudProvider", "instancePricingPath", "simulator.maxParallelSimulations", "tracing.samplingRatio"} {
+		if !strings.Contains(err.Error(), fld) {
+			t.Errorf("LoadAndValidateScalingPlannerConfig() error = %v, want error for %s", err, fld)
+		}
+	}
+}
+
+func TestLoadAndValidateScalingPlannerConfigFromFlags(t *testing.T) {
+	opts, err := ParseProgramFlags([]string{"--pricing=testdata/scaling-planner-config.yaml", "--max-concurrent-plan-jobs=3", "--trace-sampling-ratio=0"})
+	if err != nil {
+		t.Fatalf("ParseProgramFlags() error = %v", err)
+	}
+	got, err := opts.LoadAndValidateScalingPlannerConfig()
+	if err != nil {
+		t.Fatalf("LoadAndValidateScalingPlannerConfig() error = %v", err)
+	}
+	if got.PlanJobs.MaxConcurrentPlanJobs != 3 || got.Simulator.MaxUnchangedTrackAttempts != plannerapi.DefaultMaxUnchangedTrackAttempts ||
+		got.InstancePricingPath != "testdata/scaling-planner-config.yaml" || *got.Tracing.SamplingRatio != 0 {
+		t.Errorf("LoadAndValidateScalingPlannerConfig() = %+v, does not match flags", got)
+	}
+}
+
+func TestParseProgramFlagsWithConfigFile(t *testing.T) {
+	tests := map[string]struct {
+		args      []string
+		expectErr bool
+	}{
+		"config only":                {args: []string{"--config=testdata/scaling-planner-config.yaml"}},
+		"config with logging flag":   {args: []string{"--config=testdata/scaling-planner-config.yaml", "--v=0"}},
+		"config with planner flag":   {args: []string{"--config=testdata/scaling-planner-config.yaml", "--max-concurrent-plan-jobs=3"}, expectErr: true},
+		"config with defaulted flag": {args: []string{"--config=testdata/scaling-planner-config.yaml", "--cloud-provider=aws"}, expectErr: true},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ParseProgramFlags(tc.args)
+			if (err != nil) != tc.expectErr {
+				t.Fatalf("ParseProgramFlags() error = %v, want error %t", err, tc.expectErr)
+			}
+			if tc.expectErr && !errors.Is(err, commonerrors.ErrInvalidOptVal) {
+				t.Errorf("ParseProgramFlags() error = %v, want %v", err, commonerrors.ErrInvalidOptVal)
+			}
+		})
+	}
+}
+
+func TestGetStorageMetaAccess(t *testing.T) {
+	for catalogPath, want := range map[string]int32{"": 26, "testdata/volume-limits-catalog.json": 20} {
+		access, err := getStorageMetaAccess(commontypes.CloudProviderAWS, catalogPath)
+		if err != nil {
+			t.Fatalf("getStorageMetaAccess(%q) error = %v", catalogPath, err)
+		}
+		spec, err := access.GetFallbackCSINodeSpec("m5.large")
+		if err != nil {
+			t.Fatalf("GetFallbackCSINodeSpec() error = %v", err)
+		}
+		if got := *spec.Drivers[0].Allocatable.Count; got != want {
+			t.Errorf("allocatable volume count for catalog %q = %d, want %d", catalogPath, got, want)
+		}
+	}
+}
+
+func TestGetStorageMetaAccessWithoutCatalogVolumeLimits(t *testing.T) {
+	access, err := getStorageMetaAccess(commontypes.CloudProviderGCP, "")
+	if err != nil {
+		t.Fatalf("getStorageMetaAccess() error = %v", err)
+	}
+	spec, err := access.GetFallbackCSINodeSpec("n2-standard-4")
+	if err != nil {
+		t.Fatalf("GetFallbackCSINodeSpec() error = %v", err)
+	}
+	if got := *spec.Drivers[0].Allocatable.Count; got != storagemeta.DefaultMaxVolumes {
+		t.Errorf("allocatable volume count = %d, want %d", got, storagemeta.DefaultMaxVolumes)
+	}
+}
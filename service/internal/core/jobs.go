@@ -0,0 +1,283 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/objutil"
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ plannerapi.PlanJobManager = (*planJobManager)(nil)
+	_ plannerapi.ScalingPlanner = (*planJobManager)(nil)
+)
+
+// planJob is a plan job for a submitted request of a planJobManager.
+type planJob struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	req    plannerapi.Request
+	// state is guarded by the mutex of the planJobManager.
+	state plannerapi.PlanJob
+}
+
+// planJobManager runs plan jobs on a ScalingPlanner with a fixed number of workers that take plan jobs from a bounded
+// queue. Finished plan jobs are evicted lazily once their retention elapsed. It is also a ScalingPlanner itself that
+// runs plans directly, which share the MaxConcurrentPlanJobs slots with the plan jobs.
+type planJobManager struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	planner plannerapi.ScalingPlanner
+	queue   chan *planJob
+	// slots holds a token for every plan that is run, either for a plan job or directly.
+	slots chan struct{}
+	jobs  map[string]*planJob
+	nowFn func() time.Time
+	cfg   plannerapi.PlanJobConfig
+	wg    sync.WaitGroup
+	mu    sync.Mutex
+}
+
+// newPlanJobManager creates a planJobManager for the given planner and starts its workers, which run until the given
+// ctx is done or the planJobManager is stopped.
+func newPlanJobManager(ctx context.Context, planner plannerapi.ScalingPlanner, cfg plannerapi.PlanJobConfig) *planJobManager {
+	m := &planJobManager{
+		planner: planner,
+		queue:   make(chan *planJob, cfg.MaxQueuedPlanJobs),
+		slots:   make(chan struct{}, cfg.MaxConcurrentPlanJobs),
+		jobs:    make(map[string]*planJob),
+		nowFn:   time.Now,
+		cfg:     cfg,
+	}
+	m.ctx, m.cancel = context.WithCancel(ctx)
+	for range cfg.MaxConcurrentPlanJobs {
+		m.wg.Go(m.runWorker)
+	}
+	return m
+}
+
+// Plan runs the planner for the given req if a slot is free and forwards its responses. Otherwise, it sends a single
+// response with an error wrapping ErrTooManyConcurrentPlans without waiting for a slot.
+func (m *planJobManager) Plan(ctx context.Context, req plannerapi.Request) <-chan plannerapi.Response {
+	select {
+	case m.slots <- struct{}{}:
+	default:
+		responseCh := make(chan plannerapi.Response, 1)
+		responseCh <- plannerapi.Response{
+			RequestRef: req.RequestRef,
+			Error:      fmt.Errorf("%w: cannot run more than %d plans concurrently", plannerapi.ErrTooManyConcurrentPlans, m.cfg.MaxConcurrentPlanJobs),
+		}
+		close(responseCh)
+		return responseCh
+	}
+	responseCh := make(chan plannerapi.Response)
+	go func() {
+		defer func() {
+			<-m.slots
+		}()
+		defer close(responseCh)
+		for response := range m.planner.Plan(ctx, req) {
+			responseCh <- response
+		}
+	}()
+	return responseCh
+}
+
+func (m *planJobManager) SubmitPlanJob(ctx context.Context, req plannerapi.Request) (plannerapi.RequestRef, error) {
+	if req.ID == "" {
+		req.ID = objutil.GenerateName("plan-request-")
+	}
+	if req.CreationTime.IsZero() {
+		req.CreationTime = m.nowFn()
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.ctx.Err(); err != nil {
+		return req.RequestRef, fmt.Errorf("cannot submit plan job for request %q: %w", req.ID, err)
+	}
+	m.evictExpired()
+	if _, ok := m.jobs[req.ID]; ok {
+		return req.RequestRef, fmt.Errorf("%w: request %q", plannerapi.ErrPlanJobExists, req.ID)
+	}
+	job := &planJob{
+		req: req,
+		state: plannerapi.PlanJob{
+			CreationTime: m.nowFn(),
+			RequestRef:   req.RequestRef,
+			Status:       plannerapi.ActivityStatusPending,
+		},
+	}
+	// the plan job outlives the given ctx, hence only its trace context is carried into the ctx of the plan job.
+	job.ctx, job.cancel = context.WithCancel(trace.ContextWithRemoteSpanContext(m.ctx, trace.SpanContextFromContext(ctx)))
+	select {
+	case m.queue <- job:
+	default:
+		job.cancel()
+		return req.RequestRef, fmt.Errorf("%w: cannot queue more than %d plan jobs", plannerapi.ErrPlanJobQueueFull, m.cfg.MaxQueuedPlanJobs)
+	}
+	m.jobs[req.ID] = job
+	return req.RequestRef, nil
+}
+
+func (m *planJobManager) GetPlanJob(requestID string) (plannerapi.PlanJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evictExpired()
+	job, ok := m.jobs[requestID]
+	if !ok {
+		return plannerapi.PlanJob{}, fmt.Errorf("%w: request %q", plannerapi.ErrPlanJobNotFound, requestID)
+	}
+	return snapshotPlanJob(job), nil
+}
+
+func (m *planJobManager) CancelPlanJob(requestID string) (plannerapi.PlanJob, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.evictExpired()
+	job, ok := m.jobs[requestID]
+	if !ok {
+		return plannerapi.PlanJob{}, fmt.Errorf("%w: request %q", plannerapi.ErrPlanJobNotFound, requestID)
+	}
+	switch job.state.Status {
+	case plannerapi.ActivityStatusPending:
+		// a pending plan job is finished right away, the worker that dequeues it skips it.
+		m.finish(job, context.Canceled)
+	case plannerapi.ActivityStatusRunning:
+		// a running plan job is finished by its worker once the planner observed the cancellation.
+		job.cancel()
+	}
+	return snapshotPlanJob(job), nil
+}
+
+// stop cancels all pending and running plan jobs and waits for the workers to finish until the given ctx is done.
+func (m *planJobManager) stop(ctx context.Context) error {
+	m.cancel()
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("cannot stop plan jobs: %w", ctx.Err())
+	case <-done:
+		return nil
+	}
+}
+
+// runWorker runs the plan jobs taken from the queue, each once a slot is free, until the planJobManager is stopped.
+func (m *planJobManager) runWorker() {
+	for {
+		select {
+		case <-m.ctx.Done():
+			m.cancelQueued()
+			return
+		case job := <-m.queue:
+			select {
+			case <-m.ctx.Done():
+				m.cancelPending(job)
+				m.cancelQueued()
+				return
+			case m.slots <- struct{}{}:
+			}
+			m.runPlanJob(job)
+			<-m.slots
+		}
+	}
+}
+
+// runPlanJob runs the given plan job unless it was canceled while pending, accumulating all responses of the planner.
+func (m *planJobManager) runPlanJob(job *planJob) {
+	m.mu.Lock()
+	if job.state.Status != plannerapi.ActivityStatusPending {
+		m.mu.Unlock()
+		return
+	}
+	job.state.Status = plannerapi.ActivityStatusRunning
+	m.mu.Unlock()
+
+	log := logr.FromContextOrDiscard(m.ctx).WithValues("requestID", job.req.ID, "correlationID", job.req.CorrelationID)
+	log.V(2).Info("running plan job")
+	var planErr error
+	for response := range m.planner.Plan(job.ctx, job.req) {
+		if response.Error != nil && planErr == nil {
+			planErr = response.Error
+		}
+		m.mu.Lock()
+		job.state.Responses = append(job.state.Responses, response)
+		m.mu.Unlock()
+	}
+	if planErr == nil {
+		planErr = job.ctx.Err()
+	}
+	m.mu.Lock()
+	m.finish(job, planErr)
+	m.mu.Unlock()
+	log.V(2).Info("finished plan job", "status", job.state.Status)
+}
+
+// cancelQueued finishes all plan jobs that remain in the queue once the planJobManager is stopped.
+func (m *planJobManager) cancelQueued() {
+	for {
+		select {
+		case job := <-m.queue:
+			m.cancelPending(job)
+		default:
+			return
+		}
+	}
+}
+
+// cancelPending finishes the given plan job as canceled if it is still pending.
+func (m *planJobManager) cancelPending(job *planJob) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if job.state.Status == plannerapi.ActivityStatusPending {
+		m.finish(job, context.Canceled)
+	}
+}
+
+// finish sets the final status of the given plan job according to the given error and releases its context. It must
+// be called with the mutex held.
+func (m *planJobManager) finish(job *planJob, err error) {
+	job.cancel()
+	job.state.CompletionTime = m.nowFn()
+	if err == nil {
+		job.state.Status = plannerapi.ActivityStatusSuccess
+		return
+	}
+	job.state.Status = plannerapi.ActivityStatusFailure
+	if errors.Is(err, context.Canceled) {
+		job.state.Message = "plan job canceled"
+	} else {
+		job.state.Message = err.Error()
+	}
+}
+
+// evictExpired removes the finished plan jobs whose retention elapsed. It must be called with the mutex held.
+func (m *planJobManager) evictExpired() {
+	now := m.nowFn()
+	for id, job := range m.jobs {
+		if !job.state.CompletionTime.IsZero() && now.Sub(job.state.CompletionTime) > m.cfg.Retention {
+			delete(m.jobs, id)
+		}
+	}
+}
+
+// snapshotPlanJob returns a copy of the state of the given plan job. It must be called with the mutex held.
+func snapshotPlanJob(job *planJob) plannerapi.PlanJob {
+	state := job.state
+	state.Responses = slices.Clone(job.state.Responses)
+	return state
+}
@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/planner"
+	"github.com/gardener/scaling-advisor/planner/simulator/scaleout"
+	plannertestutil "github.com/gardener/scaling-advisor/planner/testutil"
+	"github.com/gardener/scaling-advisor/samples"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// blockingPlanner sends a single response for every request once release is closed, or stops once the ctx is done.
+type blockingPlanner struct {
+	release chan struct{}
+}
+
+func (b *blockingPlanner) Plan(ctx context.Context, req plannerapi.Request) <-chan plannerapi.Response {
+	responseCh := make(chan plannerapi.Response)
+	go func() {
+		defer close(responseCh)
+		select {
+		case <-ctx.Done():
+			return
+		case <-b.release:
+		}
+		select {
+		case <-ctx.Done():
+		case responseCh <- plannerapi.Response{RequestRef: req.RequestRef, ID: "plan-" + req.ID}:
+		}
+	}()
+	return responseCh
+}
+
+type fakeClock struct {
+	now time.Time
+	mu  sync.Mutex
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Step(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func TestPlanJobManager(t *testing.T) {
+	planner := &blockingPlanner{release: make(chan struct{})}
+	clock := &fakeClock{now: time.Now()}
+	m := newPlanJobManager(context.Background(), planner, plannerapi.PlanJobConfig{
+		MaxConcurrentPlanJobs: 1,
+		MaxQueuedPlanJobs:     1,
+		Retention:             time.Minute,
+	})
+	m.nowFn = clock.Now
+	defer func() {
+		if err := m.stop(context.Background()); err != nil {
+			t.Errorf("stop() error = %v", err)
+		}
+	}()
+
+	if _, err := m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "a"}}); err != nil {
+		t.Fatalf("SubmitPlanJob() error = %v", err)
+	}
+	waitForPlanJobStatus(t, m, "a", plannerapi.ActivityStatusRunning)
+	ref, err := m.SubmitPlanJob(t.Context(), plannerapi.Request{})
+	if err != nil || ref.ID == "" {
+		t.Fatalf("SubmitPlanJob() = %+v, %v, want generated request ID", ref, err)
+	}
+	if _, err = m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "c"}}); !errors.Is(err, plannerapi.ErrPlanJobQueueFull) {
+		t.Errorf("SubmitPlanJob() error = %v for full queue, want %v", err, plannerapi.ErrPlanJobQueueFull)
+	}
+	if _, err = m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "a"}}); !errors.Is(err, plannerapi.ErrPlanJobExists) {
+		t.Errorf("SubmitPlanJob() error = %v for duplicate request ID, want %v", err, plannerapi.ErrPlanJobExists)
+	}
+
+	job, err := m.CancelPlanJob(ref.ID)
+	if err != nil || job.Status != plannerapi.ActivityStatusFailure {
+		t.Errorf("CancelPlanJob() = %+v, %v for pending plan job, want status %q", job, err, plannerapi.ActivityStatusFailure)
+	}
+
+	close(planner.release)
+	job = waitForPlanJobStatus(t, m, "a", plannerapi.ActivityStatusSuccess)
+	if len(job.Responses) != 1 || job.Responses[0].ID != "plan-a" || job.CompletionTime.IsZero() {
+		t.Errorf("plan job = %+v, want completed plan job with response %q", job, "plan-a")
+	}
+	if job, err = m.CancelPlanJob("a"); err != nil || job.Status != plannerapi.ActivityStatusSuccess {
+		t.Errorf("CancelPlanJob() = %+v, %v for finished plan job, want status %q unchanged", job, err, plannerapi.ActivityStatusSuccess)
+	}
+
+	clock.Step(2 * time.Minute)
+	if _, err = m.GetPlanJob("a"); !errors.Is(err, plannerapi.ErrPlanJobNotFound) {
+		t.Errorf("GetPlanJob() error = %v after retention elapsed, want %v", err, plannerapi.ErrPlanJobNotFound)
+	}
+}
+
+func TestPlanJobManagerCancelRunning(t *testing.T) {
+	m := newPlanJobManager(context.Background(), &blockingPlanner{release: make(chan struct{})}, plannerapi.PlanJobConfig{
+		MaxConcurrentPlanJobs: 1,
+		MaxQueuedPlanJobs:     1,
+		Retention:             time.Minute,
+	})
+	if _, err := m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "a"}}); err != nil {
+		t.Fatalf("SubmitPlanJob() error = %v", err)
+	}
+	waitForPlanJobStatus(t, m, "a", plannerapi.ActivityStatusRunning)
+	if _, err := m.CancelPlanJob("a"); err != nil {
+		t.Fatalf("CancelPlanJob() error = %v", err)
+	}
+	waitForPlanJobStatus(t, m, "a", plannerapi.ActivityStatusFailure)
+
+	if _, err := m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "b"}}); err != nil {
+		t.Fatalf("SubmitPlanJob() error = %v", err)
+	}
+	waitForPlanJobStatus(t, m, "b", plannerapi.ActivityStatusRunning)
+	if err := m.stop(context.Background()); err != nil {
+		t.Fatalf("stop() error = %v", err)
+	}
+	if job, _ := m.GetPlanJob("b"); job.Status != plannerapi.ActivityStatusFailure {
+		t.Errorf("status = %q after stop, want %q", job.Status, plannerapi.ActivityStatusFailure)
+	}
+	if _, err := m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "c"}}); err == nil {
+		t.Errorf("SubmitPlanJob() after stop succeeded, want error")
+	}
+}
+
+func TestPlanJobManagerLimitsPlans(t *testing.T) {
+	planner := &blockingPlanner{release: make(chan struct{})}
+	m := newPlanJobManager(context.Background(), planner, plannerapi.PlanJobConfig{
+		MaxConcurrentPlanJobs: 1,
+		MaxQueuedPlanJobs:     1,
+		Retention:             time.Minute,
+	})
+	defer func() {
+		_ = m.stop(context.Background())
+	}()
+	if _, err := m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "a"}}); err != nil {
+		t.Fatalf("SubmitPlanJob() error = %v", err)
+	}
+	waitForPlanJobStatus(t, m, "a", plannerapi.ActivityStatusRunning)
+	responses := collectResponses(m.Plan(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "b"}}))
+	if len(responses) != 1 || !errors.Is(responses[0].Error, plannerapi.ErrTooManyConcurrentPlans) {
+		t.Fatalf("Plan() responses = %+v while plan job is running, want single error %v", responses, plannerapi.ErrTooManyConcurrentPlans)
+	}
+
+	close(planner.release)
+	waitForPlanJobStatus(t, m, "a", plannerapi.ActivityStatusSuccess)
+	responses = collectResponses(m.Plan(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "b"}}))
+	if len(responses) != 1 || responses[0].Error != nil || responses[0].ID != "plan-b" {
+		t.Fatalf("Plan() responses = %+v after plan job finished, want response %q", responses, "plan-b")
+	}
+
+	planCtx, cancel := context.WithCancel(t.Context())
+	planner.release = make(chan struct{})
+	responseCh := m.Plan(planCtx, plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "c"}})
+	if _, err := m.SubmitPlanJob(t.Context(), plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "d"}}); err != nil {
+		t.Fatalf("SubmitPlanJob() error = %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+	if job, _ := m.GetPlanJob("d"); job.Status != plannerapi.ActivityStatusPending {
+		t.Errorf("status = %q while plan is running, want %q", job.Status, plannerapi.ActivityStatusPending)
+	}
+	cancel()
+	collectResponses(responseCh)
+	close(planner.release)
+	waitForPlanJobStatus(t, m, "d", plannerapi.ActivityStatusSuccess)
+}
+
+// lingeringSimulatorFactory creates lingeringScaleOutSimulators that report the number of their running goroutines.
+type lingeringSimulatorFactory struct {
+	numRunning *atomic.Int32
+}
+
+func (f lingeringSimulatorFactory) GetScaleOutSimulator(plannerapi.SimulatorArgs) (plannerapi.ScaleOutSimulator, error) {
+	return lingeringScaleOutSimulator(f), nil
+}
+
+func (lingeringSimulatorFactory) GetScaleInSimulator(plannerapi.SimulatorArgs) (plannerapi.ScaleInSimulator, error) {
+	return nil, errors.New("scale-in is not supported")
+}
+
+// lingeringScaleOutSimulator sends a plan error once its ctx is done, only after the planner observed the cancellation
+// like a ScaleOutSimulator whose simulation run is still in progress.
+type lingeringScaleOutSimulator struct {
+	numRunning *atomic.Int32
+}
+
+func (s lingeringScaleOutSimulator) Simulate(ctx context.Context, request *plannerapi.Request, _ plannerapi.SimulationFactory) <-chan plannerapi.ScaleOutPlanResult {
+	resultCh := make(chan plannerapi.ScaleOutPlanResult)
+	s.numRunning.Add(1)
+	go func() {
+		defer s.numRunning.Add(-1)
+		defer close(resultCh)
+		<-ctx.Done()
+		time.Sleep(50 * time.Millisecond)
+		scaleout.SendPlanError(resultCh, request.GetRef(), context.Cause(ctx))
+	}()
+	return resultCh
+}
+
+func (lingeringScaleOutSimulator) Close() error {
+	return nil
+}
+
+func TestPlanJobManagerCancelRunningScaleOut(t *testing.T) {
+	numRunning := &atomic.Int32{}
+	factories := planner.NewFactories()
+	factories.Simulator = lingeringSimulatorFactory{numRunning: numRunning}
+	scalingPlanner, testData, ok := plannertestutil.CreateTestPlannerAndTestData(t, plannertestutil.Args{
+		PoolPreset: samples.PoolPreset1P,
+		NumUnscheduledPodsPerResourcePreset: map[samples.ResourcePreset]int{
+			samples.ResourcePresetBerry: 1,
+		},
+		Factories: factories,
+	})
+	if !ok {
+		return
+	}
+	m := newPlanJobManager(testData.RunContext, scalingPlanner, plannerapi.PlanJobConfig{
+		MaxConcurrentPlanJobs: 1,
+		MaxQueuedPlanJobs:     1,
+		Retention:             time.Minute,
+	})
+	defer func() {
+		_ = m.stop(context.Background())
+	}()
+	ref, err := m.SubmitPlanJob(t.Context(), testData.Request)
+	if err != nil {
+		t.Fatalf("SubmitPlanJob() error = %v", err)
+	}
+	waitForPlanJobStatus(t, m, ref.ID, plannerapi.ActivityStatusRunning)
+	if _, err = m.CancelPlanJob(ref.ID); err != nil {
+		t.Fatalf("CancelPlanJob() error = %v", err)
+	}
+	waitForPlanJobStatus(t, m, ref.ID, plannerapi.ActivityStatusFailure)
+	deadline := time.Now().Add(5 * time.Second)
+	for numRunning.Load() > 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("simulator goroutines = %d after canceling the plan job, want 0", numRunning.Load())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+// collectResponses returns all responses received from the given responseCh until it is closed.
+func collectResponses(responseCh <-chan plannerapi.Response) (responses []plannerapi.Response) {
+	for response := range responseCh {
+		responses = append(responses, response)
+	}
+	return
+}
+
+// spanContextPlanner records the span context of the ctx of every request.
+type spanContextPlanner struct {
+	spanContexts chan trace.SpanContext
+}
+
+func (s *spanContextPlanner) Plan(ctx context.Context, _ plannerapi.Request) <-chan plannerapi.Response {
+	s.spanContexts <- trace.SpanContextFromContext(ctx)
+	responseCh := make(chan plannerapi.Response)
+	close(responseCh)
+	return responseCh
+}
+
+func TestPlanJobManagerPropagatesTraceContext(t *testing.T) {
+	planner := &spanContextPlanner{spanContexts: make(chan trace.SpanContext, 1)}
+	m := newPlanJobManager(context.Background(), planner, plannerapi.PlanJobConfig{
+		MaxConcurrentPlanJobs: 1,
+		MaxQueuedPlanJobs:     1,
+		Retention:             time.Minute,
+	})
+	defer func() {
+		_ = m.stop(context.Background())
+	}()
+	spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx, cancel := context.WithCancel(trace.ContextWithSpanContext(t.Context(), spanCtx))
+	if _, err := m.SubmitPlanJob(ctx, plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "a"}}); err != nil {
+		t.Fatalf("SubmitPlanJob() error = %v", err)
+	}
+	cancel()
+	if got := <-planner.spanContexts; got.TraceID() != spanCtx.TraceID() || got.SpanID() != spanCtx.SpanID() || !got.IsRemote() {
+		t.Errorf("span context of plan job = %+v, want remote span context %+v", got, spanCtx)
+	}
+	waitForPlanJobStatus(t, m, "a", plannerapi.ActivityStatusSuccess)
+}
+
+func waitForPlanJobStatus(t *testing.T, m *planJobManager, requestID string, status plannerapi.ActivityStatus) plannerapi.PlanJob {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		job, err := m.GetPlanJob(requestID)
+		if err != nil {
+			t.Fatalf("GetPlanJob() error = %v", err)
+		}
+		if job.Status == status {
+			return job
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("status of plan job %q = %q, want %q", requestID, job.Status, status)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
@@ -17,9 +17,16 @@ import (
 	plannerapi "github.com/gardener/scaling-advisor/api/planner"
 	pricingapi "github.com/gardener/scaling-advisor/api/pricing"
 	"github.com/gardener/scaling-advisor/common/ioutil"
+	"github.com/gardener/scaling-advisor/common/metricsutil"
+	"github.com/gardener/scaling-advisor/common/traceutil"
 	mkcore "github.com/gardener/scaling-advisor/minkapi/server"
 	"github.com/gardener/scaling-advisor/minkapi/server/configtmpl"
 	"github.com/gardener/scaling-advisor/planner/scheduler"
+	"github.com/gardener/scaling-advisor/service/internal/grpcserver"
+	"github.com/gardener/scaling-advisor/service/internal/server"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 var _ plannerapi.ScalingPlannerService = (*defaultPlannerService)(nil)
@@ -27,7 +34,10 @@ var _ plannerapi.ScalingPlannerService = (*defaultPlannerService)(nil)
 type defaultPlannerService struct {
 	minKAPIServer     minkapi.Server
 	schedulerLauncher plannerapi.SchedulerLauncher
-	planner           plannerapi.ScalingPlanner
+	server            *server.Server
+	grpcServer        *grpcserver.Server
+	planJobs          *planJobManager
+	shutdownTracing   func(context.Context) error
 	cfg               plannerapi.ScalingPlannerServiceConfig
 }
 
@@ -35,6 +45,7 @@ type defaultPlannerService struct {
 func NewService(ctx context.Context,
 	config plannerapi.ScalingPlannerServiceConfig,
 	pricingAccess pricingapi.InstancePricingAccess,
+	storageMetaAccess plannerapi.StorageMetaAccess,
 	factories plannerapi.Factories) (svc plannerapi.ScalingPlannerService, err error) {
 	defer func() {
 		if err != nil {
@@ -42,6 +53,15 @@ func NewService(ctx context.Context,
 		}
 	}()
 	setServiceConfigDefaults(&config)
+	shutdownTracing, err := traceutil.SetupTracing(ctx, config.TracingConfig, plannerapi.ServiceName)
+	if err != nil {
+		return
+	}
+	defer func() {
+		if err != nil {
+			_ = shutdownTracing(ctx)
+		}
+	}()
 	minKAPIServer, err := mkcore.New(ctx, config.MinKAPIConfig)
 	if err != nil {
 		return
@@ -56,26 +76,45 @@ func NewService(ctx context.Context,
 	if err != nil {
 		return
 	}
-	schedulerLauncher, err := scheduler.NewLauncher(embeddedSchedulerConfigPath, config.SimulatorConfig.MaxParallelSimulations)
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(collectors.NewGoCollector(), collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}))
+	metricsRecorder, err := metricsutil.NewPlannerMetricsRecorder(registry)
+	if err != nil {
+		return
+	}
+	schedulerLauncher, err := scheduler.NewLauncher(embeddedSchedulerConfigPath, config.SimulatorConfig.MaxParallelSimulations, metricsRecorder)
 	if err != nil {
 		return
 	}
 	p, err := factories.Planner.NewPlanner(plannerapi.ScalingPlannerArgs{
-		ViewAccess:        minKAPIServer,
-		ResourceWeigher:   factories.ResourceWeigher,
-		PricingAccess:     pricingAccess,
-		SchedulerLauncher: schedulerLauncher,
-		TraceDir:          config.TraceDir,
+		ViewAccess:         minKAPIServer,
+		ResourceWeigher:    factories.ResourceWeigher,
+		NodeScorerRegistry: factories.NodeScorerRegistry,
+		PricingAccess:      pricingAccess,
+		StorageMetaAccess:  storageMetaAccess,
+		SchedulerLauncher:  schedulerLauncher,
+		SimulatorFactory:   factories.Simulator,
+		SimulationFactory:  factories.Simulation,
+		TraceDir:           config.TraceDir,
+		SimulatorConfig:    config.SimulatorConfig,
+		MetricsRecorder:    metricsRecorder,
 	})
 	if err != nil {
 		return
 	}
-	svc = &defaultPlannerService{
+	planJobs := newPlanJobManager(ctx, p, config.PlanJobConfig)
+	d := &defaultPlannerService{
 		cfg:               config,
 		minKAPIServer:     minKAPIServer,
 		schedulerLauncher: schedulerLauncher,
-		planner:           p,
+		server:            server.New(config.ServerConfig, planJobs, planJobs, promhttp.HandlerFor(registry, promhttp.HandlerOpts{})),
+		planJobs:          planJobs,
+		shutdownTracing:   shutdownTracing,
+	}
+	if config.GRPCBindAddress != "" {
+		d.grpcServer = grpcserver.New(config.GRPCBindAddress, planJobs)
 	}
+	svc = d
 	return
 }
 
@@ -85,8 +124,21 @@ func (d *defaultPlannerService) Start(ctx context.Context) (err error) {
 			err = fmt.Errorf("%w: %w", plannerapi.ErrStartFailed, err)
 		}
 	}()
-	if err = d.minKAPIServer.Start(ctx); err != nil {
-		return
+	// all servers block until they are stopped, hence the first error of any of them is returned.
+	startFns := []func(context.Context) error{d.minKAPIServer.Start, d.server.Start}
+	if d.grpcServer != nil {
+		startFns = append(startFns, d.grpcServer.Start)
+	}
+	errCh := make(chan error, len(startFns))
+	for _, startFn := range startFns {
+		go func() {
+			errCh <- startFn(ctx)
+		}()
+	}
+	for range startFns {
+		if err = <-errCh; err != nil {
+			return
+		}
 	}
 	return
 }
@@ -101,12 +153,32 @@ func (d *defaultPlannerService) Stop(ctx context.Context) (err error) {
 		ctx, cancel = context.WithTimeout(ctx, d.cfg.ServerConfig.GracefulShutdownTimeout.Duration)
 		defer cancel()
 	}
-	// TODO: Stop the scaling advisor http server.
+	// the scaling planner servers are stopped first so that requests in flight can complete against the minkapi server.
+	if d.server != nil {
+		if stopErr := d.server.Stop(ctx); stopErr != nil {
+			errs = append(errs, stopErr)
+		}
+	}
+	if d.grpcServer != nil {
+		if stopErr := d.grpcServer.Stop(ctx); stopErr != nil {
+			errs = append(errs, stopErr)
+		}
+	}
+	if d.planJobs != nil {
+		if stopErr := d.planJobs.stop(ctx); stopErr != nil {
+			errs = append(errs, stopErr)
+		}
+	}
 	if d.minKAPIServer != nil {
-		err = d.minKAPIServer.Stop(ctx)
+		if stopErr := d.minKAPIServer.Stop(ctx); stopErr != nil {
+			errs = append(errs, stopErr)
+		}
 	}
-	if err != nil {
-		errs = append(errs, err)
+	// tracing is shut down last so that the spans of the plan jobs stopped above are flushed.
+	if d.shutdownTracing != nil {
+		if stopErr := d.shutdownTracing(ctx); stopErr != nil {
+			errs = append(errs, stopErr)
+		}
 	}
 	if len(errs) > 0 {
 		err = errors.Join(errs...)
@@ -115,7 +187,19 @@ func (d *defaultPlannerService) Stop(ctx context.Context) (err error) {
 }
 
 func (p *defaultPlannerService) Plan(ctx context.Context, request plannerapi.Request) <-chan plannerapi.Response {
-	return p.planner.Plan(ctx, request)
+	return p.planJobs.Plan(ctx, request)
+}
+
+func (p *defaultPlannerService) SubmitPlanJob(ctx context.Context, request plannerapi.Request) (plannerapi.RequestRef, error) {
+	return p.planJobs.SubmitPlanJob(ctx, request)
+}
+
+func (p *defaultPlannerService) GetPlanJob(requestID string) (plannerapi.PlanJob, error) {
+	return p.planJobs.GetPlanJob(requestID)
+}
+
+func (p *defaultPlannerService) CancelPlanJob(requestID string) (plannerapi.PlanJob, error) {
+	return p.planJobs.CancelPlanJob(requestID)
 }
 
 func setServiceConfigDefaults(cfg *plannerapi.ScalingPlannerServiceConfig) {
@@ -125,4 +209,22 @@ func setServiceConfigDefaults(cfg *plannerapi.ScalingPlannerServiceConfig) {
 	if cfg.TraceDir == "" {
 		cfg.TraceDir = ioutil.GetTempDir()
 	}
+	if cfg.SimulatorConfig.MaxParallelSimulations <= 0 {
+		cfg.SimulatorConfig.MaxParallelSimulations = plannerapi.DefaultMaxParallelSimulations
+	}
+	if cfg.SimulatorConfig.TrackPollInterval <= 0 {
+		cfg.SimulatorConfig.TrackPollInterval = plannerapi.DefaultTrackPollInterval
+	}
+	if cfg.SimulatorConfig.MaxUnchangedTrackAttempts <= 0 {
+		cfg.SimulatorConfig.MaxUnchangedTrackAttempts = plannerapi.DefaultMaxUnchangedTrackAttempts
+	}
+	if cfg.PlanJobConfig.MaxConcurrentPlanJobs <= 0 {
+		cfg.PlanJobConfig.MaxConcurrentPlanJobs = plannerapi.DefaultMaxConcurrentPlanJobs
+	}
+	if cfg.PlanJobConfig.MaxQueuedPlanJobs <= 0 {
+		cfg.PlanJobConfig.MaxQueuedPlanJobs = plannerapi.DefaultMaxQueuedPlanJobs
+	}
+	if cfg.PlanJobConfig.Retention <= 0 {
+		cfg.PlanJobConfig.Retention = plannerapi.DefaultPlanJobRetention
+	}
 }
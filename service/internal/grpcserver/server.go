@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	plannerv1alpha1 "github.com/gardener/scaling-advisor/api/proto/planner/v1alpha1"
+	"github.com/gardener/scaling-advisor/common/objutil"
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorCodes maps sentinel errors to gRPC status codes in order of precedence. Errors wrapping none of them are mapped
+// to codes.Internal.
+var errorCodes = []struct {
+	err  error
+	code codes.Code
+}{
+	{plannerapi.ErrInvalidRequest, codes.InvalidArgument},
+	{plannerv1alpha1.ErrConvert, codes.InvalidArgument},
+	{plannerapi.ErrInvalidScalingConstraint, codes.InvalidArgument},
+	{plannerapi.ErrUnsupportedSimulatorStrategy, codes.InvalidArgument},
+	{plannerapi.ErrCreateNodeScorer, codes.InvalidArgument},
+	{plannerapi.ErrNoUnscheduledPods, codes.FailedPrecondition},
+	{plannerapi.ErrNoScaleOutPlan, codes.FailedPrecondition},
+	{plannerapi.ErrNoScaleInPlan, codes.FailedPrecondition},
+	{plannerapi.ErrAdviceGenerationTimeout, codes.DeadlineExceeded},
+	{plannerapi.ErrTooManyConcurrentPlans, codes.ResourceExhausted},
+	{context.Canceled, codes.Canceled},
+}
+
+var _ plannerv1alpha1.ScalingPlannerServer = (*Server)(nil)
+
+// Server is the gRPC server of the scaling planner service, which exposes the ScalingPlanner through the
+// plannerv1alpha1.ScalingPlanner service.
+type Server struct {
+	plannerv1alpha1.UnimplementedScalingPlannerServer
+	planner     plannerapi.ScalingPlanner
+	server      *grpc.Server
+	bindAddress string
+}
+
+// New creates a Server for the given planner that listens on the given bindAddress once started.
+func New(bindAddress string, planner plannerapi.ScalingPlanner) *Server {
+	s := &Server{
+		planner:     planner,
+		server:      grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler())),
+		bindAddress: bindAddress,
+	}
+	plannerv1alpha1.RegisterScalingPlannerServer(s.server, s)
+	return s
+}
+
+// Start listens on the bind address and serves requests until the server is stopped. This is a blocking call.
+func (s *Server) Start(ctx context.Context) error {
+	log := logr.FromContextOrDiscard(ctx)
+	listener, err := net.Listen("tcp", s.bindAddress)
+	if err != nil {
+		return fmt.Errorf("cannot listen on TCP Address %q: %w", s.bindAddress, err)
+	}
+	log.Info(fmt.Sprintf("%s gRPC server listening", plannerapi.ServiceName), "address", listener.Addr().String())
+	if err = s.server.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+	return nil
+}
+
+// Stop gracefully shuts down the server, waiting for streams in flight until the given ctx is done, after which they
+// are canceled.
+func (s *Server) Stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		s.server.Stop()
+		return fmt.Errorf("cannot gracefully stop gRPC server: %w", ctx.Err())
+	}
+}
+
+// Plan converts the given PlanRequest and streams the responses of the planner for it until the planner is done or
+// the stream is canceled. An error of the first response is returned as status of the stream instead.
+func (s *Server) Plan(in *plannerv1alpha1.PlanRequest, stream grpc.ServerStreamingServer[plannerv1alpha1.PlanResponse]) error {
+	req, err := plannerv1alpha1.ToRequest(in)
+	if err != nil {
+		return toStatusError(err)
+	}
+	if req.ID == "" {
+		req.ID = objutil.GenerateName("plan-request-")
+	}
+	if req.CreationTime.IsZero() {
+		req.CreationTime = time.Now()
+	}
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	responseCh := s.planner.Plan(ctx, req)
+	// the planner blocks on sending responses, hence they are drained if the stream ends early.
+	defer func() {
+		go func() {
+			for range responseCh {
+			}
+		}()
+	}()
+	first := true
+	for response := range responseCh {
+		if first && response.Error != nil {
+			return toStatusError(response.Error)
+		}
+		first = false
+		if err = stream.Send(plannerv1alpha1.FromResponse(&response)); err != nil {
+			return err
+		}
+	}
+	if first {
+		return status.Errorf(codes.Internal, "%v: planner sent no response for request %q", plannerapi.ErrGenScalingPlan, req.ID)
+	}
+	return nil
+}
+
+// toStatusError returns a gRPC status error with the code mapped from the sentinel error wrapped by the given err.
+func toStatusError(err error) error {
+	code := codes.Internal
+	for _, c := range errorCodes {
+		if errors.Is(err, c.err) {
+			code = c.code
+			break
+		}
+	}
+	return status.Error(code, err.Error())
+}
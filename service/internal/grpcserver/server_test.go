@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"testing"
+
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	plannerv1alpha1 "github.com/gardener/scaling-advisor/api/proto/planner/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// fakePlanner sends the configured responses for every request. If waitForCancel is set, it waits for the ctx to be
+// done afterward and records that plan generation was canceled.
+type fakePlanner struct {
+	canceled      chan struct{}
+	responses     []plannerapi.Response
+	waitForCancel bool
+}
+
+func (f *fakePlanner) Plan(ctx context.Context, req plannerapi.Request) <-chan plannerapi.Response {
+	responseCh := make(chan plannerapi.Response)
+	go func() {
+		defer close(responseCh)
+		for _, r := range f.responses {
+			r.RequestRef = req.RequestRef
+			select {
+			case <-ctx.Done():
+				return
+			case responseCh <- r:
+			}
+		}
+		if f.waitForCancel {
+			<-ctx.Done()
+			close(f.canceled)
+		}
+	}()
+	return responseCh
+}
+
+func TestPlan(t *testing.T) {
+	tests := []struct {
+		name      string
+		responses []plannerapi.Response
+		wantIDs   []string
+		wantCode  codes.Code
+	}{
+		{
+			name:      "streams responses",
+			responses: []plannerapi.Response{{ID: "plan-1"}, {ID: "plan-2"}, {ID: "plan-3", Error: plannerapi.ErrAdviceGenerationTimeout}},
+			wantIDs:   []string{"plan-1", "plan-2", "plan-3"},
+			wantCode:  codes.OK,
+		},
+		{
+			name:      "invalid request",
+			responses: []plannerapi.Response{{Error: fmt.Errorf("%w: missing snapshot", plannerapi.ErrInvalidRequest)}},
+			wantCode:  codes.InvalidArgument,
+		},
+		{
+			name:      "no scale-out plan",
+			responses: []plannerapi.Response{{Error: plannerapi.AsGenError("r1", "", plannerapi.ErrNoScaleOutPlan)}},
+			wantCode:  codes.FailedPrecondition,
+		},
+		{
+			name:      "too many concurrent plans",
+			responses: []plannerapi.Response{{Error: plannerapi.ErrTooManyConcurrentPlans}},
+			wantCode:  codes.ResourceExhausted,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			client := startServer(t, &fakePlanner{responses: tc.responses})
+			stream, err := client.Plan(t.Context(), &plannerv1alpha1.PlanRequest{Ref: &plannerv1alpha1.RequestRef{Id: "r1"}})
+			if err != nil {
+				t.Fatalf("Plan() error = %v", err)
+			}
+			var ids []string
+			for {
+				response, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				if err != nil {
+					if got := status.Code(err); got != tc.wantCode {
+						t.Errorf("status code = %v, want %v", got, tc.wantCode)
+					}
+					return
+				}
+				if response.GetRequestRef().GetId() != "r1" {
+					t.Errorf("request ID of response = %q, want %q", response.GetRequestRef().GetId(), "r1")
+				}
+				ids = append(ids, response.GetId())
+			}
+			if tc.wantCode != codes.OK {
+				t.Fatalf("stream ended without error, want status code %v", tc.wantCode)
+			}
+			if fmt.Sprint(ids) != fmt.Sprint(tc.wantIDs) {
+				t.Errorf("response IDs = %v, want %v", ids, tc.wantIDs)
+			}
+		})
+	}
+}
+
+func TestPlanCanceled(t *testing.T) {
+	planner := &fakePlanner{responses: []plannerapi.Response{{ID: "plan-1"}}, canceled: make(chan struct{}), waitForCancel: true}
+	client := startServer(t, planner)
+	ctx, cancel := context.WithCancel(t.Context())
+	stream, err := client.Plan(ctx, &plannerv1alpha1.PlanRequest{Ref: &plannerv1alpha1.RequestRef{Id: "r1"}})
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if _, err = stream.Recv(); err != nil {
+		t.Fatalf("Recv() error = %v", err)
+	}
+	cancel()
+	<-planner.canceled
+}
+
+func TestPlanInvalidQuantity(t *testing.T) {
+	client := startServer(t, &fakePlanner{})
+	stream, err := client.Plan(t.Context(), &plannerv1alpha1.PlanRequest{
+		Snapshot: &plannerv1alpha1.ClusterSnapshot{Nodes: []*plannerv1alpha1.NodeInfo{{Capacity: map[string]string{"cpu": "two"}}}},
+	})
+	if err != nil {
+		t.Fatalf("Plan() error = %v", err)
+	}
+	if _, err = stream.Recv(); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("Recv() error = %v, want status code %v", err, codes.InvalidArgument)
+	}
+}
+
+func startServer(t *testing.T, planner plannerapi.ScalingPlanner) plannerv1alpha1.ScalingPlannerClient {
+	t.Helper()
+	listener := bufconn.Listen(1 << 20)
+	s := New("", planner)
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+	t.Cleanup(func() {
+		_ = s.Stop(context.Background())
+	})
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("cannot create client: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	return plannerv1alpha1.NewScalingPlannerClient(conn)
+}
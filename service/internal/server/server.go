@@ -0,0 +1,321 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+	"github.com/gardener/scaling-advisor/common/objutil"
+	"github.com/gardener/scaling-advisor/common/webutil"
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// PlanPath is the path of the endpoint to which a plannerapi.Request is posted to generate scaling plans.
+	PlanPath = "/api/v1alpha1/plan"
+	// PlanJobsPath is the path of the endpoint to which a plannerapi.Request is posted to submit a plan job. Plan jobs
+	// are fetched and canceled at PlanJobsPath/{id}, where id is the ID of the submitted request.
+	PlanJobsPath = "/api/v1alpha1/jobs"
+	// HealthzPath is the path of the health endpoint.
+	HealthzPath = "/healthz"
+	// MetricsPath is the path of the endpoint serving the Prometheus metrics of the service.
+	MetricsPath = "/metrics"
+
+	// ContentTypeJSON is the content type of a single JSON response.
+	ContentTypeJSON = "application/json"
+	// ContentTypeNDJSON is the content type of a stream of newline delimited JSON responses.
+	ContentTypeNDJSON = "application/x-ndjson"
+	// ContentTypeEventStream is the content type of a stream of server-sent events.
+	ContentTypeEventStream = "text/event-stream"
+
+	// maxRequestBodyBytes is the maximum size of the body of a plan request, which is dominated by the cluster snapshot.
+	maxRequestBodyBytes = 256 << 20
+)
+
+// ErrorResponse is the JSON body of a request that failed with an error, which for a plan request is written if the error
+// occurred before any scaling plan was sent.
+type ErrorResponse struct {
+	// RequestRef is the reference to the failed request.
+	RequestRef plannerapi.RequestRef `json:"requestRef"`
+	// Code is the error code derived from the sentinel error of the planner API wrapped by the error.
+	Code string `json:"code"`
+	// Message is the error message.
+	Message string `json:"message"`
+}
+
+// errorMapping maps a sentinel error of the planner API to an HTTP status code and error code.
+type errorMapping struct {
+	err    error
+	code   string
+	status int
+}
+
+// errorMappings are the mappings of sentinel errors in order of precedence. Errors wrapping none of them are mapped to
+// http.StatusInternalServerError.
+var errorMappings = []errorMapping{
+	{plannerapi.ErrInvalidRequest, "InvalidRequest", http.StatusBadRequest},
+	{plannerapi.ErrInvalidScalingConstraint, "InvalidScalingConstraint", http.StatusBadRequest},
+	{plannerapi.ErrUnsupportedSimulatorStrategy, "UnsupportedSimulatorStrategy", http.StatusBadRequest},
+	{plannerapi.ErrCreateNodeScorer, "InvalidNodeScoring", http.StatusBadRequest},
+	{plannerapi.ErrNoUnscheduledPods, "NoUnscheduledPods", http.StatusUnprocessableEntity},
+	{plannerapi.ErrNoScaleOutPlan, "NoScaleOutPlan", http.StatusUnprocessableEntity},
+	{plannerapi.ErrNoScaleInPlan, "NoScaleInPlan", http.StatusUnprocessableEntity},
+	{plannerapi.ErrPlanJobNotFound, "PlanJobNotFound", http.StatusNotFound},
+	{plannerapi.ErrPlanJobExists, "PlanJobExists", http.StatusConflict},
+	{plannerapi.ErrPlanJobQueueFull, "PlanJobQueueFull", http.StatusTooManyRequests},
+	{plannerapi.ErrTooManyConcurrentPlans, "TooManyConcurrentPlans", http.StatusTooManyRequests},
+	{plannerapi.ErrAdviceGenerationTimeout, "AdviceGenerationTimeout", http.StatusGatewayTimeout},
+	{context.Canceled, "Canceled", http.StatusServiceUnavailable},
+}
+
+// Server is the HTTP server of the scaling planner service, which exposes the ScalingPlanner and the PlanJobManager
+// through a JSON API.
+type Server struct {
+	planner  plannerapi.ScalingPlanner
+	planJobs plannerapi.PlanJobManager
+	server   *http.Server
+}
+
+// New creates a Server for the given planner and planJobs that listens on the BindAddress of the given config once
+// started. The given metricsHandler is served at MetricsPath if not nil.
+func New(cfg commontypes.ServerConfig, planner plannerapi.ScalingPlanner, planJobs plannerapi.PlanJobManager, metricsHandler http.Handler) *Server {
+	s := &Server{
+		planner:  planner,
+		planJobs: planJobs,
+		server: &http.Server{
+			Addr: cfg.BindAddress,
+			// G112 (CWE-400): Potential Slowloris Attack: kept it same as the one of the MinKAPI server.
+			ReadHeaderTimeout: 32 * time.Second,
+		},
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("POST "+PlanPath, s.handlePlan)
+	mux.HandleFunc("POST "+PlanJobsPath, s.handleSubmitPlanJob)
+	mux.HandleFunc("GET "+PlanJobsPath+"/{id}", s.handleGetPlanJob)
+	mux.HandleFunc("DELETE "+PlanJobsPath+"/{id}", s.handleCancelPlanJob)
+	mux.HandleFunc("GET "+HealthzPath, handleHealthz)
+	if metricsHandler != nil {
+		mux.Handle("GET "+MetricsPath, metricsHandler)
+	}
+	// the trace context of incoming requests is extracted into the context of the request by otelhttp.
+	s.server.Handler = otelhttp.NewHandler(mux, plannerapi.ServiceName)
+	return s
+}
+
+// Start listens on the bind address and serves requests until the server is stopped. This is a blocking call.
+func (s *Server) Start(ctx context.Context) error {
+	log := logr.FromContextOrDiscard(ctx)
+	s.server.BaseContext = func(_ net.Listener) context.Context {
+		return ctx
+	}
+	s.server.Handler = webutil.LoggerMiddleware(log, s.server.Handler)
+	listener, err := net.Listen("tcp", s.server.Addr)
+	if err != nil {
+		return fmt.Errorf("cannot listen on TCP Address %q: %w", s.server.Addr, err)
+	}
+	log.Info(fmt.Sprintf("%s server listening", plannerapi.ServiceName), "address", listener.Addr().String())
+	if err = s.server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// Stop gracefully shuts down the server, waiting for requests in flight until the given ctx is done.
+func (s *Server) Stop(ctx context.Context) error {
+	return s.server.Shutdown(ctx)
+}
+
+// handlePlan decodes the plannerapi.Request of the request body and writes the responses of the planner in the format
+// negotiated by getResponseContentType. If the first response of the planner carries an error, it is written as an
+// ErrorResponse with the HTTP status mapped from its error.
+func (s *Server) handlePlan(w http.ResponseWriter, r *http.Request) {
+	req, ok := decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	if req.ID == "" {
+		req.ID = objutil.GenerateName("plan-request-")
+	}
+	if req.CreationTime.IsZero() {
+		req.CreationTime = time.Now()
+	}
+	contentType := getResponseContentType(r, &req)
+
+	responseCh := s.planner.Plan(r.Context(), req)
+	// the planner blocks on sending responses, hence they are drained if the handler returns early.
+	defer func() {
+		go func() {
+			for range responseCh {
+			}
+		}()
+	}()
+	first, ok := <-responseCh
+	if !ok {
+		writeError(w, r, req.GetRef(), fmt.Errorf("%w: planner sent no response", plannerapi.ErrGenScalingPlan))
+		return
+	}
+	if first.Error != nil {
+		writeError(w, r, req.GetRef(), first.Error)
+		return
+	}
+	if contentType == ContentTypeJSON {
+		writeJSON(w, r, http.StatusOK, first)
+		return
+	}
+	streamResponses(w, r, contentType, first, responseCh)
+}
+
+// handleSubmitPlanJob decodes the plannerapi.Request of the request body, submits a plan job for it and writes the
+// reference to the request with http.StatusAccepted and the location of the plan job.
+func (s *Server) handleSubmitPlanJob(w http.ResponseWriter, r *http.Request) {
+	req, ok := decodeRequest(w, r)
+	if !ok {
+		return
+	}
+	ref, err := s.planJobs.SubmitPlanJob(r.Context(), req)
+	if err != nil {
+		writeError(w, r, ref, err)
+		return
+	}
+	w.Header().Set("Location", PlanJobsPath+"/"+url.PathEscape(ref.ID))
+	writeJSON(w, r, http.StatusAccepted, ref)
+}
+
+// handleGetPlanJob writes the plannerapi.PlanJob for the request ID of the path.
+func (s *Server) handleGetPlanJob(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	job, err := s.planJobs.GetPlanJob(id)
+	if err != nil {
+		writeError(w, r, plannerapi.RequestRef{ID: id}, err)
+		return
+	}
+	writeJSON(w, r, http.StatusOK, job)
+}
+
+// handleCancelPlanJob cancels the plan job for the request ID of the path and writes the resulting plannerapi.PlanJob.
+func (s *Server) handleCancelPlanJob(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	job, err := s.planJobs.CancelPlanJob(id)
+	if err != nil {
+		writeError(w, r, plannerapi.RequestRef{ID: id}, err)
+		return
+	}
+	writeJSON(w, r, http.StatusOK, job)
+}
+
+// decodeRequest decodes the plannerapi.Request of the body of the given r, writing an error response if it cannot be
+// decoded.
+func decodeRequest(w http.ResponseWriter, r *http.Request) (req plannerapi.Request, ok bool) {
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodyBytes)).Decode(&req); err != nil {
+		writeError(w, r, req.GetRef(), fmt.Errorf("%w: cannot decode request body: %w", plannerapi.ErrInvalidRequest, err))
+		return req, false
+	}
+	return req, true
+}
+
+// streamResponses writes the given first response and all further responses received on the given responseCh as
+// newline delimited JSON or as server-sent events according to the given contentType, flushing each response.
+func streamResponses(w http.ResponseWriter, r *http.Request, contentType string, first plannerapi.Response, responseCh <-chan plannerapi.Response) {
+	log := logr.FromContextOrDiscard(r.Context())
+	controller := http.NewResponseController(w)
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	response, ok := first, true
+	for ok {
+		data, err := json.Marshal(response)
+		if err != nil {
+			log.Error(err, "cannot marshal response", "responseID", response.ID)
+			return
+		}
+		if contentType == ContentTypeEventStream {
+			event := "response"
+			if response.Error != nil {
+				event = "error"
+			}
+			_, err = fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", response.ID, event, data)
+		} else {
+			_, err = fmt.Fprintf(w, "%s\n", data)
+		}
+		if err == nil {
+			err = controller.Flush()
+		}
+		if err != nil {
+			log.Error(err, "cannot write response", "responseID", response.ID)
+			return
+		}
+		select {
+		case <-r.Context().Done():
+			return
+		case response, ok = <-responseCh:
+		}
+	}
+}
+
+// getResponseContentType returns the content type of the responses for the given plan request. Responses are streamed
+// if the Accept header of the request prefers server-sent events or newline delimited JSON. Otherwise, the responses
+// of a plan request with the incremental advice generation mode are streamed as newline delimited JSON, and the single
+// response of a plan request with the all-at-once advice generation mode is written as JSON.
+func getResponseContentType(r *http.Request, req *plannerapi.Request) string {
+	for accepted := range strings.SplitSeq(r.Header.Get("Accept"), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case ContentTypeEventStream, ContentTypeNDJSON:
+			return mediaType
+		case ContentTypeJSON:
+			if !req.AdviceGenerationMode.IsIncremental() {
+				return ContentTypeJSON
+			}
+		}
+	}
+	if req.AdviceGenerationMode.IsIncremental() {
+		return ContentTypeNDJSON
+	}
+	return ContentTypeJSON
+}
+
+// writeError writes the given error as ErrorResponse with the HTTP status code and error code mapped from the sentinel
+// error it wraps.
+func writeError(w http.ResponseWriter, r *http.Request, ref plannerapi.RequestRef, err error) {
+	status, code := http.StatusInternalServerError, "InternalError"
+	for _, m := range errorMappings {
+		if errors.Is(err, m.err) {
+			status, code = m.status, m.code
+			break
+		}
+	}
+	if status == http.StatusInternalServerError {
+		logr.FromContextOrDiscard(r.Context()).Error(err, "cannot generate scaling plan", "requestID", ref.ID)
+	}
+	writeJSON(w, r, status, ErrorResponse{RequestRef: ref, Code: code, Message: err.Error()})
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, body any) {
+	w.Header().Set("Content-Type", ContentTypeJSON)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		logr.FromContextOrDiscard(r.Context()).Error(err, "cannot write JSON response")
+	}
+}
+
+func handleHealthz(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte("ok"))
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2026 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	plannerapi "github.com/gardener/scaling-advisor/api/planner"
+)
+
+// fakePlanner sends the configured responses for every request, or a single response with the configured error.
+type fakePlanner struct {
+	err       error
+	responses []plannerapi.Response
+}
+
+func (f *fakePlanner) Plan(ctx context.Context, req plannerapi.Request) <-chan plannerapi.Response {
+	responseCh := make(chan plannerapi.Response)
+	go func() {
+		defer close(responseCh)
+		responses := f.responses
+		if f.err != nil {
+			responses = []plannerapi.Response{{Error: f.err}}
+		}
+		for _, r := range responses {
+			r.RequestRef = req.RequestRef
+			select {
+			case <-ctx.Done():
+				return
+			case responseCh <- r:
+			}
+		}
+	}()
+	return responseCh
+}
+
+// fakePlanJobManager retains a pending plan job for every submitted request.
+type fakePlanJobManager struct {
+	jobs map[string]plannerapi.PlanJob
+}
+
+func (f *fakePlanJobManager) SubmitPlanJob(_ context.Context, req plannerapi.Request) (plannerapi.RequestRef, error) {
+	if _, ok := f.jobs[req.ID]; ok {
+		return req.RequestRef, plannerapi.ErrPlanJobExists
+	}
+	f.jobs[req.ID] = plannerapi.PlanJob{RequestRef: req.RequestRef, Status: plannerapi.ActivityStatusPending}
+	return req.RequestRef, nil
+}
+
+func (f *fakePlanJobManager) GetPlanJob(requestID string) (plannerapi.PlanJob, error) {
+	job, ok := f.jobs[requestID]
+	if !ok {
+		return job, plannerapi.ErrPlanJobNotFound
+	}
+	return job, nil
+}
+
+func (f *fakePlanJobManager) CancelPlanJob(requestID string) (plannerapi.PlanJob, error) {
+	job, err := f.GetPlanJob(requestID)
+	if err != nil {
+		return job, err
+	}
+	job.Status = plannerapi.ActivityStatusFailure
+	f.jobs[requestID] = job
+	return job, nil
+}
+
+func TestHandlePlan(t *testing.T) {
+	responses := []plannerapi.Response{{ID: "plan-1"}, {ID: "plan-2"}}
+	ts := httptest.NewServer(New(commontypes.ServerConfig{}, &fakePlanner{responses: responses}, nil, nil).server.Handler)
+	defer ts.Close()
+
+	t.Run("all-at-once", func(t *testing.T) {
+		resp := postPlanRequest(t, ts.URL, commontypes.ScalingAdviceGenerationModeAllAtOnce, "")
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != ContentTypeJSON {
+			t.Fatalf("status = %d, content type = %q, want %d, %q", resp.StatusCode, resp.Header.Get("Content-Type"), http.StatusOK, ContentTypeJSON)
+		}
+		var got plannerapi.Response
+		if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+			t.Fatalf("cannot decode response: %v", err)
+		}
+		if got.ID != "plan-1" || got.RequestRef.ID != "r1" {
+			t.Errorf("response = %+v, want response %q for request %q", got, "plan-1", "r1")
+		}
+	})
+
+	t.Run("incremental as NDJSON", func(t *testing.T) {
+		resp := postPlanRequest(t, ts.URL, commontypes.ScalingAdviceGenerationModeIncremental, "")
+		defer func() { _ = resp.Body.Close() }()
+		if got := resp.Header.Get("Content-Type"); got != ContentTypeNDJSON {
+			t.Fatalf("content type = %q, want %q", got, ContentTypeNDJSON)
+		}
+		var ids []string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			var r plannerapi.Response
+			if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+				t.Fatalf("cannot decode line %q: %v", scanner.Text(), err)
+			}
+			ids = append(ids, r.ID)
+		}
+		if strings.Join(ids, ",") != "plan-1,plan-2" {
+			t.Errorf("response IDs = %v, want %v", ids, []string{"plan-1", "plan-2"})
+		}
+	})
+
+	t.Run("incremental as server-sent events", func(t *testing.T) {
+		resp := postPlanRequest(t, ts.URL, commontypes.ScalingAdviceGenerationModeIncremental, ContentTypeEventStream)
+		defer func() { _ = resp.Body.Close() }()
+		if got := resp.Header.Get("Content-Type"); got != ContentTypeEventStream {
+			t.Fatalf("content type = %q, want %q", got, ContentTypeEventStream)
+		}
+		var events []string
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			if event, ok := strings.CutPrefix(scanner.Text(), "id: "); ok {
+				events = append(events, event)
+			}
+		}
+		if strings.Join(events, ",") != "plan-1,plan-2" {
+			t.Errorf("event IDs = %v, want %v", events, []string{"plan-1", "plan-2"})
+		}
+	})
+}
+
+func TestHandlePlanErrors(t *testing.T) {
+	tests := []struct {
+		err        error
+		name       string
+		body       string
+		wantCode   string
+		wantStatus int
+	}{
+		{name: "malformed body", body: "{", wantStatus: http.StatusBadRequest, wantCode: "InvalidRequest"},
+		{name: "invalid request", err: fmt.Errorf("%w: missing snapshot", plannerapi.ErrInvalidRequest), wantStatus: http.StatusBadRequest, wantCode: "InvalidRequest"},
+		{name: "no scale-out plan", err: plannerapi.AsGenError("r1", "", plannerapi.ErrNoScaleOutPlan), wantStatus: http.StatusUnprocessableEntity, wantCode: "NoScaleOutPlan"},
+		{name: "too many concurrent plans", err: plannerapi.ErrTooManyConcurrentPlans, wantStatus: http.StatusTooManyRequests, wantCode: "TooManyConcurrentPlans"},
+		{name: "timeout", err: plannerapi.ErrAdviceGenerationTimeout, wantStatus: http.StatusGatewayTimeout, wantCode: "AdviceGenerationTimeout"},
+		{name: "internal error", err: plannerapi.ErrCreateSimulator, wantStatus: http.StatusInternalServerError, wantCode: "InternalError"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ts := httptest.NewServer(New(commontypes.ServerConfig{}, &fakePlanner{err: tc.err}, nil, nil).server.Handler)
+			defer ts.Close()
+			body := tc.body
+			if body == "" {
+				body = `{"id": "r1"}`
+			}
+			resp, err := http.Post(ts.URL+PlanPath, ContentTypeJSON, strings.NewReader(body))
+			if err != nil {
+				t.Fatalf("cannot post plan request: %v", err)
+			}
+			defer func() { _ = resp.Body.Close() }()
+			var got ErrorResponse
+			if err = json.NewDecoder(resp.Body).Decode(&got); err != nil {
+				t.Fatalf("cannot decode error response: %v", err)
+			}
+			if resp.StatusCode != tc.wantStatus || got.Code != tc.wantCode {
+				t.Errorf("status = %d, code = %q, want %d, %q", resp.StatusCode, got.Code, tc.wantStatus, tc.wantCode)
+			}
+		})
+	}
+}
+
+func TestHandlePlanJobs(t *testing.T) {
+	ts := httptest.NewServer(New(commontypes.ServerConfig{}, &fakePlanner{}, &fakePlanJobManager{jobs: map[string]plannerapi.PlanJob{}}, nil).server.Handler)
+	defer ts.Close()
+
+	resp, err := http.Post(ts.URL+PlanJobsPath, ContentTypeJSON, strings.NewReader(`{"id": "r1"}`))
+	if err != nil {
+		t.Fatalf("cannot submit plan job: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusAccepted || resp.Header.Get("Location") != PlanJobsPath+"/r1" {
+		t.Fatalf("status = %d, location = %q, want %d, %q", resp.StatusCode, resp.Header.Get("Location"), http.StatusAccepted, PlanJobsPath+"/r1")
+	}
+	resp, err = http.Post(ts.URL+PlanJobsPath, ContentTypeJSON, strings.NewReader(`{"id": "r1"}`))
+	if err != nil {
+		t.Fatalf("cannot submit plan job: %v", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusConflict {
+		t.Errorf("status = %d for duplicate plan job, want %d", resp.StatusCode, http.StatusConflict)
+	}
+
+	tests := []struct {
+		method     string
+		id         string
+		wantStatus int
+		wantJob    plannerapi.ActivityStatus
+	}{
+		{method: http.MethodGet, id: "r1", wantStatus: http.StatusOK, wantJob: plannerapi.ActivityStatusPending},
+		{method: http.MethodDelete, id: "r1", wantStatus: http.StatusOK, wantJob: plannerapi.ActivityStatusFailure},
+		{method: http.MethodGet, id: "unknown", wantStatus: http.StatusNotFound},
+	}
+	for _, tc := range tests {
+		req, err := http.NewRequest(tc.method, ts.URL+PlanJobsPath+"/"+tc.id, nil)
+		if err != nil {
+			t.Fatalf("cannot create request: %v", err)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatalf("cannot send request: %v", err)
+		}
+		var job plannerapi.PlanJob
+		err = json.NewDecoder(resp.Body).Decode(&job)
+		_ = resp.Body.Close()
+		if err != nil {
+			t.Fatalf("cannot decode response: %v", err)
+		}
+		if resp.StatusCode != tc.wantStatus || job.Status != tc.wantJob {
+			t.Errorf("%s %q: status = %d, plan job status = %q, want %d, %q", tc.method, tc.id, resp.StatusCode, job.Status, tc.wantStatus, tc.wantJob)
+		}
+	}
+}
+
+func postPlanRequest(t *testing.T, url string, mode commontypes.ScalingAdviceGenerationMode, accept string) *http.Response {
+	t.Helper()
+	body, err := json.Marshal(plannerapi.Request{RequestRef: plannerapi.RequestRef{ID: "r1"}, AdviceGenerationMode: mode})
+	if err != nil {
+		t.Fatalf("cannot marshal request: %v", err)
+	}
+	req, err := http.NewRequest(http.MethodPost, url+PlanPath, strings.NewReader(string(body)))
+	if err != nil {
+		t.Fatalf("cannot create request: %v", err)
+	}
+	if accept != "" {
+		req.Header.Set("Accept", accept)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("cannot post plan request: %v", err)
+	}
+	return resp
+}
@@ -75,7 +75,7 @@ func init() {
 	genpriceCmd.Flags().StringSliceVarP(&regions, "regions", "r", nil, "Comma-separated list of regions")
 }
 
-// generateAWSPrices fetches EC2 instance pricing for the given regions and writes to file `aws_instance-type-infos.json` inside pricingDir.
+// generateAWSPrices fetches EC2 on-demand and spot instance pricing for the given regions and writes to file `aws_instance-type-infos.json` inside pricingDir.
 func generateAWSPrices(pricingDir string, regions []string) error {
 	if err := os.MkdirAll(pricingDir, 0o750); err != nil {
 		return fmt.Errorf("failed to create pricing dir: %w", err)
@@ -83,6 +83,10 @@ func generateAWSPrices(pricingDir string, regions []string) error {
 
 	var allInfos []pricingapi.InstancePriceInfo
 	tmpDir := os.TempDir()
+	spotData, err := readOrFetchAWSSpotPrices(tmpDir)
+	if err != nil {
+		return err
+	}
 	for _, region := range regions {
 		regionJSONPath := path.Join(tmpDir, "aws_"+region+".json")
 		data, err := os.ReadFile(filepath.Clean(regionJSONPath))
@@ -101,17 +105,43 @@ func generateAWSPrices(pricingDir string, regions []string) error {
 		if err != nil {
 			return fmt.Errorf("failed to parse region %s: %w", region, err)
 		}
-		fmt.Printf("Fetched %d instance type prices for region %s\n", len(infos), region)
+		spotInfos, err := awsprice.ParseSpotPrices(infos, spotData)
+		if err != nil {
+			return fmt.Errorf("failed to parse spot prices of region %s: %w", region, err)
+		}
+		fmt.Printf("Fetched %d instance type prices and %d spot prices for region %s\n", len(infos), len(spotInfos), region)
 		allInfos = append(allInfos, infos...)
+		allInfos = append(allInfos, spotInfos...)
 	}
 	slices.SortFunc(allInfos, func(a, b pricingapi.InstancePriceInfo) int {
-		return cmp.Compare(a.InstanceType, b.InstanceType)
+		return cmp.Or(cmp.Compare(a.InstanceType, b.InstanceType), cmp.Compare(a.Region, b.Region),
+			cmp.Compare(a.CapacityType, b.CapacityType))
 	})
 	fmt.Printf("Fetched %d instance type prices across %d region(s)\n", len(allInfos), len(regions))
 	outputFile := filepath.Join(pricingDir, "aws_instance-type-infos.json")
 	return writeInstanceTypeInfos(outputFile, allInfos)
 }
 
+// readOrFetchAWSSpotPrices reads the AWS spot price JSON from the file `aws_spot.json` inside tmpDir, or fetches and
+// writes it there if the file does not exist.
+func readOrFetchAWSSpotPrices(tmpDir string) ([]byte, error) {
+	spotJSONPath := path.Join(tmpDir, "aws_spot.json")
+	data, err := os.ReadFile(filepath.Clean(spotJSONPath))
+	if err == nil {
+		return data, nil
+	}
+	fmt.Println("Fetching AWS spot prices")
+	data, err = awsprice.FetchSpotPriceJSON()
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch spot prices: %w", err)
+	}
+	if err = os.WriteFile(spotJSONPath, data, 0o600); err != nil {
+		return nil, fmt.Errorf("failed to write temp spot price file %s: %w", spotJSONPath, err)
+	}
+	fmt.Printf("Written spot prices to file %s\n", spotJSONPath)
+	return data, nil
+}
+
 func writeInstanceTypeInfos(path string, infos []pricingapi.InstancePriceInfo) error {
 	f, err := os.Create(filepath.Clean(path))
 	if err != nil {
@@ -27,3 +27,21 @@ func FetchRegionJSON(region string) ([]byte, error) {
 
 	return io.ReadAll(resp.Body)
 }
+
+// FetchSpotPriceJSON downloads the raw JSON spot price data of all regions.
+func FetchSpotPriceJSON() ([]byte, error) {
+	const url = "https://website.spot.ec2.aws.a2z.com/spot.json"
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("http get failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("http status %d from %s", resp.StatusCode, url)
+	}
+
+	return io.ReadAll(resp.Body)
+}
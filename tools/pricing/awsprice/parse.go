@@ -14,6 +14,7 @@ import (
 
 	"github.com/gardener/scaling-advisor/tools/types/awsprice"
 
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
 	pricingapi "github.com/gardener/scaling-advisor/api/pricing"
 )
 
@@ -116,6 +117,96 @@ func ParseRegionPrices(region, osName string, data []byte) ([]pricingapi.Instanc
 	return infos, nil
 }
 
+// spotRegionAliases maps the legacy region aliases used by the AWS spot price JSON to the names of the AWS regions.
+var spotRegionAliases = map[string]string{
+	"us-east":    "us-east-1",
+	"us-west":    "us-west-1",
+	"eu-ireland": "eu-west-1",
+	"apac-sin":   "ap-southeast-1",
+	"apac-syd":   "ap-southeast-2",
+	"apac-tokyo": "ap-northeast-1",
+}
+
+// spotOSColumns maps the operating systems of the AWS price list to the value columns of the AWS spot price JSON.
+var spotOSColumns = map[string]string{
+	"Linux":   "linux",
+	"Windows": "mswin",
+}
+
+// ParseSpotPrices parses the raw AWS spot price JSON and returns a spot InstancePriceInfo for each of the given
+// onDemandInfos whose instance type has a spot price in its region for its operating system.
+//
+// Parameters:
+//   - onDemandInfos: The InstancePriceInfo values returned by ParseRegionPrices, whose
+//     specification (VCPU, Memory, GPU) is copied into the spot InstancePriceInfo values.
+//   - data: Raw JSON bytes from the AWS spot price endpoint.
+//
+// Behavior:
+//   - Maps legacy region aliases (e.g. "eu-ireland") to region names.
+//   - Skips prices that cannot be parsed or are not positive (e.g. "N/A*").
+//
+// Returns:
+//   - A slice of pricingapi.InstancePriceInfo with CapacityType spot.
+//   - An error if the input JSON cannot be parsed.
+//
+//	{
+//	 "config": {
+//	   "regions": [{
+//	     "region": "us-east",
+//	     "instanceTypes": [{
+//	       "type": "generalCurrentGen",
+//	       "sizes": [{
+//	         "size": "m5.large",
+//	         "valueColumns": [{ "name": "linux", "prices": { "USD": "0.0355" } }]
+//	       }]
+//	     }]
+//	   }]
+//	 }
+//	}
+func ParseSpotPrices(onDemandInfos []pricingapi.InstancePriceInfo, data []byte) ([]pricingapi.InstancePriceInfo, error) {
+	var raw awsprice.SpotPriceList
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+
+	type priceKey struct {
+		InstanceType string
+		Region       string
+		OSColumn     string
+	}
+
+	spotPrices := make(map[priceKey]float64, 1000)
+	for _, region := range raw.Config.Regions {
+		regionName := region.Region
+		if name, ok := spotRegionAliases[regionName]; ok {
+			regionName = name
+		}
+		for _, group := range region.InstanceTypes {
+			for _, size := range group.Sizes {
+				for _, column := range size.ValueColumns {
+					price, err := strconv.ParseFloat(column.Prices["USD"], 64)
+					if err != nil || price <= 0 {
+						continue
+					}
+					spotPrices[priceKey{InstanceType: size.Size, Region: regionName, OSColumn: column.Name}] = price
+				}
+			}
+		}
+	}
+
+	infos := make([]pricingapi.InstancePriceInfo, 0, len(onDemandInfos))
+	for _, info := range onDemandInfos {
+		price, ok := spotPrices[priceKey{InstanceType: info.InstanceType, Region: info.Region, OSColumn: spotOSColumns[info.OS]}]
+		if !ok {
+			continue
+		}
+		info.CapacityType = commontypes.CapacityTypeSpot
+		info.HourlyPrice = price
+		infos = append(infos, info)
+	}
+	return infos, nil
+}
+
 // extractOnDemandHourlyPriceForSKU returns the lowest non-zero OnDemand hourly price
 // for a given SKU. It filters out any price dimensions that are not per-hour
 // (e.g., per-second billing).
@@ -4,7 +4,13 @@
 
 package awsprice
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+
+	commontypes "github.com/gardener/scaling-advisor/api/common/types"
+	pricingapi "github.com/gardener/scaling-advisor/api/pricing"
+)
 
 func TestParseMemory(t *testing.T) {
 	type args struct {
@@ -44,3 +50,29 @@ func TestParseMemory(t *testing.T) {
 		})
 	}
 }
+
+func TestParseSpotPrices(t *testing.T) {
+	onDemandInfos := []pricingapi.InstancePriceInfo{
+		{InstanceType: "m5.large", Region: "eu-west-1", OS: "Linux", VCPU: 2, Memory: 8 * 1024 * 1024 * 1024, HourlyPrice: 0.107},
+		{InstanceType: "m5.large", Region: "eu-central-1", OS: "Linux", VCPU: 2, Memory: 8 * 1024 * 1024 * 1024, HourlyPrice: 0.115},
+		{InstanceType: "m5.xlarge", Region: "eu-west-1", OS: "Linux", VCPU: 4, Memory: 16 * 1024 * 1024 * 1024, HourlyPrice: 0.214},
+		{InstanceType: "c5.large", Region: "eu-west-1", OS: "Linux", VCPU: 2, Memory: 4 * 1024 * 1024 * 1024, HourlyPrice: 0.096},
+	}
+	data := `{"config":{"regions":[
+		{"region":"eu-ireland","instanceTypes":[{"type":"generalCurrentGen","sizes":[
+			{"size":"m5.large","valueColumns":[{"name":"linux","prices":{"USD":"0.0391"}},{"name":"mswin","prices":{"USD":"0.1311"}}]},
+			{"size":"m5.xlarge","valueColumns":[{"name":"linux","prices":{"USD":"N/A*"}}]}]}]},
+		{"region":"eu-central-1","instanceTypes":[{"type":"generalCurrentGen","sizes":[
+			{"size":"m5.large","valueColumns":[{"name":"linux","prices":{"USD":"0.0402"}}]}]}]}]}}`
+	got, err := ParseSpotPrices(onDemandInfos, []byte(data))
+	if err != nil {
+		t.Fatalf("ParseSpotPrices() error = %v", err)
+	}
+	want := []pricingapi.InstancePriceInfo{
+		{InstanceType: "m5.large", Region: "eu-west-1", OS: "Linux", CapacityType: commontypes.CapacityTypeSpot, VCPU: 2, Memory: 8 * 1024 * 1024 * 1024, HourlyPrice: 0.0391},
+		{InstanceType: "m5.large", Region: "eu-central-1", OS: "Linux", CapacityType: commontypes.CapacityTypeSpot, VCPU: 2, Memory: 8 * 1024 * 1024 * 1024, HourlyPrice: 0.0402},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSpotPrices() = %+v, want %+v", got, want)
+	}
+}
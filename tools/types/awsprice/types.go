@@ -6,7 +6,8 @@
 // pricing information from the AWS public price list JSON.
 //
 // It extracts OnDemand hourly prices for EC2 instance types, filtered by
-// operating system, region, and tenancy. Results are returned as
+// operating system, region, and tenancy, as well as their current spot
+// prices from the AWS spot price JSON. Results are returned as
 // plannerapi.InstanceTypeInfo values suitable for consumption in higher-level
 // tools.
 
@@ -57,3 +58,40 @@ type PriceDimension struct {
 	PricePerUnit map[string]string `json:"pricePerUnit"`
 	Unit         string            `json:"unit"`
 }
+
+// SpotPriceList represents the root of the AWS spot price JSON document, which holds the current spot prices of the
+// instance types of all regions.
+type SpotPriceList struct {
+	Config SpotPriceConfig `json:"config"`
+}
+
+// SpotPriceConfig holds the spot prices grouped by region.
+type SpotPriceConfig struct {
+	Regions []SpotRegion `json:"regions"`
+}
+
+// SpotRegion holds the spot prices of the instance types of a region. The Region is either the name of the AWS region
+// or, for some older regions, a legacy alias like "us-east" or "eu-ireland".
+type SpotRegion struct {
+	Region        string                  `json:"region"`
+	InstanceTypes []SpotInstanceTypeGroup `json:"instanceTypes"`
+}
+
+// SpotInstanceTypeGroup groups the spot prices of the instance types of a family generation.
+type SpotInstanceTypeGroup struct {
+	Type  string     `json:"type"`
+	Sizes []SpotSize `json:"sizes"`
+}
+
+// SpotSize holds the spot prices of an instance type by operating system.
+type SpotSize struct {
+	Size         string            `json:"size"`
+	ValueColumns []SpotValueColumn `json:"valueColumns"`
+}
+
+// SpotValueColumn holds the spot price of an operating system, where the Name is e.g. "linux" or "mswin".
+// Example: name = "linux", prices["USD"] = "0.0355". A price of "N/A*" denotes no spot price.
+type SpotValueColumn struct {
+	Name   string            `json:"name"`
+	Prices map[string]string `json:"prices"`
+}